@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jc0b/go-jamfpro-api/jamfpro"
+	"github.com/spf13/cobra"
+)
+
+// resourceCommands describes the list, get and delete operations of one resource; create is added per resource as
+// its flags differ
+type resourceCommands struct {
+	name     string
+	singular string
+	list     func(context.Context, *jamfpro.Client) (interface{}, error)
+	get      func(context.Context, *jamfpro.Client, int) (interface{}, error)
+	delete   func(context.Context, *jamfpro.Client, int) error
+}
+
+func (r resourceCommands) command(opts *globalOptions, create *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   r.name,
+		Short: "Manage " + r.name,
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List " + r.name,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := opts.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			items, err := r.list(cmd.Context(), client)
+			if err != nil {
+				return err
+			}
+			return render(cmd.OutOrStdout(), opts.output, items)
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "get <id>",
+		Short: "Show a " + r.singular,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+			client, err := opts.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			item, err := r.get(cmd.Context(), client, id)
+			if err != nil {
+				return err
+			}
+			return render(cmd.OutOrStdout(), opts.output, item)
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "delete <id>...",
+		Short: "Delete " + r.name,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids := make([]int, len(args))
+			for i, arg := range args {
+				id, err := parseID(arg)
+				if err != nil {
+					return err
+				}
+				ids[i] = id
+			}
+
+			client, err := opts.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			for _, id := range ids {
+				if err := r.delete(cmd.Context(), client, id); err != nil {
+					return fmt.Errorf("deleting %s %d: %w", r.singular, id, err)
+				}
+				fmt.Fprintf(cmd.ErrOrStderr(), "deleted %s %d\n", r.singular, id)
+			}
+			return nil
+		},
+	})
+
+	cmd.AddCommand(create)
+	return cmd
+}
+
+// createCommand wraps run, which creates a resource from the command's flags, with client setup and output
+func createCommand(opts *globalOptions, singular string, run func(context.Context, *jamfpro.Client) (interface{}, error)) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create",
+		Short: "Create a " + singular,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := opts.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			item, err := run(cmd.Context(), client)
+			if err != nil {
+				return err
+			}
+			return render(cmd.OutOrStdout(), opts.output, item)
+		},
+	}
+}
+
+func parseID(arg string) (int, error) {
+	id, err := strconv.Atoi(arg)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid ID %q: must be a positive integer", arg)
+	}
+	return id, nil
+}
+
+func newBuildingsCommand(opts *globalOptions) *cobra.Command {
+	var request jamfpro.BuildingCreateRequest
+	create := createCommand(opts, "building", func(ctx context.Context, client *jamfpro.Client) (interface{}, error) {
+		if request.Name == "" {
+			return nil, fmt.Errorf("--name is required")
+		}
+		building, _, err := client.Buildings.Create(ctx, &request)
+		return building, err
+	})
+	create.Flags().StringVar(&request.Name, "name", "", "building name")
+	create.Flags().StringVar(&request.StreetAddress1, "street-address1", "", "first line of the street address")
+	create.Flags().StringVar(&request.StreetAddress2, "street-address2", "", "second line of the street address")
+	create.Flags().StringVar(&request.City, "city", "", "city")
+	create.Flags().StringVar(&request.StateProvince, "state-province", "", "state or province")
+	create.Flags().StringVar(&request.ZipPostalCode, "zip-postal-code", "", "ZIP or postal code")
+	create.Flags().StringVar(&request.Country, "country", "", "country")
+
+	return resourceCommands{
+		name:     "buildings",
+		singular: "building",
+		list: func(ctx context.Context, client *jamfpro.Client) (interface{}, error) {
+			buildings, _, err := client.Buildings.List(ctx)
+			return buildings, err
+		},
+		get: func(ctx context.Context, client *jamfpro.Client, id int) (interface{}, error) {
+			building, _, err := client.Buildings.GetByID(ctx, id)
+			return building, err
+		},
+		delete: func(ctx context.Context, client *jamfpro.Client, id int) error {
+			_, err := client.Buildings.Delete(ctx, id)
+			return err
+		},
+	}.command(opts, create)
+}
+
+func newCategoriesCommand(opts *globalOptions) *cobra.Command {
+	var request jamfpro.CategoryCreateRequest
+	create := createCommand(opts, "category", func(ctx context.Context, client *jamfpro.Client) (interface{}, error) {
+		if request.Name == "" {
+			return nil, fmt.Errorf("--name is required")
+		}
+		category, _, err := client.Categories.Create(ctx, &request)
+		return category, err
+	})
+	create.Flags().StringVar(&request.Name, "name", "", "category name")
+	create.Flags().IntVar(&request.Priority, "priority", 9, "category priority, from 1 to 20")
+
+	return resourceCommands{
+		name:     "categories",
+		singular: "category",
+		list: func(ctx context.Context, client *jamfpro.Client) (interface{}, error) {
+			categories, _, err := client.Categories.List(ctx)
+			return categories, err
+		},
+		get: func(ctx context.Context, client *jamfpro.Client, id int) (interface{}, error) {
+			category, _, err := client.Categories.GetByID(ctx, id)
+			return category, err
+		},
+		delete: func(ctx context.Context, client *jamfpro.Client, id int) error {
+			_, err := client.Categories.Delete(ctx, id)
+			return err
+		},
+	}.command(opts, create)
+}
+
+func newComputersCommand(opts *globalOptions) *cobra.Command {
+	var general jamfpro.ComputerCreateGeneral
+	create := createCommand(opts, "computer", func(ctx context.Context, client *jamfpro.Client) (interface{}, error) {
+		if general.Name == "" || general.SerialNumber == "" {
+			return nil, fmt.Errorf("--name and --serial-number are required")
+		}
+		computer, _, err := client.Computers.Create(ctx, &jamfpro.ComputerCreateRequest{General: general})
+		return computer, err
+	})
+	create.Flags().StringVar(&general.Name, "name", "", "computer name")
+	create.Flags().StringVar(&general.SerialNumber, "serial-number", "", "serial number")
+	create.Flags().StringVar(&general.Udid, "udid", "", "UDID")
+
+	return resourceCommands{
+		name:     "computers",
+		singular: "computer",
+		list: func(ctx context.Context, client *jamfpro.Client) (interface{}, error) {
+			computers, _, err := client.Computers.List(ctx)
+			return computers, err
+		},
+		get: func(ctx context.Context, client *jamfpro.Client, id int) (interface{}, error) {
+			computer, _, err := client.Computers.GetByID(ctx, id)
+			return computer, err
+		},
+		delete: func(ctx context.Context, client *jamfpro.Client, id int) error {
+			_, err := client.Computers.Delete(ctx, id)
+			return err
+		},
+	}.command(opts, create)
+}
+
+func newGroupsCommand(opts *globalOptions) *cobra.Command {
+	var name string
+	var serials, criteria []string
+	create := createCommand(opts, "computer group", func(ctx context.Context, client *jamfpro.Client) (interface{}, error) {
+		if name == "" {
+			return nil, fmt.Errorf("--name is required")
+		} else if len(serials) > 0 && len(criteria) > 0 {
+			return nil, fmt.Errorf("--serial and --criterion cannot be combined: a group is either static or smart")
+		}
+
+		request := &jamfpro.ComputerGroupRequest{Name: name, IsSmart: len(criteria) > 0}
+		for _, serial := range serials {
+			request.Computers = append(request.Computers, jamfpro.Computer{SerialNumber: serial})
+		}
+		if request.IsSmart {
+			builder := jamfpro.NewCriteriaBuilder()
+			for _, criterion := range criteria {
+				parts := strings.SplitN(criterion, "|", 3)
+				if len(parts) != 3 {
+					return nil, fmt.Errorf("invalid criterion %q: must be name|search type|value", criterion)
+				}
+				builder.And(parts[0], jamfpro.SearchType(parts[1]), parts[2])
+			}
+			built, err := builder.Build()
+			if err != nil {
+				return nil, err
+			}
+			request.Criteria = built
+		}
+
+		group, _, err := client.ComputerGroups.Create(ctx, request)
+		return group, err
+	})
+	create.Flags().StringVar(&name, "name", "", "group name")
+	create.Flags().StringSliceVar(&serials, "serial", nil, "serial number of a member of a static group, repeatable")
+	create.Flags().StringArrayVar(&criteria, "criterion", nil, `smart group criterion as "name|search type|value", repeatable; criteria are ANDed`)
+
+	return resourceCommands{
+		name:     "groups",
+		singular: "computer group",
+		list: func(ctx context.Context, client *jamfpro.Client) (interface{}, error) {
+			groups, _, err := client.ComputerGroups.List(ctx)
+			return groups, err
+		},
+		get: func(ctx context.Context, client *jamfpro.Client, id int) (interface{}, error) {
+			group, _, err := client.ComputerGroups.GetByID(ctx, id)
+			return group, err
+		},
+		delete: func(ctx context.Context, client *jamfpro.Client, id int) error {
+			_, err := client.ComputerGroups.Delete(ctx, id)
+			return err
+		},
+	}.command(opts, create)
+}
@@ -0,0 +1,26 @@
+// Command jamfctl manages Jamf Pro buildings, categories, computers and computer groups from the command line.
+//
+// Credentials are read from flags, then from the JAMFPRO_URL, JAMFPRO_CLIENT_ID and JAMFPRO_CLIENT_SECRET
+// environment variables. On macOS the client secret can instead be kept in the login keychain:
+//
+//	security add-generic-password -s jamfctl -a <client id> -w <client secret>
+//
+// Examples:
+//
+//	jamfctl buildings list --output table
+//	jamfctl categories create --name Utilities --priority 5
+//	jamfctl groups create --name "Lab Macs" --serial C02ABC123 --serial C02DEF456
+//	jamfctl computers get 42 --output json
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if err := newRootCommand().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
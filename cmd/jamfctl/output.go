@@ -0,0 +1,116 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jc0b/go-jamfpro-api/jamfpro"
+)
+
+const (
+	outputJSON  = "json"
+	outputTable = "table"
+	outputCSV   = "csv"
+)
+
+// render writes v, a resource or a slice of resources, in the requested format. Tables and CSV show the scalar
+// attributes of each resource, with id and name first; nested blocks are only shown in JSON.
+func render(w io.Writer, format string, v interface{}) error {
+	if format == outputJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	rows, err := resourceRows(v)
+	if err != nil {
+		return err
+	}
+	columns := rowColumns(rows)
+
+	records := [][]string{columns}
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		for i, column := range columns {
+			if value, ok := row[column]; ok {
+				record[i] = fmt.Sprint(value)
+			}
+		}
+		records = append(records, record)
+	}
+
+	if format == outputCSV {
+		cw := csv.NewWriter(w)
+		if err := cw.WriteAll(records); err != nil {
+			return err
+		}
+		return cw.Error()
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for i, record := range records {
+		if i == 0 {
+			for j := range record {
+				record[j] = strings.ToUpper(record[j])
+			}
+		}
+		fmt.Fprintln(tw, strings.Join(record, "\t"))
+	}
+	return tw.Flush()
+}
+
+func resourceRows(v interface{}) ([]map[string]interface{}, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		row, err := jamfpro.MarshalResourceMap(v)
+		if err != nil {
+			return nil, err
+		}
+		return []map[string]interface{}{row}, nil
+	}
+
+	rows := make([]map[string]interface{}, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		row, err := jamfpro.MarshalResourceMap(rv.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// rowColumns returns the scalar attributes present in any row, id and name first and the rest sorted
+func rowColumns(rows []map[string]interface{}) []string {
+	seen := map[string]bool{}
+	var rest []string
+	for _, row := range rows {
+		for key, value := range row {
+			switch value.(type) {
+			case []interface{}, map[string]interface{}:
+				continue
+			}
+			if !seen[key] {
+				seen[key] = true
+				if key != "id" && key != "name" {
+					rest = append(rest, key)
+				}
+			}
+		}
+	}
+	sort.Strings(rest)
+
+	var columns []string
+	for _, key := range []string{"id", "name"} {
+		if seen[key] {
+			columns = append(columns, key)
+		}
+	}
+	return append(columns, rest...)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/jc0b/go-jamfpro-api/jamfpro"
+	"github.com/spf13/cobra"
+)
+
+const defaultKeychainService = "jamfctl"
+
+// globalOptions holds the flags shared by every command
+type globalOptions struct {
+	instance        string
+	clientId        string
+	clientSecret    string
+	keychainService string
+	output          string
+}
+
+func newRootCommand() *cobra.Command {
+	opts := &globalOptions{}
+
+	root := &cobra.Command{
+		Use:           "jamfctl",
+		Short:         "Manage Jamf Pro from the command line",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			switch opts.output {
+			case outputJSON, outputTable, outputCSV:
+				return nil
+			}
+			return fmt.Errorf("unknown output format %q, must be %s, %s or %s", opts.output, outputJSON, outputTable, outputCSV)
+		},
+	}
+
+	flags := root.PersistentFlags()
+	flags.StringVar(&opts.instance, "url", os.Getenv("JAMFPRO_URL"), "Jamf Pro URL, e.g. https://example.jamfcloud.com (env JAMFPRO_URL)")
+	flags.StringVar(&opts.clientId, "client-id", os.Getenv("JAMFPRO_CLIENT_ID"), "API client ID (env JAMFPRO_CLIENT_ID)")
+	flags.StringVar(&opts.clientSecret, "client-secret", os.Getenv("JAMFPRO_CLIENT_SECRET"), "API client secret (env JAMFPRO_CLIENT_SECRET)")
+	flags.StringVar(&opts.keychainService, "keychain-service", defaultKeychainService, "macOS keychain service holding the client secret when none is given")
+	flags.StringVarP(&opts.output, "output", "o", outputTable, "output format: json, table or csv")
+
+	root.AddCommand(
+		newBuildingsCommand(opts),
+		newCategoriesCommand(opts),
+		newComputersCommand(opts),
+		newGroupsCommand(opts),
+	)
+	return root
+}
+
+// client returns a Jamf Pro client for the configured instance. Tokens are cached in the user cache directory so
+// consecutive commands do not each request a new one.
+func (o *globalOptions) client(ctx context.Context) (*jamfpro.Client, error) {
+	if o.instance == "" {
+		return nil, fmt.Errorf("no Jamf Pro URL: pass --url or set JAMFPRO_URL")
+	} else if o.clientId == "" {
+		return nil, fmt.Errorf("no client ID: pass --client-id or set JAMFPRO_CLIENT_ID")
+	}
+
+	secret := o.clientSecret
+	if secret == "" {
+		var err error
+		secret, err = keychainSecret(ctx, o.keychainService, o.clientId)
+		if err != nil {
+			return nil, fmt.Errorf("no client secret: pass --client-secret, set JAMFPRO_CLIENT_SECRET or store it in the keychain (%v)", err)
+		}
+	}
+
+	// Report a token cache that cannot be used, rather than silently requesting a token on every run
+	clientOpts := []jamfpro.ClientOption{jamfpro.WithLogger(slog.New(slog.NewTextHandler(os.Stderr, nil)))}
+	if cacheDir, err := os.UserCacheDir(); err == nil {
+		dir := filepath.Join(cacheDir, "jamfctl")
+		if err := os.MkdirAll(dir, 0o700); err == nil {
+			clientOpts = append(clientOpts, jamfpro.WithTokenStore(jamfpro.NewFileTokenStore(filepath.Join(dir, "tokens.json"))))
+		}
+	}
+
+	return jamfpro.NewClient(o.clientId, secret, strings.TrimSuffix(o.instance, "/"), "", clientOpts...)
+}
+
+// keychainSecret reads a generic password from the macOS keychain.
+func keychainSecret(ctx context.Context, service, account string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "security", "find-generic-password", "-s", service, "-a", account, "-w")
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("keychain: %s", msg)
+		}
+		return "", fmt.Errorf("keychain: %w", err)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
@@ -0,0 +1,189 @@
+// Command accessors generates nil-safe Get* accessor methods for every pointer field of the exported structs in a
+// package, so callers can read optional fields without checking each pointer first:
+//
+//	name := building.GetName() // "" if building or building.Name is nil
+//
+// Pointers to basic types return the zero value when nil, pointers to structs are returned as they are, and
+// pointers to slices and maps return a nil slice or map. Fields of types from other packages are skipped.
+//
+// Usage:
+//
+//	go run ./internal/accessors -dir jamfpro -out jamfpro/accessors.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the package to generate accessors for")
+	out := flag.String("out", "accessors.go", "path of the Go file to write")
+	flag.Parse()
+
+	src, err := generate(*dir, filepath.Base(*out))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type accessor struct {
+	typeName  string
+	fieldName string
+	fieldType ast.Expr
+}
+
+func generate(dir, outName string) ([]byte, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return fi.Name() != outName && !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected one package in %s, found %d", dir, len(pkgs))
+	}
+
+	var pkgName string
+	var files []*ast.File
+	for name, pkg := range pkgs {
+		pkgName = name
+		for _, f := range pkg.Files {
+			files = append(files, f)
+		}
+	}
+
+	// underlying records the underlying type of every named type, methods the methods already declared
+	underlying := map[string]ast.Expr{}
+	methods := map[string]bool{}
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok {
+						underlying[ts.Name.Name] = ts.Type
+					}
+				}
+			case *ast.FuncDecl:
+				if d.Recv != nil && len(d.Recv.List) == 1 {
+					methods[receiverType(d.Recv.List[0].Type)+"."+d.Name.Name] = true
+				}
+			}
+		}
+	}
+
+	var accessors []accessor
+	for typeName, expr := range underlying {
+		st, ok := expr.(*ast.StructType)
+		if !ok || !ast.IsExported(typeName) {
+			continue
+		}
+		for _, field := range st.Fields.List {
+			star, ok := field.Type.(*ast.StarExpr)
+			if !ok || foreign(star.X) {
+				continue
+			}
+			for _, name := range field.Names {
+				if !name.IsExported() || methods[typeName+".Get"+name.Name] {
+					continue
+				}
+				accessors = append(accessors, accessor{typeName: typeName, fieldName: name.Name, fieldType: star.X})
+			}
+		}
+	}
+	sort.Slice(accessors, func(i, j int) bool {
+		if accessors[i].typeName != accessors[j].typeName {
+			return accessors[i].typeName < accessors[j].typeName
+		}
+		return accessors[i].fieldName < accessors[j].fieldName
+	})
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by internal/accessors. DO NOT EDIT.\n\npackage %s\n", pkgName)
+	for _, a := range accessors {
+		recv := strings.ToLower(a.typeName[:1])
+		typ := exprString(fset, a.fieldType)
+
+		fmt.Fprintf(&buf, "\n// Get%s returns the %s field if it's non-nil, zero value otherwise.\n", a.fieldName, a.fieldName)
+		switch t := a.fieldType.(type) {
+		case *ast.ArrayType, *ast.MapType:
+			fmt.Fprintf(&buf, "func (%s *%s) Get%s() %s {\nif %s == nil || %s.%s == nil {\nreturn nil\n}\nreturn *%s.%s\n}\n",
+				recv, a.typeName, a.fieldName, typ, recv, recv, a.fieldName, recv, a.fieldName)
+		default:
+			if zero, ok := zeroValue(t, underlying); ok {
+				fmt.Fprintf(&buf, "func (%s *%s) Get%s() %s {\nif %s == nil || %s.%s == nil {\nreturn %s\n}\nreturn *%s.%s\n}\n",
+					recv, a.typeName, a.fieldName, typ, recv, recv, a.fieldName, zero, recv, a.fieldName)
+			} else {
+				fmt.Fprintf(&buf, "func (%s *%s) Get%s() *%s {\nif %s == nil {\nreturn nil\n}\nreturn %s.%s\n}\n",
+					recv, a.typeName, a.fieldName, typ, recv, recv, a.fieldName)
+			}
+		}
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// zeroValue returns the zero value literal of a basic type, or of a named type whose underlying type is basic
+func zeroValue(expr ast.Expr, underlying map[string]ast.Expr) (string, bool) {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+	switch ident.Name {
+	case "string":
+		return `""`, true
+	case "bool":
+		return "false", true
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64", "byte", "rune":
+		return "0", true
+	}
+	if u, ok := underlying[ident.Name]; ok {
+		if _, isIdent := u.(*ast.Ident); isIdent {
+			return zeroValue(u, underlying)
+		}
+	}
+	return "", false
+}
+
+func receiverType(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// foreign reports whether expr refers to a type from another package; accessors for those would need imports
+func foreign(expr ast.Expr) bool {
+	found := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if _, ok := n.(*ast.SelectorExpr); ok {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	format.Node(&buf, fset, expr)
+	return buf.String()
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestAccessorsUpToDate fails when jamfpro/accessors.go is not what the generator writes for the package, i.e. a
+// pointer field was added or changed without running go generate.
+func TestAccessorsUpToDate(t *testing.T) {
+	path := filepath.Join("..", "..", "jamfpro", "accessors.go")
+
+	want, err := generate(filepath.Dir(path), filepath.Base(path))
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s is out of date, run go run ./internal/accessors -dir jamfpro -out jamfpro/accessors.go", path)
+	}
+}
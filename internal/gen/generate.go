@@ -0,0 +1,383 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// generator accumulates the Go source for one configuration
+type generator struct {
+	doc   *document
+	cfg   *config
+	buf   bytes.Buffer
+	types map[string]bool // component schemas already emitted
+	queue []string        // component schemas still to emit
+}
+
+func generate(doc *document, cfg *config, pkg string) ([]byte, error) {
+	g := &generator{doc: doc, cfg: cfg, types: map[string]bool{}}
+
+	g.printf("// Code generated by internal/gen from the Jamf Pro OpenAPI schema. DO NOT EDIT.\n\n")
+	g.printf("package %s\n\n", pkg)
+
+	var services bytes.Buffer
+	for _, res := range cfg.Resources {
+		src, err := g.service(res)
+		if err != nil {
+			return nil, fmt.Errorf("resource %s: %w", res.Name, err)
+		}
+		services.Write(src)
+	}
+
+	if len(cfg.Resources) > 0 {
+		g.printf("import (\n\"context\"\n\"net/http\"\n)\n\n")
+	}
+	g.buf.Write(services.Bytes())
+
+	for len(g.queue) > 0 {
+		name := g.queue[0]
+		g.queue = g.queue[1:]
+		s, ok := doc.Components.Schemas[name]
+		if !ok {
+			return nil, fmt.Errorf("schema %s is referenced but not defined", name)
+		}
+		g.structType(g.typeName(name), s)
+	}
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source: %w\n%s", err, g.buf.Bytes())
+	}
+	return src, nil
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// service emits the interface, implementation and constructor of one resource
+func (g *generator) service(res resource) ([]byte, error) {
+	var b bytes.Buffer
+	w := func(format string, args ...interface{}) { fmt.Fprintf(&b, format, args...) }
+
+	collection := g.doc.Paths[res.Path]
+	item := g.doc.Paths[res.Path+"/{id}"]
+
+	iface := res.Plural + "Service"
+	op := iface + "Op"
+	base := lowerFirst(res.Plural) + "GeneratedBasePath"
+	recv := strings.ToLower(res.Plural[:1])
+
+	var methods []string
+	var bodies bytes.Buffer
+	wb := func(format string, args ...interface{}) { fmt.Fprintf(&bodies, format, args...) }
+
+	if res.has("list") {
+		o := collection["get"]
+		if o == nil {
+			return nil, fmt.Errorf("no GET operation for %s", res.Path)
+		}
+		elem, paged, err := g.listElem(o.responseSchema())
+		if err != nil {
+			return nil, err
+		}
+		if paged {
+			methods = append(methods, fmt.Sprintf("List(context.Context, *ListOptions) ([]%s, *Response, error)", elem))
+			wb("func (%s *%s) List(ctx context.Context, opts *ListOptions) ([]%s, *Response, error) {\n", recv, op, elem)
+			wb("path, err := addOptions(%s, opts)\nif err != nil {\nreturn nil, nil, err\n}\n\n", base)
+			wb("req, err := %s.client.NewRequest(ctx, http.MethodGet, path, nil, \"application/json\")\n", recv)
+			wb("if err != nil {\nreturn nil, nil, err\n}\n\n")
+			wb("var listResponse struct {\nTotalCount int `json:\"totalCount\"`\nResults []%s `json:\"results\"`\n}\n", elem)
+			wb("resp, err := %s.client.Do(ctx, req, &listResponse)\nif err != nil {\nreturn nil, resp, err\n}\n\n", recv)
+			wb("return listResponse.Results, resp, err\n}\n\n")
+		} else {
+			methods = append(methods, fmt.Sprintf("List(context.Context) ([]%s, *Response, error)", elem))
+			wb("func (%s *%s) List(ctx context.Context) ([]%s, *Response, error) {\n", recv, op, elem)
+			wb("req, err := %s.client.NewRequest(ctx, http.MethodGet, %s, nil, \"application/json\")\n", recv, base)
+			wb("if err != nil {\nreturn nil, nil, err\n}\n\n")
+			wb("var items []%s\n", elem)
+			wb("resp, err := %s.client.Do(ctx, req, &items)\nif err != nil {\nreturn nil, resp, err\n}\n\n", recv)
+			wb("return items, resp, err\n}\n\n")
+		}
+	}
+
+	var getType string
+	if res.has("get") {
+		o := item["get"]
+		if o == nil {
+			return nil, fmt.Errorf("no GET operation for %s/{id}", res.Path)
+		}
+		getType = g.refType(o.responseSchema())
+		if getType == "" {
+			return nil, fmt.Errorf("GET %s/{id} does not return a component schema", res.Path)
+		}
+		methods = append(methods, fmt.Sprintf("GetByID(context.Context, string) (*%s, *Response, error)", getType))
+		wb("func (%s *%s) GetByID(ctx context.Context, id string) (*%s, *Response, error) {\n", recv, op, getType)
+		wb("if id == \"\" {\nreturn nil, nil, NewArgError(\"id\", \"cannot be empty\")\n}\n")
+		wb("path := pathJoin(%s, id)\n\n", base)
+		wb("req, err := %s.client.NewRequest(ctx, http.MethodGet, path, nil, \"application/json\")\n", recv)
+		wb("if err != nil {\nreturn nil, nil, err\n}\n\n")
+		wb("result := new(%s)\n", getType)
+		wb("resp, err := %s.client.Do(ctx, req, result)\nif err != nil {\nreturn nil, resp, err\n}\n\n", recv)
+		wb("return result, resp, err\n}\n\n")
+	}
+
+	if res.has("create") {
+		o := collection["post"]
+		if o == nil {
+			return nil, fmt.Errorf("no POST operation for %s", res.Path)
+		}
+		reqType := g.refType(o.requestSchema())
+		respType := g.refType(o.responseSchema())
+		if reqType == "" || respType == "" {
+			return nil, fmt.Errorf("POST %s does not use component schemas", res.Path)
+		}
+		methods = append(methods, fmt.Sprintf("Create(context.Context, *%s) (*%s, *Response, error)", reqType, respType))
+		wb("func (%s *%s) Create(ctx context.Context, request *%s) (*%s, *Response, error) {\n", recv, op, reqType, respType)
+		wb("if request == nil {\nreturn nil, nil, NewArgError(\"createRequest\", \"cannot be nil\")\n}\n\n")
+		wb("req, err := %s.client.NewRequest(ctx, http.MethodPost, %s, request, \"application/json\")\n", recv, base)
+		wb("if err != nil {\nreturn nil, nil, err\n}\n\n")
+		wb("result := new(%s)\n", respType)
+		wb("resp, err := %s.client.Do(ctx, req, result)\nif err != nil {\nreturn nil, resp, err\n}\n\n", recv)
+		wb("return result, resp, err\n}\n\n")
+	}
+
+	if res.has("update") {
+		o := item["put"]
+		if o == nil {
+			return nil, fmt.Errorf("no PUT operation for %s/{id}", res.Path)
+		}
+		reqType := g.refType(o.requestSchema())
+		respType := g.refType(o.responseSchema())
+		if reqType == "" || respType == "" {
+			return nil, fmt.Errorf("PUT %s/{id} does not use component schemas", res.Path)
+		}
+		methods = append(methods, fmt.Sprintf("Update(context.Context, string, *%s) (*%s, *Response, error)", reqType, respType))
+		wb("func (%s *%s) Update(ctx context.Context, id string, request *%s) (*%s, *Response, error) {\n", recv, op, reqType, respType)
+		wb("if request == nil {\nreturn nil, nil, NewArgError(\"updateRequest\", \"cannot be nil\")\n} else if id == \"\" {\nreturn nil, nil, NewArgError(\"id\", \"cannot be empty\")\n}\n")
+		wb("path := pathJoin(%s, id)\n\n", base)
+		wb("req, err := %s.client.NewRequest(ctx, http.MethodPut, path, request, \"application/json\")\n", recv)
+		wb("if err != nil {\nreturn nil, nil, err\n}\n\n")
+		wb("result := new(%s)\n", respType)
+		wb("resp, err := %s.client.Do(ctx, req, result)\nif err != nil {\nreturn nil, resp, err\n}\n\n", recv)
+		wb("return result, resp, err\n}\n\n")
+	}
+
+	if res.has("delete") {
+		if item["delete"] == nil {
+			return nil, fmt.Errorf("no DELETE operation for %s/{id}", res.Path)
+		}
+		methods = append(methods, "Delete(context.Context, string) (*Response, error)")
+		wb("func (%s *%s) Delete(ctx context.Context, id string) (*Response, error) {\n", recv, op)
+		wb("if id == \"\" {\nreturn nil, NewArgError(\"id\", \"cannot be empty\")\n}\n")
+		wb("path := pathJoin(%s, id)\n\n", base)
+		wb("req, err := %s.client.NewRequest(ctx, http.MethodDelete, path, nil, \"application/json\")\n", recv)
+		wb("if err != nil {\nreturn nil, err\n}\n\n")
+		wb("return %s.client.Do(ctx, req, nil)\n}\n\n", recv)
+	}
+
+	if len(methods) == 0 {
+		return nil, fmt.Errorf("no operations configured")
+	}
+
+	w("const %s = \"uapi%s\"\n\n", base, res.Path)
+	w("// %s is generated from the %s operations of the Jamf Pro API.\n", iface, res.Path)
+	w("type %s interface {\n%s\n}\n\n", iface, strings.Join(methods, "\n"))
+	w("// %s handles communication with the %s methods of the Jamf Pro API.\n", op, res.Path)
+	w("type %s struct {\nclient *Client\n}\n\n", op)
+	w("var _ %s = &%s{}\n\n", iface, op)
+	w("// New%s returns a %s that uses client.\n", iface, iface)
+	w("func New%s(client *Client) %s {\nreturn &%s{client: client}\n}\n\n", iface, iface, op)
+	b.Write(bodies.Bytes())
+	return b.Bytes(), nil
+}
+
+// listElem returns the element type of a list response, and whether the response is a paginated
+// {totalCount, results} envelope
+func (g *generator) listElem(s *schema) (string, bool, error) {
+	if s == nil {
+		return "", false, fmt.Errorf("list operation has no JSON response")
+	}
+	if s.Type == "array" {
+		if t := g.refType(s.Items); t != "" {
+			return t, false, nil
+		}
+		return "", false, fmt.Errorf("list response items are not a component schema")
+	}
+
+	envelope := s
+	if name := s.refName(); name != "" {
+		envelope = g.doc.Components.Schemas[name]
+	}
+	if envelope != nil {
+		if results, ok := envelope.Properties["results"]; ok && results.Type == "array" {
+			if t := g.refType(results.Items); t != "" {
+				return t, true, nil
+			}
+		}
+	}
+	return "", false, fmt.Errorf("list response is neither an array nor a results envelope")
+}
+
+// refType returns the Go type name of the component schema s refers to, queueing it for generation
+func (g *generator) refType(s *schema) string {
+	name := s.refName()
+	if name == "" {
+		return ""
+	}
+	if !g.types[name] {
+		g.types[name] = true
+		g.queue = append(g.queue, name)
+	}
+	return g.typeName(name)
+}
+
+func (g *generator) typeName(schemaName string) string {
+	if renamed, ok := g.cfg.TypeNames[schemaName]; ok {
+		return renamed
+	}
+	return exportedName(schemaName)
+}
+
+// structType emits a struct for an object schema; inline object properties become their own types named after
+// the parent
+func (g *generator) structType(name string, s *schema) {
+	props, required := flatten(g.doc, s)
+
+	var nested []func()
+	g.printf("// %s is generated from the Jamf Pro OpenAPI schema.\n", name)
+	g.printf("type %s struct {\n", name)
+
+	keys := make([]string, 0, len(props))
+	for k := range props {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		prop := props[key]
+		field := exportedName(key)
+		goType := g.goType(prop, func(t string, inline *schema) {
+			nested = append(nested, func() { g.structType(t, inline) })
+		}, name+field)
+		if key == "id" && (goType == "string" || goType == "int" || goType == "int64") {
+			goType = "ID"
+		}
+
+		tag := key
+		if !required[key] {
+			tag += ",omitempty"
+			if !strings.HasPrefix(goType, "[]") && !strings.HasPrefix(goType, "map[") && goType != "interface{}" {
+				goType = "*" + goType
+			}
+		}
+		g.printf("%s %s `json:\"%s\"`\n", field, goType, tag)
+	}
+	g.printf("}\n\n")
+
+	for _, emit := range nested {
+		emit()
+	}
+}
+
+// goType maps a schema to a Go type, calling inline for object properties that need a type of their own
+func (g *generator) goType(s *schema, inline func(string, *schema), inlineName string) string {
+	if t := g.refType(s); t != "" {
+		return t
+	}
+	if len(s.AllOf) == 1 {
+		return g.goType(s.AllOf[0], inline, inlineName)
+	}
+
+	switch s.Type {
+	case "string":
+		return "string"
+	case "integer":
+		if s.Format == "int64" {
+			return "int64"
+		}
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		if s.Items == nil {
+			return "[]interface{}"
+		}
+		return "[]" + g.goType(s.Items, inline, inlineName)
+	case "object", "":
+		if len(s.Properties) == 0 && len(s.AllOf) == 0 {
+			if s.Type == "object" {
+				return "map[string]interface{}"
+			}
+			return "interface{}"
+		}
+		inline(inlineName, s)
+		return inlineName
+	}
+	return "interface{}"
+}
+
+// flatten merges the properties of s with those of any allOf members
+func flatten(doc *document, s *schema) (map[string]*schema, map[string]bool) {
+	props := map[string]*schema{}
+	required := map[string]bool{}
+
+	var walk func(*schema)
+	walk = func(s *schema) {
+		if s == nil {
+			return
+		}
+		if name := s.refName(); name != "" {
+			walk(doc.Components.Schemas[name])
+			return
+		}
+		for _, member := range s.AllOf {
+			walk(member)
+		}
+		for k, v := range s.Properties {
+			props[k] = v
+		}
+		for _, r := range s.Required {
+			required[r] = true
+		}
+	}
+	walk(s)
+	return props, required
+}
+
+// exportedName converts a schema or property name such as "streetAddress1" or "computer-group" to an exported Go
+// identifier, spelling "id" as "Id" to match the hand-written services
+func exportedName(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			b.WriteRune(unicode.ToUpper(r))
+			upper = false
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	out := b.String()
+	if out == "" || unicode.IsDigit(rune(out[0])) {
+		out = "X" + out
+	}
+	return out
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden file")
+
+// generateFixture generates the services configured in testdata/resources.json from testdata/schema.json, an
+// excerpt of the Jamf Pro OpenAPI schema.
+func generateFixture(t *testing.T) []byte {
+	t.Helper()
+
+	doc, err := loadSpec(filepath.Join("testdata", "schema.json"))
+	if err != nil {
+		t.Fatalf("loadSpec: %v", err)
+	}
+	cfg, err := loadConfig(filepath.Join("testdata", "resources.json"))
+	if err != nil {
+		t.Fatalf("loadConfig: %v", err)
+	}
+	src, err := generate(doc, cfg, "jamfpro")
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	return src
+}
+
+func TestGenerateGolden(t *testing.T) {
+	got := generateFixture(t)
+
+	golden := filepath.Join("testdata", "zz_generated.go.golden")
+	if *update {
+		if err := os.WriteFile(golden, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("generated source differs from %s, run go test ./internal/gen -update and review the diff:\n%s", golden, got)
+	}
+}
+
+// TestGenerateCompiles type-checks the generated source together with the jamfpro package, so the templates cannot
+// drift from the helpers they call.
+func TestGenerateCompiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("type-checks the jamfpro package from source")
+	}
+
+	dir := filepath.Join("..", "..", "jamfpro")
+	fset := token.NewFileSet()
+	packages, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		// The committed generated file is replaced by the fixture's.
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != "zz_generated.go"
+	}, 0)
+	if err != nil {
+		t.Fatalf("parsing %s: %v", dir, err)
+	}
+
+	generated, err := parser.ParseFile(fset, "zz_generated.go", generateFixture(t), 0)
+	if err != nil {
+		t.Fatalf("parsing the generated source: %v", err)
+	}
+	files := []*ast.File{generated}
+	for _, file := range packages["jamfpro"].Files {
+		files = append(files, file)
+	}
+
+	// Only errors in the generated source count: the accessors of the committed generated types are left dangling.
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			if typeErr, ok := err.(types.Error); ok && typeErr.Fset.Position(typeErr.Pos).Filename == "zz_generated.go" {
+				t.Errorf("the generated source does not compile with the package: %v", err)
+			}
+		},
+	}
+	conf.Check("github.com/jc0b/go-jamfpro-api/jamfpro", fset, files, nil)
+}
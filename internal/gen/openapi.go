@@ -0,0 +1,100 @@
+package main
+
+import "strings"
+
+// document is the subset of an OpenAPI 3 document the generator understands
+type document struct {
+	Paths      map[string]map[string]*operation `json:"paths"`
+	Components struct {
+		Schemas map[string]*schema `json:"schemas"`
+	} `json:"components"`
+}
+
+type operation struct {
+	Summary     string               `json:"summary"`
+	RequestBody *body                `json:"requestBody"`
+	Responses   map[string]*response `json:"responses"`
+}
+
+type body struct {
+	Content map[string]*mediaType `json:"content"`
+}
+
+type response struct {
+	Content map[string]*mediaType `json:"content"`
+}
+
+type mediaType struct {
+	Schema *schema `json:"schema"`
+}
+
+type schema struct {
+	Ref         string             `json:"$ref"`
+	Type        string             `json:"type"`
+	Format      string             `json:"format"`
+	Description string             `json:"description"`
+	Properties  map[string]*schema `json:"properties"`
+	Required    []string           `json:"required"`
+	Items       *schema            `json:"items"`
+	AllOf       []*schema          `json:"allOf"`
+	Enum        []interface{}      `json:"enum"`
+}
+
+// refName returns the name of the component schema s refers to, or "" if s is not a reference
+func (s *schema) refName() string {
+	const prefix = "#/components/schemas/"
+	if s == nil || !strings.HasPrefix(s.Ref, prefix) {
+		return ""
+	}
+	return strings.TrimPrefix(s.Ref, prefix)
+}
+
+// jsonSchema returns the JSON schema of a request body or response, if it has one
+func jsonSchema(content map[string]*mediaType) *schema {
+	for contentType, media := range content {
+		if strings.Contains(contentType, "json") && media != nil {
+			return media.Schema
+		}
+	}
+	return nil
+}
+
+func (o *operation) requestSchema() *schema {
+	if o == nil || o.RequestBody == nil {
+		return nil
+	}
+	return jsonSchema(o.RequestBody.Content)
+}
+
+func (o *operation) responseSchema() *schema {
+	if o == nil {
+		return nil
+	}
+	for _, code := range []string{"200", "201", "202"} {
+		if r, ok := o.Responses[code]; ok && r != nil {
+			return jsonSchema(r.Content)
+		}
+	}
+	return nil
+}
+
+type config struct {
+	Resources []resource        `json:"resources"`
+	TypeNames map[string]string `json:"typeNames"`
+}
+
+type resource struct {
+	Name       string   `json:"name"`
+	Plural     string   `json:"plural"`
+	Path       string   `json:"path"`
+	Operations []string `json:"operations"`
+}
+
+func (r resource) has(op string) bool {
+	for _, o := range r.Operations {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
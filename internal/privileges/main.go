@@ -0,0 +1,234 @@
+// Command privileges generates a constant for every privilege an API role can be granted, from the list in
+// privileges.json, grouped by the object the privilege applies to:
+//
+//	PrivilegeReadComputers = "Read Computers"
+//
+// With -instance, the list is first refreshed from the api-role-privileges endpoint of that Jamf Pro instance, using
+// the API client credentials in JAMFPRO_CLIENT_ID and JAMFPRO_CLIENT_SECRET.
+//
+// Usage:
+//
+//	go run ./internal/privileges -in internal/privileges/privileges.json -out jamfpro/privilegenames.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// objectVerbs are the verbs of privileges that are grouped by the object they apply to
+var objectVerbs = []string{"Create", "Read", "Update", "Delete"}
+
+type privilegeList struct {
+	Privileges []string `json:"privileges"`
+}
+
+func main() {
+	in := flag.String("in", "", "path of the JSON privilege list")
+	out := flag.String("out", "", "path of the Go file to write")
+	pkg := flag.String("package", "jamfpro", "package name of the generated file")
+	instance := flag.String("instance", "", "URL of a Jamf Pro instance to refresh the privilege list from")
+	flag.Parse()
+
+	if *in == "" || *out == "" {
+		log.Fatal("-in and -out are required")
+	}
+
+	if *instance != "" {
+		privileges, err := fetchPrivileges(*instance, os.Getenv("JAMFPRO_CLIENT_ID"), os.Getenv("JAMFPRO_CLIENT_SECRET"))
+		if err != nil {
+			log.Fatalf("fetching privileges from %s: %v", *instance, err)
+		}
+		if err := writeList(*in, privileges); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	data, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var list privilegeList
+	if err := json.Unmarshal(data, &list); err != nil {
+		log.Fatalf("decoding %s: %v", *in, err)
+	}
+
+	src, err := generate(*pkg, list.Privileges)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the formatted source declaring a constant for each privilege
+func generate(pkg string, privileges []string) ([]byte, error) {
+	groups := make(map[string][]string)
+	var other []string
+	for _, privilege := range privileges {
+		if object, ok := objectOf(privilege); ok {
+			groups[object] = append(groups[object], privilege)
+		} else {
+			other = append(other, privilege)
+		}
+	}
+
+	objects := make([]string, 0, len(groups))
+	for object := range groups {
+		objects = append(objects, object)
+	}
+	sort.Slice(objects, func(i, j int) bool { return strings.ToLower(objects[i]) < strings.ToLower(objects[j]) })
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by internal/privileges from internal/privileges/privileges.json. DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintf(&buf, "package %s\n", pkg)
+
+	names := make(map[string]string)
+	writeGroup := func(comment string, privileges []string) error {
+		fmt.Fprintf(&buf, "\n// %s\nconst (\n", comment)
+		for _, privilege := range privileges {
+			name := constantName(privilege)
+			if clash, ok := names[name]; ok {
+				return fmt.Errorf("privileges %q and %q would both be named %s", clash, privilege, name)
+			}
+			names[name] = privilege
+			fmt.Fprintf(&buf, "\t%s = %q\n", name, privilege)
+		}
+		fmt.Fprintln(&buf, ")")
+		return nil
+	}
+
+	for _, object := range objects {
+		if err := writeGroup("Privileges for "+object, sortByVerb(groups[object])); err != nil {
+			return nil, err
+		}
+	}
+	if len(other) > 0 {
+		sort.Strings(other)
+		if err := writeGroup("Privileges for commands and sensitive information", other); err != nil {
+			return nil, err
+		}
+	}
+
+	fmt.Fprintln(&buf, "\n// AllPrivileges lists every privilege that has a constant, as of when they were generated.")
+	fmt.Fprintln(&buf, "var AllPrivileges = []string{")
+	all := make([]string, 0, len(names))
+	for name := range names {
+		all = append(all, name)
+	}
+	sort.Strings(all)
+	for _, name := range all {
+		fmt.Fprintf(&buf, "\t%s,\n", name)
+	}
+	fmt.Fprintln(&buf, "}")
+
+	return format.Source(buf.Bytes())
+}
+
+// objectOf returns the object a create, read, update or delete privilege applies to
+func objectOf(privilege string) (string, bool) {
+	for _, verb := range objectVerbs {
+		if object, ok := strings.CutPrefix(privilege, verb+" "); ok {
+			return object, true
+		}
+	}
+	return "", false
+}
+
+// sortByVerb orders the privileges of an object as create, read, update, delete
+func sortByVerb(privileges []string) []string {
+	rank := func(privilege string) int {
+		for i, verb := range objectVerbs {
+			if strings.HasPrefix(privilege, verb+" ") {
+				return i
+			}
+		}
+		return len(objectVerbs)
+	}
+	sort.Slice(privileges, func(i, j int) bool { return rank(privileges[i]) < rank(privileges[j]) })
+	return privileges
+}
+
+// constantName turns a privilege into an exported Go identifier, e.g. Read macOS Configuration Profiles into
+// PrivilegeReadMacOSConfigurationProfiles.
+func constantName(privilege string) string {
+	var name strings.Builder
+	name.WriteString("Privilege")
+	for _, word := range strings.FieldsFunc(privilege, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		name.WriteString(string(runes))
+	}
+	return name.String()
+}
+
+// fetchPrivileges lists the privileges of the Jamf Pro instance at instance
+func fetchPrivileges(instance, clientId, clientSecret string) ([]string, error) {
+	if clientId == "" || clientSecret == "" {
+		return nil, fmt.Errorf("JAMFPRO_CLIENT_ID and JAMFPRO_CLIENT_SECRET must be set")
+	}
+	instance = strings.TrimSuffix(instance, "/")
+
+	form := url.Values{"client_id": {clientId}, "client_secret": {clientSecret}, "grant_type": {"client_credentials"}}
+	resp, err := http.PostForm(instance+"/api/oauth/token", form)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("requesting a token: %s", resp.Status)
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, instance+"/uapi/v1/api-role-privileges", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	req.Header.Set("Accept", "application/json")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("listing privileges: %s", resp.Status)
+	}
+
+	var list privilegeList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, err
+	}
+	return list.Privileges, nil
+}
+
+// writeList saves privileges to the privilege list at path, sorted
+func writeList(path string, privileges []string) error {
+	sorted := append([]string(nil), privileges...)
+	sort.Slice(sorted, func(i, j int) bool { return strings.ToLower(sorted[i]) < strings.ToLower(sorted[j]) })
+
+	data, err := json.MarshalIndent(privilegeList{Privileges: sorted}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
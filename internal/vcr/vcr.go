@@ -0,0 +1,300 @@
+// Package vcr records the HTTP interactions of a jamfpro.Client with a live Jamf Pro instance to a fixture file, and
+// replays them later, so service tests can run without an instance:
+//
+//	recorder, err := vcr.New("testdata/categories.json", vcr.ModeFromEnv())
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer recorder.Stop()
+//	client, err := jamfpro.NewClient(clientId, clientSecret, instance, "", jamfpro.WithHTTPClient(recorder.Client()))
+//
+// Run the tests with JAMFPRO_VCR=record and real credentials to record fixtures. Credentials, tokens, cookies and
+// the instance host name are removed before fixtures are written, so they can be committed.
+package vcr
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Mode is whether a Recorder talks to a live instance or replays a fixture
+type Mode int
+
+const (
+	// ModeReplay answers requests from the fixture, failing those it has no recorded interaction for.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to the instance and records them, overwriting the fixture when stopped.
+	ModeRecord
+)
+
+// envMode is the environment variable ModeFromEnv reads
+const envMode = "JAMFPRO_VCR"
+
+// sanitizedHost replaces the host of the recorded instance in fixtures
+const sanitizedHost = "jamf.example.com"
+
+// redacted replaces secrets in fixtures
+const redacted = "REDACTED"
+
+// droppedHeaders are removed from recorded interactions: credentials, and headers sanitizing would make wrong
+var droppedHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "Content-Length"}
+
+// sensitiveFields matches the values of credentials and tokens in form and JSON bodies
+var sensitiveFields = regexp.MustCompile(`("?(?:client_secret|client_id|access_token|token|password)"?\s*[=:]\s*"?)([^"&,}\s]+)`)
+
+// ModeFromEnv returns ModeRecord if JAMFPRO_VCR is set to record, and ModeReplay otherwise.
+func ModeFromEnv() Mode {
+	if os.Getenv(envMode) == "record" {
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+// Interaction is a recorded request and the response it got
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper recording or replaying interactions. It is safe for concurrent use, but
+// interactions are replayed in the order they were recorded in for requests to the same URL.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	sanitize  func(*Interaction)
+
+	mu           sync.Mutex
+	interactions []Interaction
+	replayed     []bool
+}
+
+// Option configures a Recorder
+type Option func(*Recorder)
+
+// WithTransport makes a recording Recorder send requests with transport instead of http.DefaultTransport.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// WithSanitizer runs sanitize on every interaction before it is recorded, after the built-in sanitizing, to remove
+// further data that must not end up in fixtures, e.g. serial numbers or user names.
+func WithSanitizer(sanitize func(*Interaction)) Option {
+	return func(r *Recorder) {
+		r.sanitize = sanitize
+	}
+}
+
+// New creates a Recorder for the fixture at path. In ModeReplay the fixture must exist.
+func New(path string, mode Mode, opts ...Option) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode, transport: http.DefaultTransport}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading fixture: %w", err)
+		}
+		if err := json.Unmarshal(data, &r.interactions); err != nil {
+			return nil, fmt.Errorf("decoding fixture %s: %w", path, err)
+		}
+		r.replayed = make([]bool, len(r.interactions))
+	}
+
+	return r, nil
+}
+
+// Client returns an http.Client sending its requests through the Recorder
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == ModeReplay {
+		return r.replay(req)
+	}
+	return r.record(req)
+}
+
+// Stop writes the recorded interactions to the fixture. It does nothing when replaying.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var data bytes.Buffer
+	encoder := json.NewEncoder(&data)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(r.interactions); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, data.Bytes(), 0o644)
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	requestBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		if responseBody, err = decompress(resp, responseBody); err != nil {
+			return nil, err
+		}
+	}
+
+	interaction := Interaction{
+		Request:  Request{Method: req.Method, URL: req.URL.String(), Header: req.Header.Clone(), Body: requestBody},
+		Response: Response{StatusCode: resp.StatusCode, Header: resp.Header.Clone(), Body: responseBody},
+	}
+	sanitize(&interaction, req.URL.Host)
+	if r.sanitize != nil {
+		r.sanitize(&interaction)
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	key := requestKey(req.Method, req.URL)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.replayed[i] {
+			continue
+		}
+		u, err := url.Parse(interaction.Request.URL)
+		if err != nil || requestKey(interaction.Request.Method, u) != key {
+			continue
+		}
+
+		r.replayed[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("vcr: no recorded interaction left in %s for %s", r.path, key)
+}
+
+// requestKey identifies the requests an interaction can be replayed for, regardless of the instance they are sent to
+func requestKey(method string, u *url.URL) string {
+	key := method + " " + u.Path
+	if u.RawQuery != "" {
+		key += "?" + u.Query().Encode()
+	}
+	return key
+}
+
+// sanitize removes credentials, tokens and the instance host from interaction
+func sanitize(interaction *Interaction, host string) {
+	for _, header := range droppedHeaders {
+		interaction.Request.Header.Del(header)
+		interaction.Response.Header.Del(header)
+	}
+
+	if u, err := url.Parse(interaction.Request.URL); err == nil {
+		u.Host = sanitizedHost
+		interaction.Request.URL = u.String()
+	}
+
+	for _, body := range []*string{&interaction.Request.Body, &interaction.Response.Body} {
+		*body = sensitiveFields.ReplaceAllString(*body, "${1}"+redacted)
+		if host != "" {
+			*body = strings.ReplaceAll(*body, host, sanitizedHost)
+		}
+	}
+}
+
+// decompress returns the content of the gzip compressed body of resp, and makes resp carry it uncompressed, so the
+// fixture stores text that can be sanitized and replayed, and the caller reads the same content it would have read
+// from the live response.
+func decompress(resp *http.Response, body string) (string, error) {
+	zr, err := gzip.NewReader(strings.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("vcr: decompressing the response: %w", err)
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return "", fmt.Errorf("vcr: decompressing the response: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = int64(len(data))
+	resp.Uncompressed = true
+	return string(data), nil
+}
+
+// readBody reads and replaces *body, so it can still be read by the caller.
+func readBody(body *io.ReadCloser) (string, error) {
+	if *body == nil || *body == http.NoBody {
+		return "", nil
+	}
+
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return "", err
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+	return string(data), nil
+}
@@ -0,0 +1,171 @@
+package vcr_test
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jc0b/go-jamfpro-api/internal/vcr"
+	"github.com/jc0b/go-jamfpro-api/jamfpro"
+)
+
+const departmentsBody = `{"totalCount":2,"results":[{"id":"1","name":"Finance"},{"id":"2","name":"Design"}]}`
+
+// newInstance starts a server standing in for a Jamf Pro instance, compressing its responses when asked to.
+func newInstance(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := departmentsBody
+		if r.URL.Path == "/api/oauth/token" {
+			http.SetCookie(w, &http.Cookie{Name: "APBALANCEID", Value: "aws.node1"})
+			body = `{"access_token":"secret-token","expires_in":3600}`
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			fmt.Fprint(w, body)
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		fmt.Fprint(zw, body)
+		zw.Close()
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// listDepartments lists the departments of instance through recorder.
+func listDepartments(t *testing.T, recorder *vcr.Recorder, instance string) []jamfpro.Department {
+	t.Helper()
+
+	client, err := jamfpro.NewClient("client-id", "client-secret", instance, "", jamfpro.WithHTTPClient(recorder.Client()))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	departments, _, err := client.Departments.List(context.Background())
+	if err != nil {
+		t.Fatalf("Departments.List: %v", err)
+	}
+	return departments
+}
+
+func TestRecordAndReplayGzipResponses(t *testing.T) {
+	fixture := filepath.Join(t.TempDir(), "departments.json")
+	server := newInstance(t)
+
+	recorder, err := vcr.New(fixture, vcr.ModeRecord)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	recorded := listDepartments(t, recorder, server.URL)
+	if err := recorder.Stop(); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+
+	data, err := os.ReadFile(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "Content-Encoding") {
+		t.Errorf("fixture keeps the Content-Encoding header:\n%s", data)
+	}
+	if !strings.Contains(string(data), `\"name\":\"Finance\"`) {
+		t.Errorf("fixture does not store the response body as text:\n%s", data)
+	}
+
+	replayer, err := vcr.New(fixture, vcr.ModeReplay)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	replayed := listDepartments(t, replayer, "https://jamf.example.com")
+
+	if len(recorded) != 2 || len(replayed) != len(recorded) {
+		t.Fatalf("recorded %+v, replayed %+v", recorded, replayed)
+	}
+	for i := range recorded {
+		if recorded[i] != replayed[i] {
+			t.Errorf("department %d: recorded %+v, replayed %+v", i, recorded[i], replayed[i])
+		}
+	}
+}
+
+func TestRecordSanitizesAndReplays(t *testing.T) {
+	fixture := filepath.Join(t.TempDir(), "departments.json")
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "APBALANCEID", Value: "aws.node1"})
+		w.Header().Set("X-Node", "node1.internal")
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/oauth/token":
+			fmt.Fprint(w, `{"access_token":"secret-token","expires_in":3600}`)
+		case "/uapi/v1/departments/1":
+			fmt.Fprintf(w, `{"id":"1","name":"Finance","href":"%s/uapi/v1/departments/1"}`, server.URL)
+		default:
+			fmt.Fprint(w, departmentsBody)
+		}
+	}))
+	defer server.Close()
+
+	recorder, err := vcr.New(fixture, vcr.ModeRecord, vcr.WithSanitizer(func(interaction *vcr.Interaction) {
+		interaction.Response.Header.Del("X-Node")
+	}))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	client, err := jamfpro.NewClient("client-id", "client-secret", server.URL, "", jamfpro.WithHTTPClient(recorder.Client()))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	recorded, _, err := client.Departments.GetByID(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if err := recorder.Stop(); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+
+	data, err := os.ReadFile(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fixtureText := string(data)
+	for _, secret := range []string{"client-id", "client-secret", "secret-token", "Bearer", "aws.node1", "Set-Cookie", "Authorization", "node1.internal", strings.TrimPrefix(server.URL, "http://")} {
+		if strings.Contains(fixtureText, secret) {
+			t.Errorf("fixture contains %q:\n%s", secret, fixtureText)
+		}
+	}
+	for _, kept := range []string{"client_secret=REDACTED", `\"access_token\":\"REDACTED\"`, "http://jamf.example.com/uapi/v1/departments/1"} {
+		if !strings.Contains(fixtureText, kept) {
+			t.Errorf("fixture does not contain %q:\n%s", kept, fixtureText)
+		}
+	}
+
+	replayer, err := vcr.New(fixture, vcr.ModeReplay)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	client, err = jamfpro.NewClient("client-id", "client-secret", "https://jamf.example.com", "", jamfpro.WithHTTPClient(replayer.Client()))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	replayed, _, err := client.Departments.GetByID(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetByID replaying: %v", err)
+	}
+	if replayed.Id != recorded.Id || replayed.Name != recorded.Name || replayed.Href != "http://jamf.example.com/uapi/v1/departments/1" {
+		t.Errorf("replayed %+v, recorded %+v", *replayed, *recorded)
+	}
+
+	if _, _, err := client.Departments.GetByID(context.Background(), 1); err == nil {
+		t.Error("GetByID succeeded after its only recorded interaction was replayed")
+	}
+}
@@ -0,0 +1,1475 @@
+// Code generated by internal/accessors. DO NOT EDIT.
+
+package jamfpro
+
+// GetSite returns the Site field if it's non-nil, zero value otherwise.
+func (a *AdvancedComputerSearchRequest) GetSite() *ClassicReference {
+	if a == nil {
+		return nil
+	}
+	return a.Site
+}
+
+// GetSite returns the Site field if it's non-nil, zero value otherwise.
+func (a *AdvancedMobileDeviceSearchRequest) GetSite() *ClassicReference {
+	if a == nil {
+		return nil
+	}
+	return a.Site
+}
+
+// GetSite returns the Site field if it's non-nil, zero value otherwise.
+func (a *AdvancedUserSearchRequest) GetSite() *ClassicReference {
+	if a == nil {
+		return nil
+	}
+	return a.Site
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (a *ApiRole) GetDisplayName() string {
+	if a == nil || a.DisplayName == nil {
+		return ""
+	}
+	return *a.DisplayName
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (a *ApiRole) GetId() ID {
+	if a == nil || a.Id == nil {
+		return ""
+	}
+	return *a.Id
+}
+
+// GetPrivileges returns the Privileges field if it's non-nil, zero value otherwise.
+func (a *ApiRole) GetPrivileges() []string {
+	if a == nil || a.Privileges == nil {
+		return nil
+	}
+	return *a.Privileges
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (a *ApiRoleCreateResponse) GetDisplayName() string {
+	if a == nil || a.DisplayName == nil {
+		return ""
+	}
+	return *a.DisplayName
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (a *ApiRoleCreateResponse) GetId() ID {
+	if a == nil || a.Id == nil {
+		return ""
+	}
+	return *a.Id
+}
+
+// GetPrivileges returns the Privileges field if it's non-nil, zero value otherwise.
+func (a *ApiRoleCreateResponse) GetPrivileges() []string {
+	if a == nil || a.Privileges == nil {
+		return nil
+	}
+	return *a.Privileges
+}
+
+// GetApiRoles returns the ApiRoles field if it's non-nil, zero value otherwise.
+func (a *ApiRoleGetResponse) GetApiRoles() []ApiRole {
+	if a == nil || a.ApiRoles == nil {
+		return nil
+	}
+	return *a.ApiRoles
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (a *ApiRoleGetResponse) GetTotalCount() int64 {
+	if a == nil || a.TotalCount == nil {
+		return 0
+	}
+	return *a.TotalCount
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (a *ApiRoleUpdateResponse) GetDisplayName() string {
+	if a == nil || a.DisplayName == nil {
+		return ""
+	}
+	return *a.DisplayName
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (a *ApiRoleUpdateResponse) GetId() ID {
+	if a == nil || a.Id == nil {
+		return ""
+	}
+	return *a.Id
+}
+
+// GetPrivileges returns the Privileges field if it's non-nil, zero value otherwise.
+func (a *ApiRoleUpdateResponse) GetPrivileges() []string {
+	if a == nil || a.Privileges == nil {
+		return nil
+	}
+	return *a.Privileges
+}
+
+// GetCountryCodes returns the CountryCodes field if it's non-nil, zero value otherwise.
+func (a *AppStoreCountryCodeListResponse) GetCountryCodes() []AppStoreCountryCode {
+	if a == nil || a.CountryCodes == nil {
+		return nil
+	}
+	return *a.CountryCodes
+}
+
+// GetCity returns the City field if it's non-nil, zero value otherwise.
+func (b *Building) GetCity() string {
+	if b == nil || b.City == nil {
+		return ""
+	}
+	return *b.City
+}
+
+// GetCountry returns the Country field if it's non-nil, zero value otherwise.
+func (b *Building) GetCountry() string {
+	if b == nil || b.Country == nil {
+		return ""
+	}
+	return *b.Country
+}
+
+// GetHref returns the Href field if it's non-nil, zero value otherwise.
+func (b *Building) GetHref() string {
+	if b == nil || b.Href == nil {
+		return ""
+	}
+	return *b.Href
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (b *Building) GetId() ID {
+	if b == nil || b.Id == nil {
+		return ""
+	}
+	return *b.Id
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (b *Building) GetName() string {
+	if b == nil || b.Name == nil {
+		return ""
+	}
+	return *b.Name
+}
+
+// GetStateProvince returns the StateProvince field if it's non-nil, zero value otherwise.
+func (b *Building) GetStateProvince() string {
+	if b == nil || b.StateProvince == nil {
+		return ""
+	}
+	return *b.StateProvince
+}
+
+// GetStreetAddress1 returns the StreetAddress1 field if it's non-nil, zero value otherwise.
+func (b *Building) GetStreetAddress1() string {
+	if b == nil || b.StreetAddress1 == nil {
+		return ""
+	}
+	return *b.StreetAddress1
+}
+
+// GetStreetAddress2 returns the StreetAddress2 field if it's non-nil, zero value otherwise.
+func (b *Building) GetStreetAddress2() string {
+	if b == nil || b.StreetAddress2 == nil {
+		return ""
+	}
+	return *b.StreetAddress2
+}
+
+// GetZipPostalCode returns the ZipPostalCode field if it's non-nil, zero value otherwise.
+func (b *Building) GetZipPostalCode() string {
+	if b == nil || b.ZipPostalCode == nil {
+		return ""
+	}
+	return *b.ZipPostalCode
+}
+
+// GetHref returns the Href field if it's non-nil, zero value otherwise.
+func (b *BuildingCreateResponse) GetHref() string {
+	if b == nil || b.Href == nil {
+		return ""
+	}
+	return *b.Href
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (b *BuildingCreateResponse) GetId() ID {
+	if b == nil || b.Id == nil {
+		return ""
+	}
+	return *b.Id
+}
+
+// GetBuildings returns the Buildings field if it's non-nil, zero value otherwise.
+func (b *BuildingGetResponse) GetBuildings() []Building {
+	if b == nil || b.Buildings == nil {
+		return nil
+	}
+	return *b.Buildings
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (b *BuildingGetResponse) GetTotalCount() int64 {
+	if b == nil || b.TotalCount == nil {
+		return 0
+	}
+	return *b.TotalCount
+}
+
+// GetCategories returns the Categories field if it's non-nil, zero value otherwise.
+func (c *CategoryListResponse) GetCategories() []Category {
+	if c == nil || c.Categories == nil {
+		return nil
+	}
+	return *c.Categories
+}
+
+// GetCategoryCount returns the CategoryCount field if it's non-nil, zero value otherwise.
+func (c *CategoryListResponse) GetCategoryCount() int64 {
+	if c == nil || c.CategoryCount == nil {
+		return 0
+	}
+	return *c.CategoryCount
+}
+
+// GetComputerGroups returns the ComputerGroups field if it's non-nil, zero value otherwise.
+func (c *ComputerGroupListResponse) GetComputerGroups() []ComputerGroupListItem {
+	if c == nil || c.ComputerGroups == nil {
+		return nil
+	}
+	return *c.ComputerGroups
+}
+
+// GetDiskEncryption returns the DiskEncryption field if it's non-nil, zero value otherwise.
+func (c *ComputerInventory) GetDiskEncryption() *ComputerInventoryDiskEncryption {
+	if c == nil {
+		return nil
+	}
+	return c.DiskEncryption
+}
+
+// GetGeneral returns the General field if it's non-nil, zero value otherwise.
+func (c *ComputerInventory) GetGeneral() *ComputerInventoryGeneral {
+	if c == nil {
+		return nil
+	}
+	return c.General
+}
+
+// GetHardware returns the Hardware field if it's non-nil, zero value otherwise.
+func (c *ComputerInventory) GetHardware() *ComputerInventoryHardware {
+	if c == nil {
+		return nil
+	}
+	return c.Hardware
+}
+
+// GetOperatingSystem returns the OperatingSystem field if it's non-nil, zero value otherwise.
+func (c *ComputerInventory) GetOperatingSystem() *ComputerInventoryOperatingSystem {
+	if c == nil {
+		return nil
+	}
+	return c.OperatingSystem
+}
+
+// GetPurchasing returns the Purchasing field if it's non-nil, zero value otherwise.
+func (c *ComputerInventory) GetPurchasing() *ComputerInventoryPurchasing {
+	if c == nil {
+		return nil
+	}
+	return c.Purchasing
+}
+
+// GetSecurity returns the Security field if it's non-nil, zero value otherwise.
+func (c *ComputerInventory) GetSecurity() *ComputerInventorySecurity {
+	if c == nil {
+		return nil
+	}
+	return c.Security
+}
+
+// GetUserAndLocation returns the UserAndLocation field if it's non-nil, zero value otherwise.
+func (c *ComputerInventory) GetUserAndLocation() *ComputerInventoryUserAndLocation {
+	if c == nil {
+		return nil
+	}
+	return c.UserAndLocation
+}
+
+// GetAssetTag returns the AssetTag field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryGeneralUpdate) GetAssetTag() string {
+	if c == nil || c.AssetTag == nil {
+		return ""
+	}
+	return *c.AssetTag
+}
+
+// GetBarcode1 returns the Barcode1 field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryGeneralUpdate) GetBarcode1() string {
+	if c == nil || c.Barcode1 == nil {
+		return ""
+	}
+	return *c.Barcode1
+}
+
+// GetBarcode2 returns the Barcode2 field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryGeneralUpdate) GetBarcode2() string {
+	if c == nil || c.Barcode2 == nil {
+		return ""
+	}
+	return *c.Barcode2
+}
+
+// GetLastIpAddress returns the LastIpAddress field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryGeneralUpdate) GetLastIpAddress() string {
+	if c == nil || c.LastIpAddress == nil {
+		return ""
+	}
+	return *c.LastIpAddress
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryGeneralUpdate) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetAltMacAddress returns the AltMacAddress field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryHardwareUpdate) GetAltMacAddress() string {
+	if c == nil || c.AltMacAddress == nil {
+		return ""
+	}
+	return *c.AltMacAddress
+}
+
+// GetAltNetworkAdapterType returns the AltNetworkAdapterType field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryHardwareUpdate) GetAltNetworkAdapterType() string {
+	if c == nil || c.AltNetworkAdapterType == nil {
+		return ""
+	}
+	return *c.AltNetworkAdapterType
+}
+
+// GetMacAddress returns the MacAddress field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryHardwareUpdate) GetMacAddress() string {
+	if c == nil || c.MacAddress == nil {
+		return ""
+	}
+	return *c.MacAddress
+}
+
+// GetNetworkAdapterType returns the NetworkAdapterType field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryHardwareUpdate) GetNetworkAdapterType() string {
+	if c == nil || c.NetworkAdapterType == nil {
+		return ""
+	}
+	return *c.NetworkAdapterType
+}
+
+// GetAppleCareId returns the AppleCareId field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryPurchasingUpdate) GetAppleCareId() string {
+	if c == nil || c.AppleCareId == nil {
+		return ""
+	}
+	return *c.AppleCareId
+}
+
+// GetLeaseDate returns the LeaseDate field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryPurchasingUpdate) GetLeaseDate() string {
+	if c == nil || c.LeaseDate == nil {
+		return ""
+	}
+	return *c.LeaseDate
+}
+
+// GetLeased returns the Leased field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryPurchasingUpdate) GetLeased() bool {
+	if c == nil || c.Leased == nil {
+		return false
+	}
+	return *c.Leased
+}
+
+// GetLifeExpectancy returns the LifeExpectancy field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryPurchasingUpdate) GetLifeExpectancy() int {
+	if c == nil || c.LifeExpectancy == nil {
+		return 0
+	}
+	return *c.LifeExpectancy
+}
+
+// GetPoDate returns the PoDate field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryPurchasingUpdate) GetPoDate() string {
+	if c == nil || c.PoDate == nil {
+		return ""
+	}
+	return *c.PoDate
+}
+
+// GetPoNumber returns the PoNumber field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryPurchasingUpdate) GetPoNumber() string {
+	if c == nil || c.PoNumber == nil {
+		return ""
+	}
+	return *c.PoNumber
+}
+
+// GetPurchasePrice returns the PurchasePrice field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryPurchasingUpdate) GetPurchasePrice() string {
+	if c == nil || c.PurchasePrice == nil {
+		return ""
+	}
+	return *c.PurchasePrice
+}
+
+// GetPurchased returns the Purchased field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryPurchasingUpdate) GetPurchased() bool {
+	if c == nil || c.Purchased == nil {
+		return false
+	}
+	return *c.Purchased
+}
+
+// GetPurchasingAccount returns the PurchasingAccount field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryPurchasingUpdate) GetPurchasingAccount() string {
+	if c == nil || c.PurchasingAccount == nil {
+		return ""
+	}
+	return *c.PurchasingAccount
+}
+
+// GetPurchasingContact returns the PurchasingContact field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryPurchasingUpdate) GetPurchasingContact() string {
+	if c == nil || c.PurchasingContact == nil {
+		return ""
+	}
+	return *c.PurchasingContact
+}
+
+// GetVendor returns the Vendor field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryPurchasingUpdate) GetVendor() string {
+	if c == nil || c.Vendor == nil {
+		return ""
+	}
+	return *c.Vendor
+}
+
+// GetWarrantyDate returns the WarrantyDate field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryPurchasingUpdate) GetWarrantyDate() string {
+	if c == nil || c.WarrantyDate == nil {
+		return ""
+	}
+	return *c.WarrantyDate
+}
+
+// GetGeneral returns the General field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryUpdateRequest) GetGeneral() *ComputerInventoryGeneralUpdate {
+	if c == nil {
+		return nil
+	}
+	return c.General
+}
+
+// GetHardware returns the Hardware field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryUpdateRequest) GetHardware() *ComputerInventoryHardwareUpdate {
+	if c == nil {
+		return nil
+	}
+	return c.Hardware
+}
+
+// GetOperatingSystem returns the OperatingSystem field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryUpdateRequest) GetOperatingSystem() *ComputerInventoryOperatingSystemUpdate {
+	if c == nil {
+		return nil
+	}
+	return c.OperatingSystem
+}
+
+// GetPurchasing returns the Purchasing field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryUpdateRequest) GetPurchasing() *ComputerInventoryPurchasingUpdate {
+	if c == nil {
+		return nil
+	}
+	return c.Purchasing
+}
+
+// GetUdid returns the Udid field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryUpdateRequest) GetUdid() string {
+	if c == nil || c.Udid == nil {
+		return ""
+	}
+	return *c.Udid
+}
+
+// GetUserAndLocation returns the UserAndLocation field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryUpdateRequest) GetUserAndLocation() *ComputerInventoryUserAndLocationUpdate {
+	if c == nil {
+		return nil
+	}
+	return c.UserAndLocation
+}
+
+// GetBuildingId returns the BuildingId field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryUserAndLocationUpdate) GetBuildingId() ID {
+	if c == nil || c.BuildingId == nil {
+		return ""
+	}
+	return *c.BuildingId
+}
+
+// GetDepartmentId returns the DepartmentId field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryUserAndLocationUpdate) GetDepartmentId() ID {
+	if c == nil || c.DepartmentId == nil {
+		return ""
+	}
+	return *c.DepartmentId
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryUserAndLocationUpdate) GetEmail() string {
+	if c == nil || c.Email == nil {
+		return ""
+	}
+	return *c.Email
+}
+
+// GetPhone returns the Phone field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryUserAndLocationUpdate) GetPhone() string {
+	if c == nil || c.Phone == nil {
+		return ""
+	}
+	return *c.Phone
+}
+
+// GetPosition returns the Position field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryUserAndLocationUpdate) GetPosition() string {
+	if c == nil || c.Position == nil {
+		return ""
+	}
+	return *c.Position
+}
+
+// GetRealname returns the Realname field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryUserAndLocationUpdate) GetRealname() string {
+	if c == nil || c.Realname == nil {
+		return ""
+	}
+	return *c.Realname
+}
+
+// GetRoom returns the Room field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryUserAndLocationUpdate) GetRoom() string {
+	if c == nil || c.Room == nil {
+		return ""
+	}
+	return *c.Room
+}
+
+// GetUsername returns the Username field if it's non-nil, zero value otherwise.
+func (c *ComputerInventoryUserAndLocationUpdate) GetUsername() string {
+	if c == nil || c.Username == nil {
+		return ""
+	}
+	return *c.Username
+}
+
+// GetEnrollIntoSite returns the EnrollIntoSite field if it's non-nil, zero value otherwise.
+func (c *ComputerInvitationRequest) GetEnrollIntoSite() *ClassicReference {
+	if c == nil {
+		return nil
+	}
+	return c.EnrollIntoSite
+}
+
+// GetComputers returns the Computers field if it's non-nil, zero value otherwise.
+func (c *ComputerListResponse) GetComputers() []Computer {
+	if c == nil || c.Computers == nil {
+		return nil
+	}
+	return *c.Computers
+}
+
+// GetDepartmentCount returns the DepartmentCount field if it's non-nil, zero value otherwise.
+func (d *DepartmentListResponse) GetDepartmentCount() int64 {
+	if d == nil || d.DepartmentCount == nil {
+		return 0
+	}
+	return *d.DepartmentCount
+}
+
+// GetDepartments returns the Departments field if it's non-nil, zero value otherwise.
+func (d *DepartmentListResponse) GetDepartments() []Department {
+	if d == nil || d.Departments == nil {
+		return nil
+	}
+	return *d.Departments
+}
+
+// GetInstitutionalRecoveryKey returns the InstitutionalRecoveryKey field if it's non-nil, zero value otherwise.
+func (d *DiskEncryptionConfiguration) GetInstitutionalRecoveryKey() *DiskEncryptionInstitutionalRecoveryKey {
+	if d == nil {
+		return nil
+	}
+	return d.InstitutionalRecoveryKey
+}
+
+// GetInstitutionalRecoveryKey returns the InstitutionalRecoveryKey field if it's non-nil, zero value otherwise.
+func (d *DiskEncryptionConfigurationRequest) GetInstitutionalRecoveryKey() *DiskEncryptionInstitutionalRecoveryKey {
+	if d == nil {
+		return nil
+	}
+	return d.InstitutionalRecoveryKey
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (e *EbookRequest) GetScope() *Scope {
+	if e == nil {
+		return nil
+	}
+	return e.Scope
+}
+
+// GetSelfService returns the SelfService field if it's non-nil, zero value otherwise.
+func (e *EbookRequest) GetSelfService() *EbookSelfService {
+	if e == nil {
+		return nil
+	}
+	return e.SelfService
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (e *ExternalRecipient) GetEmail() string {
+	if e == nil || e.Email == nil {
+		return ""
+	}
+	return *e.Email
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *ExternalRecipient) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
+	}
+	return *e.Name
+}
+
+// GetGsxKeystore returns the GsxKeystore field if it's non-nil, zero value otherwise.
+func (g *GSXConnection) GetGsxKeystore() *GSXKeystore {
+	if g == nil {
+		return nil
+	}
+	return g.GsxKeystore
+}
+
+// GetLdapServer returns the LdapServer field if it's non-nil, zero value otherwise.
+func (g *GroupAccount) GetLdapServer() *ClassicReference {
+	if g == nil {
+		return nil
+	}
+	return g.LdapServer
+}
+
+// GetSite returns the Site field if it's non-nil, zero value otherwise.
+func (g *GroupAccount) GetSite() *ClassicReference {
+	if g == nil {
+		return nil
+	}
+	return g.Site
+}
+
+// GetLdapServer returns the LdapServer field if it's non-nil, zero value otherwise.
+func (g *GroupAccountRequest) GetLdapServer() *ClassicReference {
+	if g == nil {
+		return nil
+	}
+	return g.LdapServer
+}
+
+// GetPrivileges returns the Privileges field if it's non-nil, zero value otherwise.
+func (g *GroupAccountRequest) GetPrivileges() *AccountPrivileges {
+	if g == nil {
+		return nil
+	}
+	return g.Privileges
+}
+
+// GetSite returns the Site field if it's non-nil, zero value otherwise.
+func (g *GroupAccountRequest) GetSite() *ClassicReference {
+	if g == nil {
+		return nil
+	}
+	return g.Site
+}
+
+// GetStartup returns the Startup field if it's non-nil, zero value otherwise.
+func (h *HealthStatus) GetStartup() *StartupStatus {
+	if h == nil {
+		return nil
+	}
+	return h.Startup
+}
+
+// GetEntries returns the Entries field if it's non-nil, zero value otherwise.
+func (h *HistoryListResponse) GetEntries() []HistoryEntry {
+	if h == nil || h.Entries == nil {
+		return nil
+	}
+	return *h.Entries
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (h *HistoryListResponse) GetTotalCount() int64 {
+	if h == nil || h.TotalCount == nil {
+		return 0
+	}
+	return *h.TotalCount
+}
+
+// GetHref returns the Href field if it's non-nil, zero value otherwise.
+func (h *HrefResponse) GetHref() string {
+	if h == nil || h.Href == nil {
+		return ""
+	}
+	return *h.Href
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (h *HrefResponse) GetId() ID {
+	if h == nil || h.Id == nil {
+		return ""
+	}
+	return *h.Id
+}
+
+// GetAccountId returns the AccountId field if it's non-nil, zero value otherwise.
+func (i *InternalRecipient) GetAccountId() string {
+	if i == nil || i.AccountId == nil {
+		return ""
+	}
+	return *i.AccountId
+}
+
+// GetFrequency returns the Frequency field if it's non-nil, zero value otherwise.
+func (i *InternalRecipient) GetFrequency() string {
+	if i == nil || i.Frequency == nil {
+		return ""
+	}
+	return *i.Frequency
+}
+
+// GetColumns returns the Columns field if it's non-nil, zero value otherwise.
+func (i *InventoryPreloadExtensionAttributeColumnListResponse) GetColumns() []InventoryPreloadExtensionAttributeColumn {
+	if i == nil || i.Columns == nil {
+		return nil
+	}
+	return *i.Columns
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (i *InventoryPreloadExtensionAttributeColumnListResponse) GetTotalCount() int64 {
+	if i == nil || i.TotalCount == nil {
+		return 0
+	}
+	return *i.TotalCount
+}
+
+// GetRecords returns the Records field if it's non-nil, zero value otherwise.
+func (i *InventoryPreloadListResponse) GetRecords() []InventoryPreloadRecord {
+	if i == nil || i.Records == nil {
+		return nil
+	}
+	return *i.Records
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (i *InventoryPreloadListResponse) GetTotalCount() int64 {
+	if i == nil || i.TotalCount == nil {
+		return 0
+	}
+	return *i.TotalCount
+}
+
+// GetProfiles returns the Profiles field if it's non-nil, zero value otherwise.
+func (j *JamfConnectConfigProfileListResponse) GetProfiles() []JamfConnectConfigProfile {
+	if j == nil || j.Profiles == nil {
+		return nil
+	}
+	return *j.Profiles
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (j *JamfConnectConfigProfileListResponse) GetTotalCount() int64 {
+	if j == nil || j.TotalCount == nil {
+		return 0
+	}
+	return *j.TotalCount
+}
+
+// GetTasks returns the Tasks field if it's non-nil, zero value otherwise.
+func (j *JamfConnectDeploymentTaskListResponse) GetTasks() []JamfConnectDeploymentTask {
+	if j == nil || j.Tasks == nil {
+		return nil
+	}
+	return *j.Tasks
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (j *JamfConnectDeploymentTaskListResponse) GetTotalCount() int64 {
+	if j == nil || j.TotalCount == nil {
+		return 0
+	}
+	return *j.TotalCount
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (m *MacOSConfigurationProfileRequest) GetScope() *Scope {
+	if m == nil {
+		return nil
+	}
+	return m.Scope
+}
+
+// GetSelfService returns the SelfService field if it's non-nil, zero value otherwise.
+func (m *MacOSConfigurationProfileRequest) GetSelfService() *MacOSConfigurationProfileSelfService {
+	if m == nil {
+		return nil
+	}
+	return m.SelfService
+}
+
+// GetCommands returns the Commands field if it's non-nil, zero value otherwise.
+func (m *MdmCommandListResponse) GetCommands() []MdmCommand {
+	if m == nil || m.Commands == nil {
+		return nil
+	}
+	return *m.Commands
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (m *MdmCommandListResponse) GetTotalCount() int64 {
+	if m == nil || m.TotalCount == nil {
+		return 0
+	}
+	return *m.TotalCount
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceConfigurationProfileRequest) GetScope() *Scope {
+	if m == nil {
+		return nil
+	}
+	return m.Scope
+}
+
+// GetSelfService returns the SelfService field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceConfigurationProfileRequest) GetSelfService() *MobileDeviceConfigurationProfileSelfService {
+	if m == nil {
+		return nil
+	}
+	return m.SelfService
+}
+
+// GetGeneral returns the General field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventory) GetGeneral() *MobileDeviceInventoryGeneral {
+	if m == nil {
+		return nil
+	}
+	return m.General
+}
+
+// GetHardware returns the Hardware field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventory) GetHardware() *MobileDeviceInventoryHardware {
+	if m == nil {
+		return nil
+	}
+	return m.Hardware
+}
+
+// GetNetwork returns the Network field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventory) GetNetwork() *MobileDeviceInventoryNetwork {
+	if m == nil {
+		return nil
+	}
+	return m.Network
+}
+
+// GetPurchasing returns the Purchasing field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventory) GetPurchasing() *MobileDeviceInventoryPurchasing {
+	if m == nil {
+		return nil
+	}
+	return m.Purchasing
+}
+
+// GetSecurity returns the Security field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventory) GetSecurity() *MobileDeviceInventorySecurity {
+	if m == nil {
+		return nil
+	}
+	return m.Security
+}
+
+// GetUserAndLocation returns the UserAndLocation field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventory) GetUserAndLocation() *MobileDeviceInventoryUserAndLocation {
+	if m == nil {
+		return nil
+	}
+	return m.UserAndLocation
+}
+
+// GetEnrollmentMethodPrestage returns the EnrollmentMethodPrestage field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryGeneral) GetEnrollmentMethodPrestage() *MobileDeviceInventoryPrestage {
+	if m == nil {
+		return nil
+	}
+	return m.EnrollmentMethodPrestage
+}
+
+// GetBuildingId returns the BuildingId field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryLocationUpdate) GetBuildingId() ID {
+	if m == nil || m.BuildingId == nil {
+		return ""
+	}
+	return *m.BuildingId
+}
+
+// GetDepartmentId returns the DepartmentId field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryLocationUpdate) GetDepartmentId() ID {
+	if m == nil || m.DepartmentId == nil {
+		return ""
+	}
+	return *m.DepartmentId
+}
+
+// GetEmailAddress returns the EmailAddress field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryLocationUpdate) GetEmailAddress() string {
+	if m == nil || m.EmailAddress == nil {
+		return ""
+	}
+	return *m.EmailAddress
+}
+
+// GetPhoneNumber returns the PhoneNumber field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryLocationUpdate) GetPhoneNumber() string {
+	if m == nil || m.PhoneNumber == nil {
+		return ""
+	}
+	return *m.PhoneNumber
+}
+
+// GetPosition returns the Position field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryLocationUpdate) GetPosition() string {
+	if m == nil || m.Position == nil {
+		return ""
+	}
+	return *m.Position
+}
+
+// GetRealName returns the RealName field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryLocationUpdate) GetRealName() string {
+	if m == nil || m.RealName == nil {
+		return ""
+	}
+	return *m.RealName
+}
+
+// GetRoom returns the Room field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryLocationUpdate) GetRoom() string {
+	if m == nil || m.Room == nil {
+		return ""
+	}
+	return *m.Room
+}
+
+// GetUsername returns the Username field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryLocationUpdate) GetUsername() string {
+	if m == nil || m.Username == nil {
+		return ""
+	}
+	return *m.Username
+}
+
+// GetAppleCareId returns the AppleCareId field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryPurchasingUpdate) GetAppleCareId() string {
+	if m == nil || m.AppleCareId == nil {
+		return ""
+	}
+	return *m.AppleCareId
+}
+
+// GetLeaseExpiresDate returns the LeaseExpiresDate field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryPurchasingUpdate) GetLeaseExpiresDate() string {
+	if m == nil || m.LeaseExpiresDate == nil {
+		return ""
+	}
+	return *m.LeaseExpiresDate
+}
+
+// GetLeased returns the Leased field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryPurchasingUpdate) GetLeased() bool {
+	if m == nil || m.Leased == nil {
+		return false
+	}
+	return *m.Leased
+}
+
+// GetLifeExpectancy returns the LifeExpectancy field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryPurchasingUpdate) GetLifeExpectancy() int {
+	if m == nil || m.LifeExpectancy == nil {
+		return 0
+	}
+	return *m.LifeExpectancy
+}
+
+// GetPoDate returns the PoDate field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryPurchasingUpdate) GetPoDate() string {
+	if m == nil || m.PoDate == nil {
+		return ""
+	}
+	return *m.PoDate
+}
+
+// GetPoNumber returns the PoNumber field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryPurchasingUpdate) GetPoNumber() string {
+	if m == nil || m.PoNumber == nil {
+		return ""
+	}
+	return *m.PoNumber
+}
+
+// GetPurchasePrice returns the PurchasePrice field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryPurchasingUpdate) GetPurchasePrice() string {
+	if m == nil || m.PurchasePrice == nil {
+		return ""
+	}
+	return *m.PurchasePrice
+}
+
+// GetPurchased returns the Purchased field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryPurchasingUpdate) GetPurchased() bool {
+	if m == nil || m.Purchased == nil {
+		return false
+	}
+	return *m.Purchased
+}
+
+// GetPurchasingAccount returns the PurchasingAccount field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryPurchasingUpdate) GetPurchasingAccount() string {
+	if m == nil || m.PurchasingAccount == nil {
+		return ""
+	}
+	return *m.PurchasingAccount
+}
+
+// GetPurchasingContact returns the PurchasingContact field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryPurchasingUpdate) GetPurchasingContact() string {
+	if m == nil || m.PurchasingContact == nil {
+		return ""
+	}
+	return *m.PurchasingContact
+}
+
+// GetVendor returns the Vendor field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryPurchasingUpdate) GetVendor() string {
+	if m == nil || m.Vendor == nil {
+		return ""
+	}
+	return *m.Vendor
+}
+
+// GetWarrantyExpiresDate returns the WarrantyExpiresDate field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryPurchasingUpdate) GetWarrantyExpiresDate() string {
+	if m == nil || m.WarrantyExpiresDate == nil {
+		return ""
+	}
+	return *m.WarrantyExpiresDate
+}
+
+// GetAssetTag returns the AssetTag field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryUpdateRequest) GetAssetTag() string {
+	if m == nil || m.AssetTag == nil {
+		return ""
+	}
+	return *m.AssetTag
+}
+
+// GetEnforceName returns the EnforceName field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryUpdateRequest) GetEnforceName() bool {
+	if m == nil || m.EnforceName == nil {
+		return false
+	}
+	return *m.EnforceName
+}
+
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryUpdateRequest) GetLocation() *MobileDeviceInventoryLocationUpdate {
+	if m == nil {
+		return nil
+	}
+	return m.Location
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryUpdateRequest) GetName() string {
+	if m == nil || m.Name == nil {
+		return ""
+	}
+	return *m.Name
+}
+
+// GetPurchasing returns the Purchasing field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryUpdateRequest) GetPurchasing() *MobileDeviceInventoryPurchasingUpdate {
+	if m == nil {
+		return nil
+	}
+	return m.Purchasing
+}
+
+// GetSiteId returns the SiteId field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryUpdateRequest) GetSiteId() ID {
+	if m == nil || m.SiteId == nil {
+		return ""
+	}
+	return *m.SiteId
+}
+
+// GetTimeZone returns the TimeZone field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInventoryUpdateRequest) GetTimeZone() string {
+	if m == nil || m.TimeZone == nil {
+		return ""
+	}
+	return *m.TimeZone
+}
+
+// GetEnrollIntoSite returns the EnrollIntoSite field if it's non-nil, zero value otherwise.
+func (m *MobileDeviceInvitationRequest) GetEnrollIntoSite() *ClassicReference {
+	if m == nil {
+		return nil
+	}
+	return m.EnrollIntoSite
+}
+
+// GetMaintenance returns the Maintenance field if it's non-nil, zero value otherwise.
+func (p *PolicyRequest) GetMaintenance() *PolicyMaintenance {
+	if p == nil {
+		return nil
+	}
+	return p.Maintenance
+}
+
+// GetPackageConfiguration returns the PackageConfiguration field if it's non-nil, zero value otherwise.
+func (p *PolicyRequest) GetPackageConfiguration() *PolicyPackageConfiguration {
+	if p == nil {
+		return nil
+	}
+	return p.PackageConfiguration
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (p *PolicyRequest) GetScope() *Scope {
+	if p == nil {
+		return nil
+	}
+	return p.Scope
+}
+
+// GetSelfService returns the SelfService field if it's non-nil, zero value otherwise.
+func (p *PolicyRequest) GetSelfService() *PolicySelfService {
+	if p == nil {
+		return nil
+	}
+	return p.SelfService
+}
+
+// GetAppleCareId returns the AppleCareId field if it's non-nil, zero value otherwise.
+func (p *Purchasing) GetAppleCareId() string {
+	if p == nil || p.AppleCareId == nil {
+		return ""
+	}
+	return *p.AppleCareId
+}
+
+// GetLeased returns the Leased field if it's non-nil, zero value otherwise.
+func (p *Purchasing) GetLeased() bool {
+	if p == nil || p.Leased == nil {
+		return false
+	}
+	return *p.Leased
+}
+
+// GetLifeExpectancy returns the LifeExpectancy field if it's non-nil, zero value otherwise.
+func (p *Purchasing) GetLifeExpectancy() int {
+	if p == nil || p.LifeExpectancy == nil {
+		return 0
+	}
+	return *p.LifeExpectancy
+}
+
+// GetPoNumber returns the PoNumber field if it's non-nil, zero value otherwise.
+func (p *Purchasing) GetPoNumber() string {
+	if p == nil || p.PoNumber == nil {
+		return ""
+	}
+	return *p.PoNumber
+}
+
+// GetPurchasePrice returns the PurchasePrice field if it's non-nil, zero value otherwise.
+func (p *Purchasing) GetPurchasePrice() string {
+	if p == nil || p.PurchasePrice == nil {
+		return ""
+	}
+	return *p.PurchasePrice
+}
+
+// GetPurchased returns the Purchased field if it's non-nil, zero value otherwise.
+func (p *Purchasing) GetPurchased() bool {
+	if p == nil || p.Purchased == nil {
+		return false
+	}
+	return *p.Purchased
+}
+
+// GetPurchasingAccount returns the PurchasingAccount field if it's non-nil, zero value otherwise.
+func (p *Purchasing) GetPurchasingAccount() string {
+	if p == nil || p.PurchasingAccount == nil {
+		return ""
+	}
+	return *p.PurchasingAccount
+}
+
+// GetPurchasingContact returns the PurchasingContact field if it's non-nil, zero value otherwise.
+func (p *Purchasing) GetPurchasingContact() string {
+	if p == nil || p.PurchasingContact == nil {
+		return ""
+	}
+	return *p.PurchasingContact
+}
+
+// GetVendor returns the Vendor field if it's non-nil, zero value otherwise.
+func (p *Purchasing) GetVendor() string {
+	if p == nil || p.Vendor == nil {
+		return ""
+	}
+	return *p.Vendor
+}
+
+// GetConfigurations returns the Configurations field if it's non-nil, zero value otherwise.
+func (r *RemoteAdministrationConfigurationListResponse) GetConfigurations() []RemoteAdministrationConfiguration {
+	if r == nil || r.Configurations == nil {
+		return nil
+	}
+	return *r.Configurations
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (r *RemoteAdministrationConfigurationListResponse) GetTotalCount() int64 {
+	if r == nil || r.TotalCount == nil {
+		return 0
+	}
+	return *r.TotalCount
+}
+
+// GetScripts returns the Scripts field if it's non-nil, zero value otherwise.
+func (s *ScriptListResponse) GetScripts() []Script {
+	if s == nil || s.Scripts == nil {
+		return nil
+	}
+	return *s.Scripts
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (s *ScriptListResponse) GetTotalCount() int64 {
+	if s == nil || s.TotalCount == nil {
+		return 0
+	}
+	return *s.TotalCount
+}
+
+// GetPolicy returns the Policy field if it's non-nil, zero value otherwise.
+func (s *ScriptRun) GetPolicy() *Policy {
+	if s == nil {
+		return nil
+	}
+	return s.Policy
+}
+
+// GetBluetooth returns the Bluetooth field if it's non-nil, zero value otherwise.
+func (s *SettingsCommand) GetBluetooth() bool {
+	if s == nil || s.Bluetooth == nil {
+		return false
+	}
+	return *s.Bluetooth
+}
+
+// GetDataRoaming returns the DataRoaming field if it's non-nil, zero value otherwise.
+func (s *SettingsCommand) GetDataRoaming() bool {
+	if s == nil || s.DataRoaming == nil {
+		return false
+	}
+	return *s.DataRoaming
+}
+
+// GetPersonalHotspot returns the PersonalHotspot field if it's non-nil, zero value otherwise.
+func (s *SettingsCommand) GetPersonalHotspot() bool {
+	if s == nil || s.PersonalHotspot == nil {
+		return false
+	}
+	return *s.PersonalHotspot
+}
+
+// GetVoiceRoaming returns the VoiceRoaming field if it's non-nil, zero value otherwise.
+func (s *SettingsCommand) GetVoiceRoaming() bool {
+	if s == nil || s.VoiceRoaming == nil {
+		return false
+	}
+	return *s.VoiceRoaming
+}
+
+// GetChangeManagement returns the ChangeManagement field if it's non-nil, zero value otherwise.
+func (s *SettingsSnapshot) GetChangeManagement() *ChangeManagement {
+	if s == nil {
+		return nil
+	}
+	return s.ChangeManagement
+}
+
+// GetCheckIn returns the CheckIn field if it's non-nil, zero value otherwise.
+func (s *SettingsSnapshot) GetCheckIn() *CheckInSettings {
+	if s == nil {
+		return nil
+	}
+	return s.CheckIn
+}
+
+// GetEngage returns the Engage field if it's non-nil, zero value otherwise.
+func (s *SettingsSnapshot) GetEngage() *EngageSettings {
+	if s == nil {
+		return nil
+	}
+	return s.Engage
+}
+
+// GetInventoryCollection returns the InventoryCollection field if it's non-nil, zero value otherwise.
+func (s *SettingsSnapshot) GetInventoryCollection() *ComputerInventoryCollectionSettings {
+	if s == nil {
+		return nil
+	}
+	return s.InventoryCollection
+}
+
+// GetReenrollment returns the Reenrollment field if it's non-nil, zero value otherwise.
+func (s *SettingsSnapshot) GetReenrollment() *ReenrollmentSettings {
+	if s == nil {
+		return nil
+	}
+	return s.Reenrollment
+}
+
+// GetSiteId returns the SiteId field if it's non-nil, zero value otherwise.
+func (s *SmartComputerGroupV1) GetSiteId() ID {
+	if s == nil || s.SiteId == nil {
+		return ""
+	}
+	return *s.SiteId
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (t *TeamViewerConfigurationUpdateRequest) GetDisplayName() string {
+	if t == nil || t.DisplayName == nil {
+		return ""
+	}
+	return *t.DisplayName
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (t *TeamViewerConfigurationUpdateRequest) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetScriptToken returns the ScriptToken field if it's non-nil, zero value otherwise.
+func (t *TeamViewerConfigurationUpdateRequest) GetScriptToken() string {
+	if t == nil || t.ScriptToken == nil {
+		return ""
+	}
+	return *t.ScriptToken
+}
+
+// GetSessionTimeout returns the SessionTimeout field if it's non-nil, zero value otherwise.
+func (t *TeamViewerConfigurationUpdateRequest) GetSessionTimeout() int {
+	if t == nil || t.SessionTimeout == nil {
+		return 0
+	}
+	return *t.SessionTimeout
+}
+
+// GetSessions returns the Sessions field if it's non-nil, zero value otherwise.
+func (t *TeamViewerSessionListResponse) GetSessions() []TeamViewerSession {
+	if t == nil || t.Sessions == nil {
+		return nil
+	}
+	return *t.Sessions
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (t *TeamViewerSessionListResponse) GetTotalCount() int64 {
+	if t == nil || t.TotalCount == nil {
+		return 0
+	}
+	return *t.TotalCount
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (v *VolumePurchasingSubscription) GetEnabled() bool {
+	if v == nil || v.Enabled == nil {
+		return false
+	}
+	return *v.Enabled
+}
+
+// GetId returns the Id field if it's non-nil, zero value otherwise.
+func (v *VolumePurchasingSubscription) GetId() ID {
+	if v == nil || v.Id == nil {
+		return ""
+	}
+	return *v.Id
+}
+
+// GetSiteId returns the SiteId field if it's non-nil, zero value otherwise.
+func (v *VolumePurchasingSubscription) GetSiteId() string {
+	if v == nil || v.SiteId == nil {
+		return ""
+	}
+	return *v.SiteId
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (v *VolumePurchasingSubscriptionBase) GetEnabled() bool {
+	if v == nil || v.Enabled == nil {
+		return false
+	}
+	return *v.Enabled
+}
+
+// GetSiteId returns the SiteId field if it's non-nil, zero value otherwise.
+func (v *VolumePurchasingSubscriptionBase) GetSiteId() string {
+	if v == nil || v.SiteId == nil {
+		return ""
+	}
+	return *v.SiteId
+}
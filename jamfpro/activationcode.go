@@ -0,0 +1,66 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+)
+
+const activationCodeBasePath = "JSSResource/activationcode"
+
+type ActivationCodeService interface {
+	Get(context.Context) (*ActivationCode, *Response, error)
+	Update(context.Context, *ActivationCode) (*ActivationCode, *Response, error)
+}
+
+// ActivationCodeServiceOp handles communication with the activation code
+// methods of the Jamf Pro API.
+type ActivationCodeServiceOp struct {
+	client *Client
+}
+
+var _ ActivationCodeService = &ActivationCodeServiceOp{}
+
+// ActivationCode represents the license Jamf Pro runs under, renewed by setting a new code.
+type ActivationCode struct {
+	XMLName          xml.Name `xml:"activation_code"`
+	OrganizationName string   `xml:"organization_name"`
+	Code             string   `xml:"code"`
+}
+
+func (a *ActivationCodeServiceOp) Get(ctx context.Context) (*ActivationCode, *Response, error) {
+	req, err := a.client.NewRequest(ctx, http.MethodGet, activationCodeBasePath, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var activationCode ActivationCode
+	resp, err := a.client.Do(ctx, req, &activationCode)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &activationCode, resp, err
+}
+
+// Update sets the organization name and activation code, and returns them as stored by the server so a renewal can
+// be verified.
+func (a *ActivationCodeServiceOp) Update(ctx context.Context, activationCode *ActivationCode) (*ActivationCode, *Response, error) {
+	if activationCode == nil {
+		return nil, nil, NewArgError("activationCode", "cannot be nil")
+	} else if activationCode.Code == "" {
+		return nil, nil, NewArgError("Code", "cannot be empty")
+	}
+
+	req, err := a.client.NewRequest(ctx, http.MethodPut, activationCodeBasePath, activationCode, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := a.client.Do(ctx, req, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a.Get(ctx)
+}
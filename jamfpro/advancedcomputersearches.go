@@ -0,0 +1,202 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+)
+
+const advancedComputerSearchesBasePath = "JSSResource/advancedcomputersearches"
+
+type AdvancedComputerSearchesService interface {
+	List(context.Context) ([]AdvancedComputerSearchListItem, *Response, error)
+	GetByID(context.Context, int) (*AdvancedComputerSearch, *Response, error)
+	GetByName(context.Context, string) (*AdvancedComputerSearch, *Response, error)
+	Create(context.Context, *AdvancedComputerSearchRequest) (*AdvancedComputerSearch, *Response, error)
+	Update(context.Context, int, *AdvancedComputerSearchRequest) (*AdvancedComputerSearch, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+	Execute(context.Context, int) (*AdvancedSearchResults, *Response, error)
+}
+
+// AdvancedComputerSearchesServiceOp handles communication with the advanced computer search-related
+// methods of the Jamf Pro API.
+type AdvancedComputerSearchesServiceOp struct {
+	client *Client
+}
+
+var _ AdvancedComputerSearchesService = &AdvancedComputerSearchesServiceOp{}
+
+// AdvancedComputerSearch represents a Jamf Pro advanced computer search. Results holds the computers matching the
+// search at the time it was retrieved.
+type AdvancedComputerSearch struct {
+	XMLName       xml.Name                     `xml:"advanced_computer_search"`
+	Id            ID                           `xml:"id"`
+	Name          string                       `xml:"name"`
+	ViewAs        string                       `xml:"view_as"`
+	Sort1         string                       `xml:"sort_1"`
+	Sort2         string                       `xml:"sort_2"`
+	Sort3         string                       `xml:"sort_3"`
+	Criteria      []ComputerGroupCriteria      `xml:"criteria>criterion"`
+	DisplayFields []AdvancedSearchDisplayField `xml:"display_fields>display_field"`
+	Results       []AdvancedSearchRow          `xml:"computers>computer"`
+	Site          ClassicReference             `xml:"site"`
+}
+
+// AdvancedComputerSearchListItem is the summary of a search returned when listing all advanced computer searches
+type AdvancedComputerSearchListItem struct {
+	Id   ID     `xml:"id"`
+	Name string `xml:"name"`
+}
+
+// AdvancedComputerSearchListResponse represents the raw API response to getting all advanced computer searches
+type AdvancedComputerSearchListResponse struct {
+	XMLName                  xml.Name                         `xml:"advanced_computer_searches"`
+	Size                     int                              `xml:"size"`
+	AdvancedComputerSearches []AdvancedComputerSearchListItem `xml:"advanced_computer_search"`
+}
+
+// AdvancedComputerSearchRequest represents a request to create or update an advanced computer search.
+type AdvancedComputerSearchRequest struct {
+	XMLName       xml.Name                     `xml:"advanced_computer_search"`
+	Name          string                       `xml:"name"`
+	ViewAs        string                       `xml:"view_as,omitempty"`
+	Sort1         string                       `xml:"sort_1,omitempty"`
+	Sort2         string                       `xml:"sort_2,omitempty"`
+	Sort3         string                       `xml:"sort_3,omitempty"`
+	Criteria      []ComputerGroupCriteria      `xml:"criteria>criterion,omitempty"`
+	DisplayFields []AdvancedSearchDisplayField `xml:"display_fields>display_field,omitempty"`
+	Site          *ClassicReference            `xml:"site,omitempty"`
+}
+
+type AdvancedComputerSearchResponse struct {
+	Id ID `xml:"id"`
+}
+
+func (a *AdvancedComputerSearchesServiceOp) List(ctx context.Context) ([]AdvancedComputerSearchListItem, *Response, error) {
+	return a.list(ctx)
+}
+
+func (a *AdvancedComputerSearchesServiceOp) GetByID(ctx context.Context, id int) (*AdvancedComputerSearch, *Response, error) {
+	path := advancedComputerSearchesBasePath + "/id/" + strconv.Itoa(id)
+	return a.get(ctx, path)
+}
+
+func (a *AdvancedComputerSearchesServiceOp) GetByName(ctx context.Context, name string) (*AdvancedComputerSearch, *Response, error) {
+	path := pathJoin(advancedComputerSearchesBasePath, "name", name)
+	return a.get(ctx, path)
+}
+
+// Create creates an advanced computer search in Jamf Pro and returns the search as stored by the server.
+func (a *AdvancedComputerSearchesServiceOp) Create(ctx context.Context, request *AdvancedComputerSearchRequest) (*AdvancedComputerSearch, *Response, error) {
+	path := advancedComputerSearchesBasePath + "/id/0"
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+	if err := ValidateCriteria(request.Criteria); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := a.client.NewRequest(ctx, http.MethodPost, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	searchCreation := new(AdvancedComputerSearchResponse)
+	resp, err := a.client.Do(ctx, req, searchCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, searchCreation.Id, a.GetByID)
+}
+
+// Update updates an advanced computer search in Jamf Pro and returns the search as stored by the server.
+func (a *AdvancedComputerSearchesServiceOp) Update(ctx context.Context, id int, request *AdvancedComputerSearchRequest) (*AdvancedComputerSearch, *Response, error) {
+	path := advancedComputerSearchesBasePath + "/id/" + strconv.Itoa(id)
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if id == 0 {
+		return nil, nil, NewArgError("advanced computer search ID", "cannot be 0")
+	}
+	if err := ValidateCriteria(request.Criteria); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := a.client.NewRequest(ctx, http.MethodPut, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	searchUpdate := new(AdvancedComputerSearchResponse)
+	resp, err := a.client.Do(ctx, req, searchUpdate)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a.GetByID(ctx, id)
+}
+
+func (a *AdvancedComputerSearchesServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := advancedComputerSearchesBasePath + "/id/" + strconv.Itoa(id)
+
+	req, err := a.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DeleteByName deletes the advanced computer search called name. The error matches ErrNotFound if there is none.
+func (a *AdvancedComputerSearchesServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "advanced computer search", name, a.GetByName, func(s *AdvancedComputerSearch) ID { return s.Id }, a.Delete)
+}
+
+// Execute runs the advanced computer search with the given ID and returns the matching computers, with one entry per
+// display field of the search.
+func (a *AdvancedComputerSearchesServiceOp) Execute(ctx context.Context, id int) (*AdvancedSearchResults, *Response, error) {
+	search, resp, err := a.GetByID(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return newAdvancedSearchResults(search.DisplayFields, search.Results), resp, err
+}
+
+func (a *AdvancedComputerSearchesServiceOp) get(ctx context.Context, path string) (*AdvancedComputerSearch, *Response, error) {
+	req, err := a.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var search AdvancedComputerSearch
+	resp, err := a.client.Do(ctx, req, &search)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &search, resp, err
+}
+
+func (a *AdvancedComputerSearchesServiceOp) list(ctx context.Context) ([]AdvancedComputerSearchListItem, *Response, error) {
+	path := advancedComputerSearchesBasePath
+	req, err := a.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var searchResponse AdvancedComputerSearchListResponse
+	resp, err := a.client.Do(ctx, req, &searchResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return searchResponse.AdvancedComputerSearches, resp, err
+}
@@ -0,0 +1,203 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+)
+
+const advancedMobileDeviceSearchesBasePath = "JSSResource/advancedmobiledevicesearches"
+
+type AdvancedMobileDeviceSearchesService interface {
+	List(context.Context) ([]AdvancedMobileDeviceSearchListItem, *Response, error)
+	GetByID(context.Context, int) (*AdvancedMobileDeviceSearch, *Response, error)
+	GetByName(context.Context, string) (*AdvancedMobileDeviceSearch, *Response, error)
+	Create(context.Context, *AdvancedMobileDeviceSearchRequest) (*AdvancedMobileDeviceSearch, *Response, error)
+	Update(context.Context, int, *AdvancedMobileDeviceSearchRequest) (*AdvancedMobileDeviceSearch, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+	Execute(context.Context, int) (*AdvancedSearchResults, *Response, error)
+}
+
+// AdvancedMobileDeviceSearchesServiceOp handles communication with the advanced mobile device search-related
+// methods of the Jamf Pro API.
+type AdvancedMobileDeviceSearchesServiceOp struct {
+	client *Client
+}
+
+var _ AdvancedMobileDeviceSearchesService = &AdvancedMobileDeviceSearchesServiceOp{}
+
+// AdvancedMobileDeviceSearch represents a Jamf Pro advanced mobile device search. Results holds the mobile devices
+// matching the search at the time it was retrieved. Its criteria take the same form as those of computer groups and
+// advanced computer searches.
+type AdvancedMobileDeviceSearch struct {
+	XMLName       xml.Name                     `xml:"advanced_mobile_device_search"`
+	Id            ID                           `xml:"id"`
+	Name          string                       `xml:"name"`
+	ViewAs        string                       `xml:"view_as"`
+	Sort1         string                       `xml:"sort_1"`
+	Sort2         string                       `xml:"sort_2"`
+	Sort3         string                       `xml:"sort_3"`
+	Criteria      []ComputerGroupCriteria      `xml:"criteria>criterion"`
+	DisplayFields []AdvancedSearchDisplayField `xml:"display_fields>display_field"`
+	Results       []AdvancedSearchRow          `xml:"mobile_devices>mobile_device"`
+	Site          ClassicReference             `xml:"site"`
+}
+
+// AdvancedMobileDeviceSearchListItem is the summary of a search returned when listing all advanced mobile device searches
+type AdvancedMobileDeviceSearchListItem struct {
+	Id   ID     `xml:"id"`
+	Name string `xml:"name"`
+}
+
+// AdvancedMobileDeviceSearchListResponse represents the raw API response to getting all advanced mobile device searches
+type AdvancedMobileDeviceSearchListResponse struct {
+	XMLName                      xml.Name                             `xml:"advanced_mobile_device_searches"`
+	Size                         int                                  `xml:"size"`
+	AdvancedMobileDeviceSearches []AdvancedMobileDeviceSearchListItem `xml:"advanced_mobile_device_search"`
+}
+
+// AdvancedMobileDeviceSearchRequest represents a request to create or update an advanced mobile device search.
+type AdvancedMobileDeviceSearchRequest struct {
+	XMLName       xml.Name                     `xml:"advanced_mobile_device_search"`
+	Name          string                       `xml:"name"`
+	ViewAs        string                       `xml:"view_as,omitempty"`
+	Sort1         string                       `xml:"sort_1,omitempty"`
+	Sort2         string                       `xml:"sort_2,omitempty"`
+	Sort3         string                       `xml:"sort_3,omitempty"`
+	Criteria      []ComputerGroupCriteria      `xml:"criteria>criterion,omitempty"`
+	DisplayFields []AdvancedSearchDisplayField `xml:"display_fields>display_field,omitempty"`
+	Site          *ClassicReference            `xml:"site,omitempty"`
+}
+
+type AdvancedMobileDeviceSearchResponse struct {
+	Id ID `xml:"id"`
+}
+
+func (a *AdvancedMobileDeviceSearchesServiceOp) List(ctx context.Context) ([]AdvancedMobileDeviceSearchListItem, *Response, error) {
+	return a.list(ctx)
+}
+
+func (a *AdvancedMobileDeviceSearchesServiceOp) GetByID(ctx context.Context, id int) (*AdvancedMobileDeviceSearch, *Response, error) {
+	path := advancedMobileDeviceSearchesBasePath + "/id/" + strconv.Itoa(id)
+	return a.get(ctx, path)
+}
+
+func (a *AdvancedMobileDeviceSearchesServiceOp) GetByName(ctx context.Context, name string) (*AdvancedMobileDeviceSearch, *Response, error) {
+	path := pathJoin(advancedMobileDeviceSearchesBasePath, "name", name)
+	return a.get(ctx, path)
+}
+
+// Create creates an advanced mobile device search in Jamf Pro and returns the search as stored by the server.
+func (a *AdvancedMobileDeviceSearchesServiceOp) Create(ctx context.Context, request *AdvancedMobileDeviceSearchRequest) (*AdvancedMobileDeviceSearch, *Response, error) {
+	path := advancedMobileDeviceSearchesBasePath + "/id/0"
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+	if err := ValidateCriteria(request.Criteria); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := a.client.NewRequest(ctx, http.MethodPost, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	searchCreation := new(AdvancedMobileDeviceSearchResponse)
+	resp, err := a.client.Do(ctx, req, searchCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, searchCreation.Id, a.GetByID)
+}
+
+// Update updates an advanced mobile device search in Jamf Pro and returns the search as stored by the server.
+func (a *AdvancedMobileDeviceSearchesServiceOp) Update(ctx context.Context, id int, request *AdvancedMobileDeviceSearchRequest) (*AdvancedMobileDeviceSearch, *Response, error) {
+	path := advancedMobileDeviceSearchesBasePath + "/id/" + strconv.Itoa(id)
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if id == 0 {
+		return nil, nil, NewArgError("advanced mobile device search ID", "cannot be 0")
+	}
+	if err := ValidateCriteria(request.Criteria); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := a.client.NewRequest(ctx, http.MethodPut, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	searchUpdate := new(AdvancedMobileDeviceSearchResponse)
+	resp, err := a.client.Do(ctx, req, searchUpdate)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a.GetByID(ctx, id)
+}
+
+func (a *AdvancedMobileDeviceSearchesServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := advancedMobileDeviceSearchesBasePath + "/id/" + strconv.Itoa(id)
+
+	req, err := a.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DeleteByName deletes the advanced mobile device search called name. The error matches ErrNotFound if there is none.
+func (a *AdvancedMobileDeviceSearchesServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "advanced mobile device search", name, a.GetByName, func(s *AdvancedMobileDeviceSearch) ID { return s.Id }, a.Delete)
+}
+
+// Execute runs the advanced mobile device search with the given ID and returns the matching mobile devices, with one entry per
+// display field of the search.
+func (a *AdvancedMobileDeviceSearchesServiceOp) Execute(ctx context.Context, id int) (*AdvancedSearchResults, *Response, error) {
+	search, resp, err := a.GetByID(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return newAdvancedSearchResults(search.DisplayFields, search.Results), resp, err
+}
+
+func (a *AdvancedMobileDeviceSearchesServiceOp) get(ctx context.Context, path string) (*AdvancedMobileDeviceSearch, *Response, error) {
+	req, err := a.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var search AdvancedMobileDeviceSearch
+	resp, err := a.client.Do(ctx, req, &search)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &search, resp, err
+}
+
+func (a *AdvancedMobileDeviceSearchesServiceOp) list(ctx context.Context) ([]AdvancedMobileDeviceSearchListItem, *Response, error) {
+	path := advancedMobileDeviceSearchesBasePath
+	req, err := a.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var searchResponse AdvancedMobileDeviceSearchListResponse
+	resp, err := a.client.Do(ctx, req, &searchResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return searchResponse.AdvancedMobileDeviceSearches, resp, err
+}
@@ -0,0 +1,136 @@
+package jamfpro
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AdvancedSearchDisplayField is a column shown in the results of an advanced search
+type AdvancedSearchDisplayField struct {
+	Name string `xml:"name"`
+}
+
+// AdvancedSearchResults holds the results of running an advanced search
+type AdvancedSearchResults struct {
+	// Fields lists the display fields of the search, in the order they were configured
+	Fields []string
+	Rows   []AdvancedSearchRow
+}
+
+// AdvancedSearchRow is a single result of an advanced search. Keys are the element names the Classic API uses for
+// each display field, which are the field names with spaces and punctuation replaced by underscores, e.g.
+// "Serial_Number". A row always contains the id and name of the matching object, plus one key per display field.
+// If the API returns the same field more than once for a row, as it does for multi-value fields, the values are
+// joined with ", ".
+type AdvancedSearchRow map[string]string
+
+// Get returns the value of field, which may be given either as its display name ("Serial Number") or as its key
+// ("Serial_Number"). It returns "" if the row has no such field.
+func (r AdvancedSearchRow) Get(field string) string {
+	if v, ok := r[field]; ok {
+		return v
+	}
+	return r[advancedSearchFieldKey(field)]
+}
+
+// Has reports whether the row has a value for field
+func (r AdvancedSearchRow) Has(field string) bool {
+	if _, ok := r[field]; ok {
+		return true
+	}
+	_, ok := r[advancedSearchFieldKey(field)]
+	return ok
+}
+
+// Id returns the ID of the object the row describes
+func (r AdvancedSearchRow) Id() int {
+	id, _ := strconv.Atoi(r["id"])
+	return id
+}
+
+// Name returns the name of the object the row describes
+func (r AdvancedSearchRow) Name() string {
+	return r["name"]
+}
+
+// Int returns the value of field parsed as an integer
+func (r AdvancedSearchRow) Int(field string) (int, error) {
+	return strconv.Atoi(strings.TrimSpace(r.Get(field)))
+}
+
+// Bool returns the value of field parsed as a boolean. Besides the values understood by strconv.ParseBool, the
+// "Yes"/"No" and "Enabled"/"Disabled" values used in Jamf Pro inventory are accepted.
+func (r AdvancedSearchRow) Bool(field string) (bool, error) {
+	v := strings.TrimSpace(r.Get(field))
+	switch strings.ToLower(v) {
+	case "yes", "enabled", "on":
+		return true, nil
+	case "no", "disabled", "off":
+		return false, nil
+	}
+	return strconv.ParseBool(v)
+}
+
+// Time returns the value of field parsed as a date. Jamf Pro reports dates either as "2006-01-02 15:04:05" or as
+// "2006-01-02"; both are accepted and interpreted as UTC.
+func (r AdvancedSearchRow) Time(field string) (time.Time, error) {
+	v := strings.TrimSpace(r.Get(field))
+	t, err := time.Parse("2006-01-02 15:04:05", v)
+	if err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", v)
+}
+
+// UnmarshalXML reads every child element of a result row into the map, whatever its name
+func (r *AdvancedSearchRow) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if *r == nil {
+		*r = make(AdvancedSearchRow)
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			var value string
+			if err := d.DecodeElement(&value, &t); err != nil {
+				return err
+			}
+			key := t.Name.Local
+			if existing, ok := (*r)[key]; ok && existing != "" {
+				if value != "" {
+					(*r)[key] = existing + ", " + value
+				}
+			} else {
+				(*r)[key] = value
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// advancedSearchFieldKey converts a display field name to the element name the Classic API uses for it
+func advancedSearchFieldKey(field string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, field)
+}
+
+// newAdvancedSearchResults pairs the result rows of a search with its display fields
+func newAdvancedSearchResults(displayFields []AdvancedSearchDisplayField, rows []AdvancedSearchRow) *AdvancedSearchResults {
+	results := &AdvancedSearchResults{Rows: rows}
+	for _, field := range displayFields {
+		results.Fields = append(results.Fields, advancedSearchFieldKey(field.Name))
+	}
+	return results
+}
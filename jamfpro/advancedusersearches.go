@@ -0,0 +1,194 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+)
+
+const advancedUserSearchesBasePath = "JSSResource/advancedusersearches"
+
+type AdvancedUserSearchesService interface {
+	List(context.Context) ([]AdvancedUserSearchListItem, *Response, error)
+	GetByID(context.Context, int) (*AdvancedUserSearch, *Response, error)
+	GetByName(context.Context, string) (*AdvancedUserSearch, *Response, error)
+	Create(context.Context, *AdvancedUserSearchRequest) (*AdvancedUserSearch, *Response, error)
+	Update(context.Context, int, *AdvancedUserSearchRequest) (*AdvancedUserSearch, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+	Execute(context.Context, int) (*AdvancedSearchResults, *Response, error)
+}
+
+// AdvancedUserSearchesServiceOp handles communication with the advanced user search-related
+// methods of the Jamf Pro API.
+type AdvancedUserSearchesServiceOp struct {
+	client *Client
+}
+
+var _ AdvancedUserSearchesService = &AdvancedUserSearchesServiceOp{}
+
+// AdvancedUserSearch represents a Jamf Pro advanced user search. Results holds the users matching the search at the
+// time it was retrieved. Its criteria take the same form as those of computer groups and advanced computer searches.
+type AdvancedUserSearch struct {
+	XMLName       xml.Name                     `xml:"advanced_user_search"`
+	Id            ID                           `xml:"id"`
+	Name          string                       `xml:"name"`
+	Criteria      []ComputerGroupCriteria      `xml:"criteria>criterion"`
+	DisplayFields []AdvancedSearchDisplayField `xml:"display_fields>display_field"`
+	Results       []AdvancedSearchRow          `xml:"users>user"`
+	Site          ClassicReference             `xml:"site"`
+}
+
+// AdvancedUserSearchListItem is the summary of a search returned when listing all advanced user searches
+type AdvancedUserSearchListItem struct {
+	Id   ID     `xml:"id"`
+	Name string `xml:"name"`
+}
+
+// AdvancedUserSearchListResponse represents the raw API response to getting all advanced user searches
+type AdvancedUserSearchListResponse struct {
+	XMLName              xml.Name                     `xml:"advanced_user_searches"`
+	Size                 int                          `xml:"size"`
+	AdvancedUserSearches []AdvancedUserSearchListItem `xml:"advanced_user_search"`
+}
+
+// AdvancedUserSearchRequest represents a request to create or update an advanced user search.
+type AdvancedUserSearchRequest struct {
+	XMLName       xml.Name                     `xml:"advanced_user_search"`
+	Name          string                       `xml:"name"`
+	Criteria      []ComputerGroupCriteria      `xml:"criteria>criterion,omitempty"`
+	DisplayFields []AdvancedSearchDisplayField `xml:"display_fields>display_field,omitempty"`
+	Site          *ClassicReference            `xml:"site,omitempty"`
+}
+
+type AdvancedUserSearchResponse struct {
+	Id ID `xml:"id"`
+}
+
+func (a *AdvancedUserSearchesServiceOp) List(ctx context.Context) ([]AdvancedUserSearchListItem, *Response, error) {
+	return a.list(ctx)
+}
+
+func (a *AdvancedUserSearchesServiceOp) GetByID(ctx context.Context, id int) (*AdvancedUserSearch, *Response, error) {
+	path := advancedUserSearchesBasePath + "/id/" + strconv.Itoa(id)
+	return a.get(ctx, path)
+}
+
+func (a *AdvancedUserSearchesServiceOp) GetByName(ctx context.Context, name string) (*AdvancedUserSearch, *Response, error) {
+	path := pathJoin(advancedUserSearchesBasePath, "name", name)
+	return a.get(ctx, path)
+}
+
+// Create creates an advanced user search in Jamf Pro and returns the search as stored by the server.
+func (a *AdvancedUserSearchesServiceOp) Create(ctx context.Context, request *AdvancedUserSearchRequest) (*AdvancedUserSearch, *Response, error) {
+	path := advancedUserSearchesBasePath + "/id/0"
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+	if err := ValidateCriteria(request.Criteria); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := a.client.NewRequest(ctx, http.MethodPost, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	searchCreation := new(AdvancedUserSearchResponse)
+	resp, err := a.client.Do(ctx, req, searchCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, searchCreation.Id, a.GetByID)
+}
+
+// Update updates an advanced user search in Jamf Pro and returns the search as stored by the server.
+func (a *AdvancedUserSearchesServiceOp) Update(ctx context.Context, id int, request *AdvancedUserSearchRequest) (*AdvancedUserSearch, *Response, error) {
+	path := advancedUserSearchesBasePath + "/id/" + strconv.Itoa(id)
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if id == 0 {
+		return nil, nil, NewArgError("advanced user search ID", "cannot be 0")
+	}
+	if err := ValidateCriteria(request.Criteria); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := a.client.NewRequest(ctx, http.MethodPut, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	searchUpdate := new(AdvancedUserSearchResponse)
+	resp, err := a.client.Do(ctx, req, searchUpdate)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a.GetByID(ctx, id)
+}
+
+func (a *AdvancedUserSearchesServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := advancedUserSearchesBasePath + "/id/" + strconv.Itoa(id)
+
+	req, err := a.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DeleteByName deletes the advanced user search called name. The error matches ErrNotFound if there is none.
+func (a *AdvancedUserSearchesServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "advanced user search", name, a.GetByName, func(s *AdvancedUserSearch) ID { return s.Id }, a.Delete)
+}
+
+// Execute runs the advanced user search with the given ID and returns the matching users, with one entry per
+// display field of the search.
+func (a *AdvancedUserSearchesServiceOp) Execute(ctx context.Context, id int) (*AdvancedSearchResults, *Response, error) {
+	search, resp, err := a.GetByID(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return newAdvancedSearchResults(search.DisplayFields, search.Results), resp, err
+}
+
+func (a *AdvancedUserSearchesServiceOp) get(ctx context.Context, path string) (*AdvancedUserSearch, *Response, error) {
+	req, err := a.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var search AdvancedUserSearch
+	resp, err := a.client.Do(ctx, req, &search)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &search, resp, err
+}
+
+func (a *AdvancedUserSearchesServiceOp) list(ctx context.Context) ([]AdvancedUserSearchListItem, *Response, error) {
+	path := advancedUserSearchesBasePath
+	req, err := a.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var searchResponse AdvancedUserSearchListResponse
+	resp, err := a.client.Do(ctx, req, &searchResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return searchResponse.AdvancedUserSearches, resp, err
+}
@@ -0,0 +1,144 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const allowedFileExtensionsBasePath = "JSSResource/allowedfileextensions"
+
+// AllowedFileExtensionsService manages the file extensions that may be uploaded to Jamf Pro. The Classic API offers
+// no update operation for these, so an extension is changed by deleting it and creating a new one.
+type AllowedFileExtensionsService interface {
+	List(context.Context) ([]AllowedFileExtension, *Response, error)
+	GetByID(context.Context, int) (*AllowedFileExtension, *Response, error)
+	GetByExtension(context.Context, string) (*AllowedFileExtension, *Response, error)
+	Create(context.Context, *AllowedFileExtensionRequest) (*AllowedFileExtension, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+}
+
+// AllowedFileExtensionsServiceOp handles communication with the allowed file extension-related
+// methods of the Jamf Pro API.
+type AllowedFileExtensionsServiceOp struct {
+	client *Client
+}
+
+var _ AllowedFileExtensionsService = &AllowedFileExtensionsServiceOp{}
+
+// AllowedFileExtension represents a Jamf Pro allowed file extension
+type AllowedFileExtension struct {
+	XMLName   xml.Name `xml:"allowed_file_extension"`
+	Id        ID       `xml:"id"`
+	Extension string   `xml:"extension"`
+}
+
+// AllowedFileExtensionListResponse represents the raw API response to getting all allowed file extensions
+type AllowedFileExtensionListResponse struct {
+	XMLName               xml.Name               `xml:"allowed_file_extensions"`
+	Size                  int                    `xml:"size"`
+	AllowedFileExtensions []AllowedFileExtension `xml:"allowed_file_extension"`
+}
+
+// AllowedFileExtensionRequest represents a request to create an allowed file extension.
+type AllowedFileExtensionRequest struct {
+	XMLName   xml.Name `xml:"allowed_file_extension"`
+	Extension string   `xml:"extension"`
+}
+
+type AllowedFileExtensionResponse struct {
+	Id ID `xml:"id"`
+}
+
+func (a *AllowedFileExtensionsServiceOp) List(ctx context.Context) ([]AllowedFileExtension, *Response, error) {
+	return a.list(ctx)
+}
+
+func (a *AllowedFileExtensionsServiceOp) GetByID(ctx context.Context, id int) (*AllowedFileExtension, *Response, error) {
+	path := allowedFileExtensionsBasePath + "/id/" + strconv.Itoa(id)
+	return a.get(ctx, path)
+}
+
+// GetByExtension looks up an allowed file extension by the extension itself. A leading dot is ignored.
+func (a *AllowedFileExtensionsServiceOp) GetByExtension(ctx context.Context, extension string) (*AllowedFileExtension, *Response, error) {
+	extension = strings.TrimPrefix(extension, ".")
+	if extension == "" {
+		return nil, nil, NewArgError("extension", "cannot be empty")
+	}
+	path := pathJoin(allowedFileExtensionsBasePath, "extension", extension)
+	return a.get(ctx, path)
+}
+
+// Create creates an allowed file extension in Jamf Pro and returns the record as stored by the server.
+func (a *AllowedFileExtensionsServiceOp) Create(ctx context.Context, request *AllowedFileExtensionRequest) (*AllowedFileExtension, *Response, error) {
+	path := allowedFileExtensionsBasePath + "/id/0"
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	} else if strings.TrimPrefix(request.Extension, ".") == "" {
+		return nil, nil, NewArgError("Extension", "cannot be empty")
+	}
+
+	payload := *request
+	payload.Extension = strings.TrimPrefix(request.Extension, ".")
+	req, err := a.client.NewRequest(ctx, http.MethodPost, path, &payload, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	allowedFileExtensionCreation := new(AllowedFileExtensionResponse)
+	resp, err := a.client.Do(ctx, req, allowedFileExtensionCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, allowedFileExtensionCreation.Id, a.GetByID)
+}
+
+func (a *AllowedFileExtensionsServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := allowedFileExtensionsBasePath + "/id/" + strconv.Itoa(id)
+
+	req, err := a.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+func (a *AllowedFileExtensionsServiceOp) get(ctx context.Context, path string) (*AllowedFileExtension, *Response, error) {
+	req, err := a.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var allowedFileExtension AllowedFileExtension
+	resp, err := a.client.Do(ctx, req, &allowedFileExtension)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &allowedFileExtension, resp, err
+}
+
+func (a *AllowedFileExtensionsServiceOp) list(ctx context.Context) ([]AllowedFileExtension, *Response, error) {
+	path := allowedFileExtensionsBasePath
+	req, err := a.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var allowedFileExtensionResponse AllowedFileExtensionListResponse
+	resp, err := a.client.Do(ctx, req, &allowedFileExtensionResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return allowedFileExtensionResponse.AllowedFileExtensions, resp, err
+}
@@ -0,0 +1,34 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"testing"
+)
+
+func TestAllowedFileExtensionsCreateLeavesTheRequestAlone(t *testing.T) {
+	var sent AllowedFileExtensionRequest
+	client, _ := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		if r.Method == http.MethodPost {
+			if err := xml.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Errorf("decoding the request: %v", err)
+			}
+			w.Write([]byte(`<allowed_file_extension><id>3</id></allowed_file_extension>`))
+			return
+		}
+		w.Write([]byte(`<allowed_file_extension><id>3</id><extension>pdf</extension></allowed_file_extension>`))
+	}))
+
+	request := &AllowedFileExtensionRequest{Extension: ".pdf"}
+	if _, _, err := client.AllowedFileExtensions.Create(context.Background(), request); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if sent.Extension != "pdf" {
+		t.Errorf("sent extension %q, want %q", sent.Extension, "pdf")
+	}
+	if request.Extension != ".pdf" {
+		t.Errorf("Create changed the request's extension to %q", request.Extension)
+	}
+}
@@ -6,7 +6,10 @@ import (
 	"strconv"
 )
 
-const apiRolesBasePath = "uapi/v1/api-roles"
+const (
+	apiRolesBasePath          = "uapi/v1/api-roles"
+	apiRolePrivilegesBasePath = "uapi/v1/api-role-privileges"
+)
 
 type ApiRolesService interface {
 	List(context.Context) ([]ApiRole, *Response, error)
@@ -15,6 +18,8 @@ type ApiRolesService interface {
 	Create(context.Context, *ApiRoleCreateRequest) (*ApiRole, *Response, error)
 	Update(context.Context, int, *ApiRoleUpdateRequest) (*ApiRole, *Response, error)
 	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+	ListAvailablePrivileges(context.Context) ([]string, *Response, error)
 }
 
 // ApiRolesServiceOp handles communication with the API roles related
@@ -27,7 +32,7 @@ var _ ApiRolesService = &ApiRolesServiceOp{}
 
 // ApiRole represents a Jamf Pro API Role
 type ApiRole struct {
-	Id          *string   `json:"id,omitempty"` // The response type to be returned is a string
+	Id          *ID       `json:"id,omitempty"` // The response type to be returned is a string
 	DisplayName *string   `json:"displayName,omitempty"`
 	Privileges  *[]string `json:"privileges,omitempty"`
 }
@@ -38,6 +43,11 @@ type ApiRoleGetResponse struct {
 	ApiRoles   *[]ApiRole `json:"results"`
 }
 
+// ApiRolePrivilegesResponse represents the raw API response to listing the available API role privileges
+type ApiRolePrivilegesResponse struct {
+	Privileges []string `json:"privileges"`
+}
+
 // ApiRoleCreateRequest represents a request to create an API role.
 type ApiRoleCreateRequest struct {
 	DisplayName string   `json:"displayName,omitempty"`
@@ -46,7 +56,7 @@ type ApiRoleCreateRequest struct {
 
 // ApiRoleCreateResponse represents an API response to creating an API role
 type ApiRoleCreateResponse struct {
-	Id          *string   `json:"id,omitempty"` // The response type to be returned is a string
+	Id          *ID       `json:"id,omitempty"` // The response type to be returned is a string
 	DisplayName *string   `json:"displayName,omitempty"`
 	Privileges  *[]string `json:"privileges,omitempty"`
 }
@@ -59,7 +69,7 @@ type ApiRoleUpdateRequest struct {
 
 // ApiRoleUpdateResponse represents an API response to updating an API role
 type ApiRoleUpdateResponse struct {
-	Id          *string   `json:"id,omitempty"` // The response type to be returned is a string
+	Id          *ID       `json:"id,omitempty"` // The response type to be returned is a string
 	DisplayName *string   `json:"displayName,omitempty"`
 	Privileges  *[]string `json:"privileges,omitempty"`
 }
@@ -94,10 +104,13 @@ func (a *ApiRolesServiceOp) GetByName(ctx context.Context, name string) (*ApiRol
 
 	for i := range apiRoles {
 		if *apiRoles[i].DisplayName == name {
-			id = *apiRoles[i].Id
+			id = apiRoles[i].Id.String()
 			break
 		}
 	}
+	if id == "" {
+		return nil, nil, notFoundByName("API role", name)
+	}
 	intId, err := strconv.ParseInt(id, 10, 64)
 
 	if err != nil {
@@ -129,7 +142,7 @@ func (a *ApiRolesServiceOp) Create(ctx context.Context, request *ApiRoleCreateRe
 	}
 
 	if apiRoleCreation.Id == nil {
-		return nil, resp, err
+		return nil, resp, ErrMissingID
 	}
 
 	return apiRoleCreation, resp, err
@@ -172,6 +185,28 @@ func (a *ApiRolesServiceOp) Delete(ctx context.Context, id int) (*Response, erro
 	return resp, err
 }
 
+// DeleteByName deletes the API role called name. The error matches ErrNotFound if there is none.
+func (a *ApiRolesServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "API role", name, a.GetByName, (*ApiRole).GetId, a.Delete)
+}
+
+// ListAvailablePrivileges returns every privilege an API role can be granted on the instance. The Privilege*
+// constants hold the privileges known when this package was released.
+func (a *ApiRolesServiceOp) ListAvailablePrivileges(ctx context.Context) ([]string, *Response, error) {
+	req, err := a.client.NewRequest(ctx, http.MethodGet, apiRolePrivilegesBasePath, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var privilegesResponse ApiRolePrivilegesResponse
+	resp, err := a.client.Do(ctx, req, &privilegesResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return privilegesResponse.Privileges, resp, err
+}
+
 func (a *ApiRolesServiceOp) list(ctx context.Context) ([]ApiRole, *Response, error) {
 	path := apiRolesBasePath
 
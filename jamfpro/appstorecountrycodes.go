@@ -0,0 +1,52 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+)
+
+const appStoreCountryCodesBasePath = "uapi/v1/app-store-country-codes"
+
+type AppStoreCountryCodesService interface {
+	List(context.Context) ([]AppStoreCountryCode, *Response, error)
+}
+
+// AppStoreCountryCodesServiceOp handles communication with the App Store country code
+// methods of the Jamf Pro API.
+type AppStoreCountryCodesServiceOp struct {
+	client *Client
+}
+
+var _ AppStoreCountryCodesService = &AppStoreCountryCodesServiceOp{}
+
+// AppStoreCountryCode represents an App Store region that apps and eBooks can be looked up in
+type AppStoreCountryCode struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+// AppStoreCountryCodeListResponse represents the raw API response to getting all App Store country codes
+type AppStoreCountryCodeListResponse struct {
+	CountryCodes *[]AppStoreCountryCode `json:"countryCodes"`
+}
+
+func (a *AppStoreCountryCodesServiceOp) List(ctx context.Context) ([]AppStoreCountryCode, *Response, error) {
+	path := appStoreCountryCodesBasePath
+
+	req, err := a.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var countryCodeResponse AppStoreCountryCodeListResponse
+	resp, err := a.client.Do(ctx, req, &countryCodeResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if countryCodeResponse.CountryCodes == nil {
+		return nil, resp, err
+	}
+
+	return *countryCodeResponse.CountryCodes, resp, err
+}
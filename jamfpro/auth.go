@@ -0,0 +1,161 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	uriInvalidateToken = "uapi/v1/auth/invalidate-token"
+	uriKeepAlive       = "uapi/v1/auth/keep-alive"
+
+	// uriOAuthDiscovery is where RFC 8414 places the authorization server metadata below its issuer
+	uriOAuthDiscovery = "/.well-known/oauth-authorization-server"
+)
+
+// oauthServerMetadata is the part of an RFC 8414 authorization server metadata document the client uses
+type oauthServerMetadata struct {
+	TokenEndpoint string `json:"token_endpoint"`
+}
+
+// keepAliveResponse represents the raw API response to refreshing a bearer token
+type keepAliveResponse struct {
+	Token   *string    `json:"token"`
+	Expires *time.Time `json:"expires"`
+}
+
+// InvalidateToken revokes the client's current bearer token on the server, and removes it from the client and its
+// TokenStore. Call this on shutdown to make sure a leaked token cannot be reused. The client requests a new token
+// the next time one is needed. Requests already sent with the revoked token fail with an ErrorResponse for the 401
+// Unauthorized the instance answers them with.
+func (c *Client) InvalidateToken(ctx context.Context) (*Response, error) {
+	if !c.hasToken() {
+		return nil, nil
+	}
+
+	ctx = withRead(ctx)
+	req, err := c.NewRequest(ctx, http.MethodPost, uriInvalidateToken, nil, "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	c.mu.Lock()
+	c.token = nil
+	c.tokenExpiration = nil
+	c.mu.Unlock()
+
+	if c.tokenStore != nil {
+		if err := c.tokenStore.Delete(c.tokenStoreKey()); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// KeepAlive exchanges the client's current bearer token for a new one with a fresh expiry, without going through the
+// OAuth token endpoint again.
+func (c *Client) KeepAlive(ctx context.Context) (*Response, error) {
+	if !c.hasToken() {
+		return nil, NewArgError("token", "cannot be refreshed before one has been issued")
+	}
+
+	ctx = withRead(ctx)
+	req, err := c.NewRequest(ctx, http.MethodPost, uriKeepAlive, nil, "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	var out keepAliveResponse
+	resp, err := c.Do(ctx, req, &out)
+	if err != nil {
+		return resp, err
+	}
+
+	if out.Token == nil || out.Expires == nil {
+		return resp, nil
+	}
+
+	c.setToken(*out.Token, *out.Expires)
+
+	if c.tokenStore != nil {
+		if err := c.tokenStore.Save(c.tokenStoreKey(), &Token{AccessToken: *out.Token, Expiration: *out.Expires}); err != nil {
+			c.warn("Could not save the bearer token to the token store", "error", err)
+		}
+	}
+
+	return resp, nil
+}
+
+// tokenEndpoint returns the URL to request bearer tokens from: the one given with WithTokenURL, the one discovered
+// with WithOAuthDiscovery, or the instance's own. A discovered endpoint is remembered for later tokens. It must be
+// called with c.refreshMu held.
+func (c *Client) tokenEndpoint(ctx context.Context) (string, error) {
+	if c.tokenURL != "" {
+		return c.tokenURL, nil
+	}
+	if c.discoveryURL == "" {
+		return c.instanceUrl.String() + uriOAuthToken, nil
+	}
+
+	endpoint, err := c.discoverTokenEndpoint(ctx)
+	if err != nil {
+		return "", err
+	}
+	if endpoint == "" {
+		// The server does not publish its metadata, as Jamf Pro does not yet, so use the instance's own endpoint.
+		endpoint = c.instanceUrl.String() + uriOAuthToken
+	}
+	c.tokenURL = endpoint
+	return endpoint, nil
+}
+
+// discoverTokenEndpoint fetches the authorization server metadata at c.discoveryURL and returns its token endpoint,
+// or an empty string if the server has no metadata document.
+func (c *Client) discoverTokenEndpoint(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.discoveryURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	for k, v := range c.ExtraHeader {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", nil
+	} else if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("jamfpro: fetching the OAuth metadata at %s failed with %s", c.discoveryURL, resp.Status)
+	}
+
+	var metadata oauthServerMetadata
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxTokenResponseSize)).Decode(&metadata); err != nil {
+		return "", fmt.Errorf("jamfpro: decoding the OAuth metadata at %s: %w", c.discoveryURL, err)
+	}
+	if metadata.TokenEndpoint == "" {
+		return "", fmt.Errorf("jamfpro: the OAuth metadata at %s has no token_endpoint", c.discoveryURL)
+	}
+
+	endpoint, err := url.Parse(metadata.TokenEndpoint)
+	if err != nil || !endpoint.IsAbs() {
+		return "", fmt.Errorf("jamfpro: the OAuth metadata at %s has an invalid token_endpoint %q", c.discoveryURL, metadata.TokenEndpoint)
+	}
+	return endpoint.String(), nil
+}
@@ -0,0 +1,158 @@
+package jamfpro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// rotatingInstance is a server standing in for a Jamf Pro instance that issues a new token for every token request
+// and a new node cookie with every response, and refuses requests without a token it issued and has not revoked.
+type rotatingInstance struct {
+	mu          sync.Mutex
+	tokens      map[string]bool
+	issued      int64
+	invalidated int64
+	responses   int64
+}
+
+func (s *rotatingInstance) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n := atomic.AddInt64(&s.responses, 1)
+	http.SetCookie(w, &http.Cookie{Name: cookieAPBalanceID, Value: fmt.Sprintf("node%d", n%3)})
+	http.SetCookie(w, &http.Cookie{Name: cookieJamfProIngress, Value: fmt.Sprintf("ingress%d", n%2)})
+
+	if r.URL.Path == uriOAuthToken {
+		token := fmt.Sprintf("token-%d", atomic.AddInt64(&s.issued, 1))
+		s.mu.Lock()
+		s.tokens[token] = true
+		s.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":%q,"expires_in":3600}`, token)
+		return
+	}
+
+	s.mu.Lock()
+	known := s.tokens[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]
+	s.mu.Unlock()
+	if !known {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch {
+	case r.URL.Path == "/"+uriInvalidateToken:
+		s.mu.Lock()
+		delete(s.tokens, strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+		s.mu.Unlock()
+		atomic.AddInt64(&s.invalidated, 1)
+		w.WriteHeader(http.StatusNoContent)
+	case r.URL.Path == "/"+departmentsBasePath:
+		departmentPage(3).ServeHTTP(w, r)
+	case strings.HasPrefix(r.URL.Path, "/"+departmentsBasePath+"/"):
+		id := strings.TrimPrefix(r.URL.Path, "/"+departmentsBasePath+"/")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id":%q,"name":"Department %s"}`, id, id)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// TestConcurrentRequestsWithTokenRotation sends requests from many goroutines while others invalidate the token, so
+// that run with -race it checks the token and the affinity cookies are only accessed under Client.mu. Requests sent
+// with a token another goroutine has just revoked may fail with the 401 InvalidateToken documents, but nothing else.
+func TestConcurrentRequestsWithTokenRotation(t *testing.T) {
+	instance := &rotatingInstance{tokens: make(map[string]bool)}
+	server := httptest.NewServer(instance)
+	defer server.Close()
+
+	client, err := NewClient("client-id", "client-secret", server.URL, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	ctx := context.Background()
+	var (
+		wg           sync.WaitGroup
+		mu           sync.Mutex
+		errs         []error
+		unauthorized int
+	)
+	check := func(op string, err error) {
+		if err == nil {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		var errResp *ErrorResponse
+		if errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusUnauthorized {
+			unauthorized++
+			return
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", op, err))
+	}
+	for i := 0; i < 8; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				_, _, err := client.Departments.List(ctx)
+				check("List", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				_, _, err := client.Departments.GetByName(ctx, "Department 2")
+				check("GetByName", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 5; j++ {
+				_, err := client.InvalidateToken(ctx)
+				check("InvalidateToken", err)
+				client.GetSessionToken()
+				client.APBalanceID()
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		t.Error(err)
+	}
+	if atomic.LoadInt64(&instance.invalidated) == 0 || atomic.LoadInt64(&instance.issued) < 2 {
+		t.Errorf("issued %d tokens and invalidated %d; want the token to have been rotated", instance.issued, instance.invalidated)
+	}
+	t.Logf("%d requests were refused with a revoked token", unauthorized)
+
+	// Once the token is no longer changing, invalidating it is followed by a refresh the instance accepts
+	if _, err := client.InvalidateToken(ctx); err != nil {
+		t.Errorf("InvalidateToken: %v", err)
+	}
+	if _, _, err := client.Departments.List(ctx); err != nil {
+		t.Errorf("List after a refresh: %v", err)
+	}
+}
+
+func TestEnsureTokenRenewsTokensAboutToExpire(t *testing.T) {
+	client, _ := newTestClient(t, http.NotFoundHandler())
+	client.setToken("stale", time.Now().Add(tokenExpirySkew/2))
+
+	if err := client.ensureToken(context.Background()); err != nil {
+		t.Fatalf("ensureToken: %v", err)
+	}
+	client.mu.RLock()
+	token := *client.token
+	client.mu.RUnlock()
+	if token == "stale" {
+		t.Error("ensureToken kept a token that expires within the skew")
+	}
+}
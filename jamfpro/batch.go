@@ -0,0 +1,91 @@
+package jamfpro
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const defaultBatchConcurrency = 4
+
+// BatchGetError reports the records a GetManyByID call could not fetch. The records that were fetched are returned
+// alongside it.
+type BatchGetError struct {
+	// Failures maps the ID of each record that could not be fetched to the error fetching it.
+	Failures map[int]error
+}
+
+func (e *BatchGetError) Error() string {
+	ids := make([]int, 0, len(e.Failures))
+	for id := range e.Failures {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	const shown = 5
+	var failures []string
+	for i, id := range ids {
+		if i == shown {
+			failures = append(failures, fmt.Sprintf("and %d more", len(ids)-shown))
+			break
+		}
+		failures = append(failures, fmt.Sprintf("%d: %v", id, e.Failures[id]))
+	}
+	return fmt.Sprintf("jamfpro: fetching %d records failed: %s", len(ids), strings.Join(failures, "; "))
+}
+
+// getManyByID calls get for each of ids, with at most concurrency calls in flight, and returns the records fetched
+// keyed by ID. Failed fetches are reported together in a *BatchGetError; the IDs not yet fetched when ctx is done
+// fail with its error.
+func getManyByID[T any](ctx context.Context, ids []int, concurrency int, get func(context.Context, int) (*T, *Response, error)) (map[int]*T, error) {
+	if concurrency <= 0 {
+		concurrency = defaultBatchConcurrency
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		records  = make(map[int]*T, len(ids))
+		failures = make(map[int]error)
+		slots    = make(chan struct{}, concurrency)
+		seen     = make(map[int]bool, len(ids))
+	)
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			failures[id] = ctx.Err()
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			record, _, err := get(ctx, id)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failures[id] = err
+				return
+			}
+			records[id] = record
+		}(id)
+	}
+	wg.Wait()
+
+	if len(failures) > 0 {
+		return records, &BatchGetError{Failures: failures}
+	}
+	return records, nil
+}
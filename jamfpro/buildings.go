@@ -2,6 +2,7 @@ package jamfpro
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"strconv"
 )
@@ -12,9 +13,15 @@ type BuildingsService interface {
 	List(context.Context) ([]Building, *Response, error)
 	GetByID(context.Context, int) (*Building, *Response, error)
 	GetByName(context.Context, string) (*Building, *Response, error)
+	ExistsByName(context.Context, string) (bool, *Response, error)
 	Create(context.Context, *BuildingCreateRequest) (*Building, *Response, error)
 	Update(context.Context, int, *BuildingUpdateRequest) (*Building, *Response, error)
 	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+	GetOrCreate(context.Context, string) (*Building, *Response, error)
+	ListHistory(context.Context, int, *ListOptions) ([]HistoryEntry, *Response, error)
+	AddHistoryNote(context.Context, int, string) (*HistoryNoteResponse, *Response, error)
+	ExportCSV(context.Context, io.Writer, *ExportOptions) (*Response, error)
 }
 
 // BuildingsServiceOp handles communication with the buildings related
@@ -27,7 +34,7 @@ var _ BuildingsService = &BuildingsServiceOp{}
 
 // Building represents a Jamf Pro Building
 type Building struct {
-	Id             *string `json:"id,omitempty"` // The response type to be returned is a string
+	Id             *ID     `json:"id,omitempty"` // The response type to be returned is a string
 	Name           *string `json:"name,omitempty"`
 	StreetAddress1 *string `json:"streetAddress1,omitempty"`
 	StreetAddress2 *string `json:"streetAddress2,omitempty"`
@@ -57,7 +64,7 @@ type BuildingCreateRequest struct {
 
 // BuildingCreateResponse represents an API response to creating a building
 type BuildingCreateResponse struct {
-	Id   *string `json:"id"`
+	Id   *ID     `json:"id"`
 	Href *string `json:"href"`
 }
 
@@ -74,7 +81,7 @@ type BuildingUpdateRequest struct {
 
 // BuildingUpdateResponse represents an API response to updating a building
 type BuildingUpdateResponse struct {
-	Id             string `json:"id"` // The response type to be returned is a string
+	Id             ID     `json:"id"` // The response type to be returned is a string
 	Name           string `json:"name"`
 	StreetAddress1 string `json:"streetAddress1,omitempty"`
 	StreetAddress2 string `json:"streetAddress2,omitempty"`
@@ -105,31 +112,34 @@ func (b *BuildingsServiceOp) GetByID(ctx context.Context, i int) (*Building, *Re
 	return &building, resp, err
 }
 
+// GetByName returns the building called name, looking it up with a filter rather than listing every building. The
+// error matches ErrNotFound if there is none.
 func (b *BuildingsServiceOp) GetByName(ctx context.Context, name string) (*Building, *Response, error) {
-	buildings, _, err := b.list(ctx)
-	var id string
-	if err != nil {
-		return nil, nil, err
-	}
-
-	for i := range buildings {
-		if *buildings[i].Name == name {
-			id = *buildings[i].Id
-			break
-		}
+	if name == "" {
+		return nil, nil, NewArgError("name", "cannot be empty")
 	}
-	intId, err := strconv.ParseInt(id, 10, 64)
 
+	building, resp, err := b.findByName(ctx, name)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
+	}
+	if building == nil || building.GetId().IsZero() {
+		return nil, resp, notFoundByName("building", name)
 	}
 
-	building, resp, err := b.GetByID(ctx, int(intId))
-	if err != nil {
-		return nil, resp, err
+	return b.GetByID(ctx, building.GetId().Int())
+}
+
+// ExistsByName reports whether a building is called name, looking it up with a filter rather than listing every
+// building.
+func (b *BuildingsServiceOp) ExistsByName(ctx context.Context, name string) (bool, *Response, error) {
+	if name == "" {
+		return false, nil, NewArgError("name", "cannot be empty")
 	}
 
-	return building, resp, err
+	building, resp, err := b.findByName(ctx, name)
+	exists, err := existence(err)
+	return exists && building != nil, resp, err
 }
 
 func (b *BuildingsServiceOp) Create(ctx context.Context, request *BuildingCreateRequest) (*Building, *Response, error) {
@@ -149,11 +159,10 @@ func (b *BuildingsServiceOp) Create(ctx context.Context, request *BuildingCreate
 	}
 
 	if buildingCreation.Id == nil {
-		return nil, resp, err
+		return nil, resp, ErrMissingID
 	}
 
-	building := b.createBuildingFromCreationResponse(*buildingCreation, *request)
-	return &building, resp, err
+	return getCreated(ctx, resp, *buildingCreation.Id, b.GetByID)
 }
 
 func (b *BuildingsServiceOp) Update(ctx context.Context, i int, request *BuildingUpdateRequest) (*Building, *Response, error) {
@@ -174,8 +183,7 @@ func (b *BuildingsServiceOp) Update(ctx context.Context, i int, request *Buildin
 		return nil, resp, err
 	}
 
-	building := b.createBuildingFromUpdateResponse(*buildingUpdate, *request)
-	return &building, resp, err
+	return getUpdated(ctx, resp, buildingUpdate.Id, b.GetByID, nil)
 }
 
 func (b *BuildingsServiceOp) Delete(ctx context.Context, i int) (*Response, error) {
@@ -194,46 +202,84 @@ func (b *BuildingsServiceOp) Delete(ctx context.Context, i int) (*Response, erro
 	return resp, err
 }
 
+// DeleteByName deletes the building called name. The error matches ErrNotFound if there is none.
+func (b *BuildingsServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "building", name, b.findByName, (*Building).GetId, b.Delete)
+}
+
+// list returns every building, fetching all pages.
 func (b *BuildingsServiceOp) list(ctx context.Context) ([]Building, *Response, error) {
-	path := buildingsBasePath
+	return listAll[Building](ctx, b.client, buildingsBasePath)
+}
 
-	req, err := b.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+// GetOrCreate returns the building called name, creating it if it does not exist yet. If another client creates the
+// building at the same time, the resulting conflict is resolved by fetching the building again.
+func (b *BuildingsServiceOp) GetOrCreate(ctx context.Context, name string) (*Building, *Response, error) {
+	if name == "" {
+		return nil, nil, NewArgError("name", "cannot be empty")
+	}
+
+	existing, resp, err := b.findByName(ctx, name)
+	if err != nil || existing != nil {
+		return existing, resp, err
+	}
+
+	created, resp, err := b.Create(ctx, &BuildingCreateRequest{Name: name})
+	if err != nil && isConflict(resp) {
+		if existing, findResp, findErr := b.findByName(ctx, name); findErr == nil && existing != nil {
+			return existing, findResp, nil
+		}
+	}
+
+	return created, resp, err
+}
+
+// findByName returns the building called name, or nil if there is none.
+func (b *BuildingsServiceOp) findByName(ctx context.Context, name string) (*Building, *Response, error) {
+	path, err := addOptions(buildingsBasePath, &ListOptions{Filter: nameFilter(name)})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var buildingResponse BuildingGetResponse
-	resp, err := b.client.Do(ctx, req, &buildingResponse)
+	req, err := b.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
 	if err != nil {
+		return nil, nil, err
+	}
+
+	var listResponse BuildingGetResponse
+	resp, err := b.client.Do(ctx, req, &listResponse)
+	if err != nil || listResponse.Buildings == nil {
 		return nil, resp, err
 	}
 
-	return *buildingResponse.Buildings, resp, err
-}
-
-func (b *BuildingsServiceOp) createBuildingFromCreationResponse(response BuildingCreateResponse, request BuildingCreateRequest) Building {
-	building := new(Building)
-	building.Id = response.Id
-	building.Href = response.Href
-	building.Name = &request.Name
-	building.StreetAddress1 = &request.StreetAddress1
-	building.StreetAddress2 = &request.StreetAddress2
-	building.City = &request.City
-	building.StateProvince = &request.StateProvince
-	building.ZipPostalCode = &request.ZipPostalCode
-	building.Country = &request.Country
-	return *building
-}
-
-func (b *BuildingsServiceOp) createBuildingFromUpdateResponse(response BuildingUpdateResponse, request BuildingUpdateRequest) Building {
-	building := new(Building)
-	building.Id = &response.Id
-	building.Name = &request.Name
-	building.StreetAddress1 = &request.StreetAddress1
-	building.StreetAddress2 = &request.StreetAddress2
-	building.City = &request.City
-	building.StateProvince = &request.StateProvince
-	building.ZipPostalCode = &request.ZipPostalCode
-	building.Country = &request.Country
-	return *building
+	// RSQL treats * as a wildcard, so the filter can match more than the one name asked for.
+	results := *listResponse.Buildings
+	for i := range results {
+		if results[i].GetName() == name {
+			return &results[i], resp, nil
+		}
+	}
+
+	return nil, resp, nil
+}
+
+// ListHistory returns one page of the change history of the building with the given ID.
+func (b *BuildingsServiceOp) ListHistory(ctx context.Context, id int, opts *ListOptions) ([]HistoryEntry, *Response, error) {
+	if id == 0 {
+		return nil, nil, NewArgError("building ID", "cannot be 0")
+	}
+	return listHistory(ctx, b.client, buildingsBasePath+"/"+strconv.Itoa(id)+"/history", opts)
+}
+
+// AddHistoryNote adds a note to the change history of the building with the given ID.
+func (b *BuildingsServiceOp) AddHistoryNote(ctx context.Context, id int, note string) (*HistoryNoteResponse, *Response, error) {
+	if id == 0 {
+		return nil, nil, NewArgError("building ID", "cannot be 0")
+	}
+	return addHistoryNote(ctx, b.client, buildingsBasePath+"/"+strconv.Itoa(id)+"/history", note)
+}
+
+// ExportCSV writes the building records selected by opts to w as CSV.
+func (b *BuildingsServiceOp) ExportCSV(ctx context.Context, w io.Writer, opts *ExportOptions) (*Response, error) {
+	return exportCSV(ctx, b.client, buildingsBasePath+"/export", w, opts)
 }
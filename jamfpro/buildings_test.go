@@ -0,0 +1,44 @@
+package jamfpro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestBuildingsGetByName(t *testing.T) {
+	client, _ := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/" + buildingsBasePath:
+			switch filter := r.URL.Query().Get("filter"); filter {
+			case nameFilter("HQ"):
+				// A building without a name must not stop the lookup.
+				fmt.Fprint(w, `{"totalCount":2,"results":[{"id":"3"},{"id":"4","name":"HQ"}]}`)
+			case nameFilter("Annex"):
+				fmt.Fprint(w, `{"totalCount":0,"results":[]}`)
+			default:
+				t.Errorf("buildings listed with filter %q, want a name filter", filter)
+			}
+		case "/" + buildingsBasePath + "/4":
+			fmt.Fprint(w, `{"id":"4","name":"HQ","city":"Oslo"}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL)
+			http.NotFound(w, r)
+		}
+	}))
+
+	building, _, err := client.Buildings.GetByName(context.Background(), "HQ")
+	if err != nil {
+		t.Fatalf("GetByName: %v", err)
+	}
+	if building.GetId() != "4" || building.GetCity() != "Oslo" {
+		t.Errorf("GetByName = %+v, want building 4 in Oslo", building)
+	}
+
+	if _, _, err := client.Buildings.GetByName(context.Background(), "Annex"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetByName of a missing building = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,255 @@
+// Package bulk runs the same Jamf Pro operation over many items with bounded concurrency, rate limiting and
+// retries, and reports the outcome of every item.
+//
+//	executor := bulk.NewExecutor[jamfpro.DepartmentCreateRequest](bulk.Options{
+//		Concurrency:       8,
+//		RequestsPerSecond: 20,
+//		MaxRetries:        3,
+//	})
+//	report := executor.Run(ctx, requests, func(ctx context.Context, r jamfpro.DepartmentCreateRequest) error {
+//		_, _, err := client.Departments.Create(ctx, &r)
+//		return err
+//	})
+//	for _, failure := range report.Failures() {
+//		log.Printf("%s: %v", failure.Item.Name, failure.Err)
+//	}
+package bulk
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/jc0b/go-jamfpro-api/jamfpro"
+)
+
+const (
+	defaultConcurrency  = 4
+	defaultRetryBackoff = time.Second
+)
+
+// Operation is run once for each item, and again for each retry.
+type Operation[T any] func(ctx context.Context, item T) error
+
+// Options configures an Executor. The zero value runs four operations at a time, without rate limiting or
+// retries.
+type Options struct {
+	// Concurrency is the number of operations run at the same time.
+	Concurrency int
+
+	// RequestsPerSecond limits how often operations are started across all workers, retries included. Zero means
+	// no limit.
+	RequestsPerSecond float64
+
+	// MaxRetries is the number of times a failed operation is retried.
+	MaxRetries int
+
+	// RetryBackoff is the wait before the first retry. It doubles with every further retry.
+	RetryBackoff time.Duration
+
+	// ShouldRetry decides whether a failed operation is retried. It defaults to RetryableError.
+	ShouldRetry func(error) bool
+
+	// Progress is told about every item as it finishes, after its retries.
+	Progress jamfpro.ProgressReporter
+}
+
+// Result is the outcome of one item.
+type Result[T any] struct {
+	// Index is the position of the item in the slice passed to Run.
+	Index    int
+	Item     T
+	Err      error
+	Attempts int
+	Duration time.Duration
+}
+
+// Report collects the results of a Run, in the order of the items.
+type Report[T any] struct {
+	Results   []Result[T]
+	Succeeded int
+	Failed    int
+}
+
+// Failures returns the results of the items whose operation failed.
+func (r *Report[T]) Failures() []Result[T] {
+	var failures []Result[T]
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// Executor runs an Operation over a slice of items.
+type Executor[T any] struct {
+	opts Options
+}
+
+// NewExecutor returns an Executor configured by opts.
+func NewExecutor[T any](opts Options) *Executor[T] {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultConcurrency
+	}
+	if opts.RetryBackoff <= 0 {
+		opts.RetryBackoff = defaultRetryBackoff
+	}
+	if opts.ShouldRetry == nil {
+		opts.ShouldRetry = RetryableError
+	}
+	return &Executor[T]{opts: opts}
+}
+
+// Run applies op to every item and waits for all of them to finish. Items that have not started when ctx is
+// cancelled are reported with the context's error.
+func (e *Executor[T]) Run(ctx context.Context, items []T, op Operation[T]) *Report[T] {
+	report := &Report[T]{Results: make([]Result[T], len(items))}
+
+	progress := jamfpro.SyncProgress(e.opts.Progress)
+	progress.OnStart(len(items))
+	var mu sync.Mutex
+	done := 0
+	finish := func(result Result[T]) {
+		mu.Lock()
+		defer mu.Unlock()
+		report.Results[result.Index] = result
+		done++
+		if result.Err != nil {
+			progress.OnError(done, result.Err)
+		} else {
+			progress.OnItem(done)
+		}
+	}
+
+	limiter := newLimiter(e.opts.RequestsPerSecond)
+	defer limiter.stop()
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < e.opts.Concurrency && w < len(items); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				finish(e.runOne(ctx, limiter, i, items[i], op))
+			}
+		}()
+	}
+
+	for i := range items {
+		if ctx.Err() != nil {
+			finish(Result[T]{Index: i, Item: items[i], Err: ctx.Err()})
+			continue
+		}
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	for _, result := range report.Results {
+		if result.Err != nil {
+			report.Failed++
+		} else {
+			report.Succeeded++
+		}
+	}
+	progress.OnFinish(ctx.Err())
+	return report
+}
+
+func (e *Executor[T]) runOne(ctx context.Context, limiter *limiter, index int, item T, op Operation[T]) Result[T] {
+	result := Result[T]{Index: index, Item: item}
+	start := time.Now()
+	backoff := e.opts.RetryBackoff
+
+	for {
+		if err := limiter.wait(ctx); err != nil {
+			result.Err = err
+			break
+		}
+
+		result.Attempts++
+		result.Err = op(ctx, item)
+		if result.Err == nil || result.Attempts > e.opts.MaxRetries || !e.opts.ShouldRetry(result.Err) {
+			break
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			result.Duration = time.Since(start)
+			return result
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+
+	result.Duration = time.Since(start)
+	return result
+}
+
+// RetryableError reports whether err is worth retrying: rate limiting, server errors, timeouts and failures to
+// connect to the server are. Anything else is not, as sending the request again would fail the same way: client
+// errors, missing objects and conflicts, credentials Jamf Pro refuses, requests the client itself refused to send,
+// and cancellation.
+func RetryableError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, jamfpro.ErrNotFound) || errors.Is(err, jamfpro.ErrConflict) {
+		return false
+	}
+
+	var errorResponse *jamfpro.ErrorResponse
+	if errors.As(err, &errorResponse) {
+		if errorResponse.Response == nil {
+			return false
+		}
+		code := errorResponse.Response.StatusCode
+		return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	// Dial and connection errors, e.g. a refused or reset connection
+	var opErr *net.OpError
+	return errors.As(err, &opErr) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET)
+}
+
+// limiter hands out one start per tick, shared by all workers.
+type limiter struct {
+	ticker *time.Ticker
+}
+
+func newLimiter(perSecond float64) *limiter {
+	if perSecond <= 0 {
+		return &limiter{}
+	}
+	return &limiter{ticker: time.NewTicker(time.Duration(float64(time.Second) / perSecond))}
+}
+
+func (l *limiter) wait(ctx context.Context) error {
+	if l.ticker == nil {
+		return ctx.Err()
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-l.ticker.C:
+		return nil
+	}
+}
+
+func (l *limiter) stop() {
+	if l.ticker != nil {
+		l.ticker.Stop()
+	}
+}
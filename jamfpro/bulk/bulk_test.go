@@ -0,0 +1,80 @@
+package bulk_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jc0b/go-jamfpro-api/jamfpro"
+	"github.com/jc0b/go-jamfpro-api/jamfpro/bulk"
+)
+
+// timeoutError is a net.Error reporting a timeout, as an HTTP client with a Timeout returns.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func errorResponse(code int) error {
+	return &jamfpro.ErrorResponse{Response: &http.Response{StatusCode: code, Request: &http.Request{Method: http.MethodDelete, URL: &url.URL{}}}}
+}
+
+func TestRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"429", errorResponse(http.StatusTooManyRequests), true},
+		{"500", errorResponse(http.StatusInternalServerError), true},
+		{"503", errorResponse(http.StatusServiceUnavailable), true},
+		{"400", errorResponse(http.StatusBadRequest), false},
+		{"404", errorResponse(http.StatusNotFound), false},
+		{"409", errorResponse(http.StatusConflict), false},
+		{"timeout", &url.Error{Op: "Get", URL: "https://jamf.example.com", Err: timeoutError{}}, true},
+		{"connection refused", &url.Error{Op: "Get", URL: "https://jamf.example.com", Err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}}, true},
+		{"connection reset", fmt.Errorf("reading response: %w", syscall.ECONNRESET), true},
+		{"not found by name", fmt.Errorf("%w: no department is called %q", jamfpro.ErrNotFound, "Finance"), false},
+		{"conflict", fmt.Errorf("updating: %w", jamfpro.ErrConflict), false},
+		{"argument", jamfpro.NewArgError("name", "cannot be empty"), false},
+		{"invalid client", &jamfpro.AuthError{StatusCode: http.StatusUnauthorized, Code: "invalid_client"}, false},
+		{"read-only client", &jamfpro.ErrReadOnlyClient{Method: http.MethodDelete, Path: "uapi/v1/departments/1"}, false},
+		{"protected instance", &jamfpro.ErrProtectedInstance{Instance: "jamf.example.com", Method: http.MethodDelete}, false},
+		{"no MDM capability", &jamfpro.MDMCapabilityError{ComputerId: 1, Reason: "is not managed"}, false},
+		{"other", errors.New("something went wrong"), false},
+		{"cancelled", context.Canceled, false},
+		{"deadline", fmt.Errorf("listing: %w", context.DeadlineExceeded), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bulk.RetryableError(tt.err); got != tt.want {
+				t.Errorf("RetryableError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunDoesNotRetryRefusedRequests(t *testing.T) {
+	executor := bulk.NewExecutor[int](bulk.Options{MaxRetries: 3, RetryBackoff: time.Millisecond})
+
+	report := executor.Run(context.Background(), []int{1, 2}, func(ctx context.Context, id int) error {
+		if id == 1 {
+			return &jamfpro.ErrProtectedInstance{Instance: "jamf.example.com", Method: http.MethodDelete}
+		}
+		return errorResponse(http.StatusServiceUnavailable)
+	})
+
+	if got := report.Results[0].Attempts; got != 1 {
+		t.Errorf("a refused delete was attempted %d times, want 1", got)
+	}
+	if got := report.Results[1].Attempts; got != 4 {
+		t.Errorf("a delete failing with 503 was attempted %d times, want 4", got)
+	}
+}
@@ -0,0 +1,331 @@
+package jamfpro
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// CachedResource names a resource whose lookups WithCache can cache
+type CachedResource string
+
+const (
+	CacheBuildings   CachedResource = "buildings"
+	CacheCategories  CachedResource = "categories"
+	CacheDepartments CachedResource = "departments"
+	CacheSites       CachedResource = "sites"
+)
+
+// cachedResources are the resources WithCache caches when none are given
+var cachedResources = []CachedResource{CacheBuildings, CacheCategories, CacheDepartments, CacheSites}
+
+// ttlCache holds the results of lookups for ttl. Errors are never cached. Every result is returned through clone, so
+// callers cannot change what the cache holds.
+type ttlCache[V any] struct {
+	ttl   time.Duration
+	clone func(V) V
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry[V]
+	// generation is bumped by invalidate, so a load that started before a change is not stored after it
+	generation uint64
+}
+
+type cacheEntry[V any] struct {
+	value   V
+	resp    *Response
+	expires time.Time
+}
+
+func newTTLCache[V any](ttl time.Duration, clone func(V) V) *ttlCache[V] {
+	return &ttlCache[V]{ttl: ttl, clone: clone, entries: make(map[string]cacheEntry[V])}
+}
+
+// get returns the cached result for key, calling load to fill the cache if there is none or it has expired. The
+// result of a load is not cached if the cache was invalidated while it ran, as it may predate the change.
+func (c *ttlCache[V]) get(key string, load func() (V, *Response, error)) (V, *Response, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	generation := c.generation
+	c.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return c.clone(entry.value), entry.resp, nil
+	}
+
+	value, resp, err := load()
+	if err != nil {
+		return value, resp, err
+	}
+
+	c.mu.Lock()
+	if c.generation == generation {
+		c.entries[key] = cacheEntry[V]{value: value, resp: resp, expires: time.Now().Add(c.ttl)}
+	}
+	c.mu.Unlock()
+	return c.clone(value), resp, nil
+}
+
+// invalidate empties the cache
+func (c *ttlCache[V]) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]cacheEntry[V])
+	c.generation++
+}
+
+// clonePointer returns a pointer to a copy of *p, or nil if p is nil
+func clonePointer[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+// cloneSlice returns a copy of s, or nil if s is nil
+func cloneSlice[T any](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	return append(make([]T, 0, len(s)), s...)
+}
+
+// cloneBuilding copies b and the values its fields point to
+func cloneBuilding(b Building) Building {
+	return Building{
+		Id:             clonePointer(b.Id),
+		Name:           clonePointer(b.Name),
+		StreetAddress1: clonePointer(b.StreetAddress1),
+		StreetAddress2: clonePointer(b.StreetAddress2),
+		City:           clonePointer(b.City),
+		StateProvince:  clonePointer(b.StateProvince),
+		ZipPostalCode:  clonePointer(b.ZipPostalCode),
+		Country:        clonePointer(b.Country),
+		Href:           clonePointer(b.Href),
+	}
+}
+
+func cloneBuildings(buildings []Building) []Building {
+	if buildings == nil {
+		return nil
+	}
+	clones := make([]Building, len(buildings))
+	for i, b := range buildings {
+		clones[i] = cloneBuilding(b)
+	}
+	return clones
+}
+
+func cloneBuildingPointer(b *Building) *Building {
+	if b == nil {
+		return nil
+	}
+	clone := cloneBuilding(*b)
+	return &clone
+}
+
+// cacheListKey is the key of List results, which cannot clash with a name as names are never empty
+const cacheListKey = ""
+
+// cachedCategories caches List and GetByName of a CategoriesService, until a change is made through it.
+type cachedCategories struct {
+	CategoriesService
+	lists  *ttlCache[[]Category]
+	byName *ttlCache[*Category]
+}
+
+func (c *cachedCategories) List(ctx context.Context) ([]Category, *Response, error) {
+	return c.lists.get(cacheListKey, func() ([]Category, *Response, error) {
+		return c.CategoriesService.List(ctx)
+	})
+}
+
+func (c *cachedCategories) GetByName(ctx context.Context, name string) (*Category, *Response, error) {
+	return c.byName.get(name, func() (*Category, *Response, error) {
+		return c.CategoriesService.GetByName(ctx, name)
+	})
+}
+
+func (c *cachedCategories) Create(ctx context.Context, request *CategoryCreateRequest) (*Category, *Response, error) {
+	defer c.invalidate()
+	return c.CategoriesService.Create(ctx, request)
+}
+
+func (c *cachedCategories) Update(ctx context.Context, id int, request *CategoryUpdateRequest) (*Category, *Response, error) {
+	defer c.invalidate()
+	return c.CategoriesService.Update(ctx, id, request)
+}
+
+func (c *cachedCategories) Delete(ctx context.Context, id int) (*Response, error) {
+	defer c.invalidate()
+	return c.CategoriesService.Delete(ctx, id)
+}
+
+func (c *cachedCategories) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	defer c.invalidate()
+	return c.CategoriesService.DeleteByName(ctx, name)
+}
+
+func (c *cachedCategories) GetOrCreate(ctx context.Context, name string) (*Category, *Response, error) {
+	defer c.invalidate()
+	return c.CategoriesService.GetOrCreate(ctx, name)
+}
+
+func (c *cachedCategories) invalidate() {
+	c.lists.invalidate()
+	c.byName.invalidate()
+}
+
+// cachedBuildings caches List and GetByName of a BuildingsService, until a change is made through it.
+type cachedBuildings struct {
+	BuildingsService
+	lists  *ttlCache[[]Building]
+	byName *ttlCache[*Building]
+}
+
+func (b *cachedBuildings) List(ctx context.Context) ([]Building, *Response, error) {
+	return b.lists.get(cacheListKey, func() ([]Building, *Response, error) {
+		return b.BuildingsService.List(ctx)
+	})
+}
+
+func (b *cachedBuildings) GetByName(ctx context.Context, name string) (*Building, *Response, error) {
+	return b.byName.get(name, func() (*Building, *Response, error) {
+		return b.BuildingsService.GetByName(ctx, name)
+	})
+}
+
+func (b *cachedBuildings) Create(ctx context.Context, request *BuildingCreateRequest) (*Building, *Response, error) {
+	defer b.invalidate()
+	return b.BuildingsService.Create(ctx, request)
+}
+
+func (b *cachedBuildings) Update(ctx context.Context, id int, request *BuildingUpdateRequest) (*Building, *Response, error) {
+	defer b.invalidate()
+	return b.BuildingsService.Update(ctx, id, request)
+}
+
+func (b *cachedBuildings) Delete(ctx context.Context, id int) (*Response, error) {
+	defer b.invalidate()
+	return b.BuildingsService.Delete(ctx, id)
+}
+
+func (b *cachedBuildings) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	defer b.invalidate()
+	return b.BuildingsService.DeleteByName(ctx, name)
+}
+
+func (b *cachedBuildings) GetOrCreate(ctx context.Context, name string) (*Building, *Response, error) {
+	defer b.invalidate()
+	return b.BuildingsService.GetOrCreate(ctx, name)
+}
+
+func (b *cachedBuildings) invalidate() {
+	b.lists.invalidate()
+	b.byName.invalidate()
+}
+
+// cachedDepartments caches List and GetByName of a DepartmentsService, until a change is made through it.
+type cachedDepartments struct {
+	DepartmentsService
+	lists  *ttlCache[[]Department]
+	byName *ttlCache[*Department]
+}
+
+func (d *cachedDepartments) List(ctx context.Context) ([]Department, *Response, error) {
+	return d.lists.get(cacheListKey, func() ([]Department, *Response, error) {
+		return d.DepartmentsService.List(ctx)
+	})
+}
+
+func (d *cachedDepartments) GetByName(ctx context.Context, name string) (*Department, *Response, error) {
+	return d.byName.get(name, func() (*Department, *Response, error) {
+		return d.DepartmentsService.GetByName(ctx, name)
+	})
+}
+
+func (d *cachedDepartments) Create(ctx context.Context, request *DepartmentCreateRequest) (*Department, *Response, error) {
+	defer d.invalidate()
+	return d.DepartmentsService.Create(ctx, request)
+}
+
+func (d *cachedDepartments) Update(ctx context.Context, id int, request *DepartmentUpdateRequest) (*Department, *Response, error) {
+	defer d.invalidate()
+	return d.DepartmentsService.Update(ctx, id, request)
+}
+
+func (d *cachedDepartments) Delete(ctx context.Context, id int) (*Response, error) {
+	defer d.invalidate()
+	return d.DepartmentsService.Delete(ctx, id)
+}
+
+func (d *cachedDepartments) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	defer d.invalidate()
+	return d.DepartmentsService.DeleteByName(ctx, name)
+}
+
+func (d *cachedDepartments) GetOrCreate(ctx context.Context, name string) (*Department, *Response, error) {
+	defer d.invalidate()
+	return d.DepartmentsService.GetOrCreate(ctx, name)
+}
+
+func (d *cachedDepartments) invalidate() {
+	d.lists.invalidate()
+	d.byName.invalidate()
+}
+
+// cachedSites caches List and GetByName of a SitesService. Sites cannot be changed through the client.
+type cachedSites struct {
+	SitesService
+	lists  *ttlCache[[]Site]
+	byName *ttlCache[*Site]
+}
+
+func (s *cachedSites) List(ctx context.Context) ([]Site, *Response, error) {
+	return s.lists.get(cacheListKey, func() ([]Site, *Response, error) {
+		return s.SitesService.List(ctx)
+	})
+}
+
+func (s *cachedSites) GetByName(ctx context.Context, name string) (*Site, *Response, error) {
+	return s.byName.get(name, func() (*Site, *Response, error) {
+		return s.SitesService.GetByName(ctx, name)
+	})
+}
+
+// enableCache wraps the services of the given resources in caches holding results for ttl. A service that is cached
+// already has its cache replaced, rather than wrapped in a second one.
+func (c *Client) enableCache(ttl time.Duration, resources []CachedResource) error {
+	for _, resource := range resources {
+		switch resource {
+		case CacheBuildings:
+			service := c.Buildings
+			if cached, ok := service.(*cachedBuildings); ok {
+				service = cached.BuildingsService
+			}
+			c.Buildings = &cachedBuildings{BuildingsService: service, lists: newTTLCache(ttl, cloneBuildings), byName: newTTLCache(ttl, cloneBuildingPointer)}
+		case CacheCategories:
+			service := c.Categories
+			if cached, ok := service.(*cachedCategories); ok {
+				service = cached.CategoriesService
+			}
+			c.Categories = &cachedCategories{CategoriesService: service, lists: newTTLCache(ttl, cloneSlice[Category]), byName: newTTLCache(ttl, clonePointer[Category])}
+		case CacheDepartments:
+			service := c.Departments
+			if cached, ok := service.(*cachedDepartments); ok {
+				service = cached.DepartmentsService
+			}
+			c.Departments = &cachedDepartments{DepartmentsService: service, lists: newTTLCache(ttl, cloneSlice[Department]), byName: newTTLCache(ttl, clonePointer[Department])}
+		case CacheSites:
+			service := c.Sites
+			if cached, ok := service.(*cachedSites); ok {
+				service = cached.SitesService
+			}
+			c.Sites = &cachedSites{SitesService: service, lists: newTTLCache(ttl, cloneSlice[Site]), byName: newTTLCache(ttl, clonePointer[Site])}
+		default:
+			return NewArgError("resources", string(resource)+" cannot be cached")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package jamfpro
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingInstance answers department and building lookups, counting the requests it gets.
+func countingInstance(requests *int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/"+departmentsBasePath:
+			departmentPage(2).ServeHTTP(w, r)
+		case strings.HasPrefix(r.URL.Path, "/"+departmentsBasePath+"/"):
+			id := strings.TrimPrefix(r.URL.Path, "/"+departmentsBasePath+"/")
+			fmt.Fprintf(w, `{"id":%q,"name":"Department %s"}`, id, id)
+		case r.URL.Path == "/"+buildingsBasePath:
+			fmt.Fprint(w, `{"totalCount":1,"results":[{"id":"1","name":"HQ","city":"Oslo"}]}`)
+		case r.URL.Path == "/"+buildingsBasePath+"/1":
+			fmt.Fprint(w, `{"id":"1","name":"HQ","city":"Oslo"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+func TestCacheReturnsCopies(t *testing.T) {
+	var requests int64
+	client, _ := newTestClient(t, countingInstance(&requests), WithCache(time.Minute, CacheBuildings, CacheDepartments))
+	ctx := context.Background()
+
+	department, _, err := client.Departments.GetByName(ctx, "Department 1")
+	if err != nil {
+		t.Fatalf("GetByName: %v", err)
+	}
+	department.Name = "Changed"
+	departments, _, err := client.Departments.List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	departments[0].Name = "Changed"
+
+	building, _, err := client.Buildings.GetByName(ctx, "HQ")
+	if err != nil {
+		t.Fatalf("Buildings.GetByName: %v", err)
+	}
+	*building.City = "Changed"
+	buildings, _, err := client.Buildings.List(ctx)
+	if err != nil {
+		t.Fatalf("Buildings.List: %v", err)
+	}
+	*buildings[0].Name = "Changed"
+
+	fetched := atomic.LoadInt64(&requests)
+
+	if department, _, _ := client.Departments.GetByName(ctx, "Department 1"); department.Name != "Department 1" {
+		t.Errorf("cached department is called %q after changing the one returned before", department.Name)
+	}
+	if departments, _, _ := client.Departments.List(ctx); departments[0].Name != "Department 1" {
+		t.Errorf("cached list holds %q after changing the one returned before", departments[0].Name)
+	}
+	if building, _, _ := client.Buildings.GetByName(ctx, "HQ"); *building.City != "Oslo" {
+		t.Errorf("cached building is in %q after changing the one returned before", *building.City)
+	}
+	if buildings, _, _ := client.Buildings.List(ctx); *buildings[0].Name != "HQ" {
+		t.Errorf("cached building list holds %q after changing the one returned before", *buildings[0].Name)
+	}
+	if got := atomic.LoadInt64(&requests); got != fetched {
+		t.Errorf("cached lookups sent %d requests, want none", got-fetched)
+	}
+}
+
+func TestWithCacheTwiceReplacesCache(t *testing.T) {
+	client, _ := newTestClient(t, http.NotFoundHandler(), WithCache(time.Minute), WithCache(time.Hour, CacheDepartments))
+
+	cached, ok := client.Departments.(*cachedDepartments)
+	if !ok {
+		t.Fatalf("Departments is a %T, want a cache", client.Departments)
+	}
+	if _, ok := cached.DepartmentsService.(*DepartmentsServiceOp); !ok {
+		t.Errorf("the department cache wraps a %T, want the service itself", cached.DepartmentsService)
+	}
+	if cached.lists.ttl != time.Hour {
+		t.Errorf("departments are cached for %v, want the ttl of the last WithCache", cached.lists.ttl)
+	}
+	if _, ok := client.Sites.(*cachedSites).SitesService.(*SitesServiceOp); !ok {
+		t.Errorf("the site cache wraps a %T, want the service itself", client.Sites.(*cachedSites).SitesService)
+	}
+}
+
+func TestCacheDropsLoadsInvalidatedWhileRunning(t *testing.T) {
+	cache := newTTLCache(time.Minute, clonePointer[Department])
+	loads := 0
+	load := func(name string) func() (*Department, *Response, error) {
+		return func() (*Department, *Response, error) {
+			loads++
+			if loads == 1 {
+				// A change made through the service while the first lookup is in flight
+				cache.invalidate()
+			}
+			return &Department{Id: "1", Name: name}, nil, nil
+		}
+	}
+
+	if got, _, _ := cache.get("Finance", load("Before")); got.Name != "Before" {
+		t.Errorf("first lookup = %q, want the loaded value", got.Name)
+	}
+	if got, _, _ := cache.get("Finance", load("After")); got.Name != "After" {
+		t.Errorf("lookup after the change = %q, want it loaded again rather than the result from before the change", got.Name)
+	}
+	if got, _, _ := cache.get("Finance", load("Later")); got.Name != "After" {
+		t.Errorf("later lookup = %q, want the cached result", got.Name)
+	}
+	if loads != 2 {
+		t.Errorf("loaded %d times, want 2", loads)
+	}
+}
@@ -15,6 +15,10 @@ type CategoriesService interface {
 	Create(context.Context, *CategoryCreateRequest) (*Category, *Response, error)
 	Update(context.Context, int, *CategoryUpdateRequest) (*Category, *Response, error)
 	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+	GetOrCreate(context.Context, string) (*Category, *Response, error)
+	ListHistory(context.Context, int, *ListOptions) ([]HistoryEntry, *Response, error)
+	AddHistoryNote(context.Context, int, string) (*HistoryNoteResponse, *Response, error)
 }
 
 // CategoriesServiceOp handles communication with the categories-related
@@ -27,7 +31,7 @@ var _ CategoriesService = &CategoriesServiceOp{}
 
 // Category represents a Jamf Pro Category
 type Category struct {
-	Id       string `json:"id,omitempty"`
+	Id       ID     `json:"id,omitempty"`
 	Name     string `json:"name"`
 	Priority int    `json:"priority"`
 	Href     string `json:"href,omitempty"`
@@ -47,19 +51,19 @@ type CategoryCreateRequest struct {
 
 // CategoryCreateResponse represents an API response to creating a category
 type CategoryCreateResponse struct {
-	Id   string `json:"id"`
+	Id   ID     `json:"id"`
 	Href string `json:"href"`
 }
 
 // CategoryUpdateRequest represents an API response to creating a category.
 type CategoryUpdateRequest struct {
-	Id       int    `json:"id,omitempty"`
+	Id       ID     `json:"id,omitempty"`
 	Name     string `json:"name"`
 	Priority int    `json:"priority"`
 }
 
 type CategoryUpdateResponse struct {
-	Id       string `json:"id"`
+	Id       ID     `json:"id"`
 	Name     string `json:"name"`
 	Priority int    `json:"priority"`
 }
@@ -85,31 +89,22 @@ func (c *CategoriesServiceOp) GetByID(ctx context.Context, i int) (*Category, *R
 	return &category, resp, err
 }
 
+// GetByName returns the category called name, looking it up with a filter rather than listing every category. The
+// error matches ErrNotFound if there is none.
 func (c *CategoriesServiceOp) GetByName(ctx context.Context, name string) (*Category, *Response, error) {
-	categories, _, err := c.list(ctx)
-	var id string
-	if err != nil {
-		return nil, nil, err
-	}
-
-	for i := range categories {
-		if categories[i].Name == name {
-			id = categories[i].Id
-			break
-		}
+	if name == "" {
+		return nil, nil, NewArgError("name", "cannot be empty")
 	}
-	intId, err := strconv.ParseInt(id, 10, 64)
 
-	if err != nil {
-		return nil, nil, err
-	}
-
-	category, resp, err := c.GetByID(ctx, int(intId))
+	category, resp, err := c.findByName(ctx, name)
 	if err != nil {
 		return nil, resp, err
 	}
+	if category == nil || category.Id.IsZero() {
+		return nil, resp, notFoundByName("category", name)
+	}
 
-	return category, resp, err
+	return c.GetByID(ctx, category.Id.Int())
 }
 
 func (c CategoriesServiceOp) Create(ctx context.Context, request *CategoryCreateRequest) (*Category, *Response, error) {
@@ -128,12 +123,7 @@ func (c CategoriesServiceOp) Create(ctx context.Context, request *CategoryCreate
 		return nil, resp, err
 	}
 
-	if categoryCreation.Id == "" {
-		return nil, resp, err
-	}
-
-	category := c.createCategoryFromCreationResponse(*categoryCreation, *request)
-	return &category, resp, err
+	return getCreated(ctx, resp, categoryCreation.Id, c.GetByID)
 }
 
 func (c *CategoriesServiceOp) Update(ctx context.Context, i int, request *CategoryUpdateRequest) (*Category, *Response, error) {
@@ -155,8 +145,7 @@ func (c *CategoriesServiceOp) Update(ctx context.Context, i int, request *Catego
 		return nil, resp, err
 	}
 
-	building := c.createCategoryFromUpdateResponse(*categoryUpdate, *request)
-	return &building, resp, err
+	return getUpdated(ctx, resp, categoryUpdate.Id, c.GetByID, nil)
 }
 
 func (c *CategoriesServiceOp) Delete(ctx context.Context, i int) (*Response, error) {
@@ -175,36 +164,82 @@ func (c *CategoriesServiceOp) Delete(ctx context.Context, i int) (*Response, err
 	return resp, err
 }
 
+// DeleteByName deletes the category called name. The error matches ErrNotFound if there is none.
+func (c *CategoriesServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "category", name, c.findByName, func(category *Category) ID { return category.Id }, c.Delete)
+}
+
+// list returns every category, fetching all pages.
 func (c *CategoriesServiceOp) list(ctx context.Context) ([]Category, *Response, error) {
-	path := categoriesBasePath
-	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	return listAll[Category](ctx, c.client, categoriesBasePath)
+}
+
+// defaultCategoryPriority is the priority Jamf Pro gives categories created in the web interface.
+const defaultCategoryPriority = 9
+
+// GetOrCreate returns the category called name, creating it if it does not exist yet. If another client creates the
+// category at the same time, the resulting conflict is resolved by fetching the category again.
+func (c *CategoriesServiceOp) GetOrCreate(ctx context.Context, name string) (*Category, *Response, error) {
+	if name == "" {
+		return nil, nil, NewArgError("name", "cannot be empty")
+	}
+
+	existing, resp, err := c.findByName(ctx, name)
+	if err != nil || existing != nil {
+		return existing, resp, err
+	}
+
+	created, resp, err := c.Create(ctx, &CategoryCreateRequest{Name: name, Priority: defaultCategoryPriority})
+	if err != nil && isConflict(resp) {
+		if existing, findResp, findErr := c.findByName(ctx, name); findErr == nil && existing != nil {
+			return existing, findResp, nil
+		}
+	}
+
+	return created, resp, err
+}
+
+// findByName returns the category called name, or nil if there is none.
+func (c *CategoriesServiceOp) findByName(ctx context.Context, name string) (*Category, *Response, error) {
+	path, err := addOptions(categoriesBasePath, &ListOptions{Filter: nameFilter(name)})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var categoryResponse CategoryListResponse
-	resp, err := c.client.Do(ctx, req, &categoryResponse)
+	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
 	if err != nil {
+		return nil, nil, err
+	}
+
+	var listResponse CategoryListResponse
+	resp, err := c.client.Do(ctx, req, &listResponse)
+	if err != nil || listResponse.Categories == nil {
 		return nil, resp, err
 	}
 
-	return *categoryResponse.Categories, resp, err
+	// RSQL treats * as a wildcard, so the filter can match more than the one name asked for.
+	results := *listResponse.Categories
+	for i := range results {
+		if results[i].Name == name {
+			return &results[i], resp, nil
+		}
+	}
 
+	return nil, resp, nil
 }
 
-func (c *CategoriesServiceOp) createCategoryFromCreationResponse(response CategoryCreateResponse, request CategoryCreateRequest) Category {
-	category := new(Category)
-	category.Id = response.Id
-	category.Href = response.Href
-	category.Name = request.Name
-	category.Priority = request.Priority
-	return *category
+// ListHistory returns one page of the change history of the category with the given ID.
+func (c *CategoriesServiceOp) ListHistory(ctx context.Context, id int, opts *ListOptions) ([]HistoryEntry, *Response, error) {
+	if id == 0 {
+		return nil, nil, NewArgError("category ID", "cannot be 0")
+	}
+	return listHistory(ctx, c.client, categoriesBasePath+"/"+strconv.Itoa(id)+"/history", opts)
 }
 
-func (c *CategoriesServiceOp) createCategoryFromUpdateResponse(response CategoryUpdateResponse, request CategoryUpdateRequest) Category {
-	category := new(Category)
-	category.Id = response.Id
-	category.Name = request.Name
-	category.Priority = request.Priority
-	return *category
+// AddHistoryNote adds a note to the change history of the category with the given ID.
+func (c *CategoriesServiceOp) AddHistoryNote(ctx context.Context, id int, note string) (*HistoryNoteResponse, *Response, error) {
+	if id == 0 {
+		return nil, nil, NewArgError("category ID", "cannot be 0")
+	}
+	return addHistoryNote(ctx, c.client, categoriesBasePath+"/"+strconv.Itoa(id)+"/history", note)
 }
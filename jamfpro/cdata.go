@@ -0,0 +1,45 @@
+package jamfpro
+
+import (
+	"encoding/xml"
+	"html"
+	"strings"
+)
+
+// CDATA is text embedded in a Classic API document, such as the property list in the payloads of a configuration
+// profile or the contents of a script. It is written as a CDATA section, so its bytes reach Jamf Pro exactly as
+// given instead of being entity-encoded, with newlines and tabs turned into character references.
+//
+// Jamf Pro returns some embedded documents escaped twice, so that decoding the response once still leaves &lt; in
+// place of <. CDATA undoes the second escaping when the text decodes to an escaped XML document, which lets a
+// profile be read, edited and written back without its payloads growing a layer of escaping on every round trip.
+type CDATA string
+
+func (c CDATA) String() string {
+	return string(c)
+}
+
+func (c CDATA) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	// The cdata field option splits any ]]> in the text across two sections.
+	return e.EncodeElement(struct {
+		Text string `xml:",cdata"`
+	}{string(c)}, start)
+}
+
+func (c *CDATA) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return err
+	}
+	*c = CDATA(unescapeEmbedded(text))
+	return nil
+}
+
+// unescapeEmbedded decodes text that is still an entity-escaped XML document after XML decoding. Other text, such as
+// scripts, is returned unchanged, as an entity in it cannot be told apart from one that belongs there.
+func unescapeEmbedded(text string) string {
+	if !strings.HasPrefix(strings.TrimSpace(text), "&lt;") {
+		return text
+	}
+	return html.UnescapeString(text)
+}
@@ -0,0 +1,121 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readCDATAFixture(t *testing.T, name string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", "cdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestCDATARoundTrip(t *testing.T) {
+	type document struct {
+		XMLName  xml.Name `xml:"general"`
+		Payloads CDATA    `xml:"payloads"`
+	}
+
+	for _, name := range []string{"wifi.mobileconfig", "login.sh"} {
+		t.Run(name, func(t *testing.T) {
+			text := readCDATAFixture(t, name)
+
+			data, err := xml.Marshal(document{Payloads: CDATA(text)})
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			encoded := string(data)
+			if !strings.HasPrefix(encoded, "<general><payloads><![CDATA[") {
+				t.Errorf("payloads are not written as a CDATA section:\n%s", encoded)
+			}
+			if strings.Contains(encoded, "&#xA;") || strings.Contains(encoded, "&amp;amp;") {
+				t.Errorf("payloads are entity-encoded:\n%s", encoded)
+			}
+			if strings.Contains(text, "]]>") && !strings.Contains(encoded, "]]]]><![CDATA[>") {
+				t.Errorf("]]> is not split across sections:\n%s", encoded)
+			}
+
+			var decoded document
+			if err := xml.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if string(decoded.Payloads) != text {
+				t.Errorf("round trip changed the payloads:\ngot  %q\nwant %q", decoded.Payloads, text)
+			}
+		})
+	}
+}
+
+func TestCDATADecodesProfileResponses(t *testing.T) {
+	want := readCDATAFixture(t, "wifi.mobileconfig")
+
+	// Jamf Pro returns payloads entity-escaped, in a CDATA section, and for some older profiles, escaped twice.
+	for _, fixture := range []string{"cdata/profile.xml", "cdata/profile_cdata.xml", "cdata/profile_escaped_twice.xml"} {
+		t.Run(fixture, func(t *testing.T) {
+			client, _ := newTestClient(t, serveFixture(t, fixture))
+
+			profile, _, err := client.MacOSConfigurationProfiles.GetByID(context.Background(), 42)
+			if err != nil {
+				t.Fatalf("GetByID: %v", err)
+			}
+			if got := string(profile.General.Payloads); got != want {
+				t.Errorf("payloads:\ngot  %q\nwant %q", got, want)
+			}
+
+			parsed, err := profile.Profile()
+			if err != nil {
+				t.Fatalf("Profile: %v", err)
+			}
+			if got, want := parsed.DisplayName(), "Wi-Fi & Proxy"; got != want {
+				t.Errorf("DisplayName = %q, want %q", got, want)
+			}
+			if got, _ := parsed.Payloads()[0].Get("Password"); got != "p<ss&w0rd]]>" {
+				t.Errorf("Password = %q, want %q", got, "p<ss&w0rd]]>")
+			}
+		})
+	}
+}
+
+func TestCDATASendsPayloadsUnchanged(t *testing.T) {
+	payloads := readCDATAFixture(t, "wifi.mobileconfig")
+	fixture := serveFixture(t, "cdata/profile.xml")
+
+	client, _ := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Decode the request as Jamf Pro does, with nothing undoing a second layer of escaping.
+			var sent struct {
+				Payloads string `xml:"general>payloads"`
+			}
+			if err := xml.Unmarshal(body, &sent); err != nil {
+				t.Fatalf("decoding the request: %v", err)
+			}
+			if sent.Payloads != payloads {
+				t.Errorf("Jamf Pro would read payloads:\n%q\nwant\n%q", sent.Payloads, payloads)
+			}
+			w.Header().Set("Content-Type", "application/xml")
+			io.WriteString(w, "<os_x_configuration_profile><id>42</id></os_x_configuration_profile>")
+			return
+		}
+		fixture.ServeHTTP(w, r)
+	}))
+
+	request := &MacOSConfigurationProfileRequest{General: MacOSConfigurationProfileGeneral{Name: "Wi-Fi & Proxy", Payloads: CDATA(payloads)}}
+	if _, _, err := client.MacOSConfigurationProfiles.Update(context.Background(), 42, request); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+)
+
+const changeManagementBasePath = "JSSResource/changemanagement"
+
+type ChangeManagementService interface {
+	Get(context.Context) (*ChangeManagement, *Response, error)
+	Update(context.Context, *ChangeManagement) (*ChangeManagement, *Response, error)
+}
+
+// ChangeManagementServiceOp handles communication with the change management
+// methods of the Jamf Pro API.
+type ChangeManagementServiceOp struct {
+	client *Client
+}
+
+var _ ChangeManagementService = &ChangeManagementServiceOp{}
+
+// ChangeManagement represents where Jamf Pro records the changes made to its configuration, in addition to the
+// object histories it always keeps.
+type ChangeManagement struct {
+	XMLName       xml.Name `xml:"change_management"`
+	UseSyslog     bool     `xml:"use_syslog"`
+	SyslogAddress string   `xml:"syslog_address"`
+	SyslogPort    int      `xml:"syslog_port"`
+	LogToFile     bool     `xml:"log_to_file"`
+	LogDirectory  string   `xml:"log_directory,omitempty"`
+}
+
+func (c *ChangeManagementServiceOp) Get(ctx context.Context) (*ChangeManagement, *Response, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, changeManagementBasePath, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var changeManagement ChangeManagement
+	resp, err := c.client.Do(ctx, req, &changeManagement)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &changeManagement, resp, err
+}
+
+// Update changes the change management settings and returns them as stored by the server.
+func (c *ChangeManagementServiceOp) Update(ctx context.Context, changeManagement *ChangeManagement) (*ChangeManagement, *Response, error) {
+	if changeManagement == nil {
+		return nil, nil, NewArgError("changeManagement", "cannot be nil")
+	} else if changeManagement.UseSyslog && changeManagement.SyslogAddress == "" {
+		return nil, nil, NewArgError("SyslogAddress", "cannot be empty when UseSyslog is set")
+	}
+
+	req, err := c.client.NewRequest(ctx, http.MethodPut, changeManagementBasePath, changeManagement, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.client.Do(ctx, req, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c.Get(ctx)
+}
@@ -0,0 +1,84 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+)
+
+// The v1 check-in endpoint has been superseded by v3, which accepts the same settings
+const checkInBasePath = "uapi/v3/check-in"
+
+type CheckInSettingsService interface {
+	Get(context.Context) (*CheckInSettings, *Response, error)
+	Update(context.Context, *CheckInSettings) (*CheckInSettings, *Response, error)
+	ListHistory(context.Context, *ListOptions) ([]HistoryEntry, *Response, error)
+	AddHistoryNote(context.Context, string) (*HistoryNoteResponse, *Response, error)
+}
+
+// CheckInSettingsServiceOp handles communication with the computer check-in settings
+// methods of the Jamf Pro API.
+type CheckInSettingsServiceOp struct {
+	client *Client
+}
+
+var _ CheckInSettingsService = &CheckInSettingsServiceOp{}
+
+// CheckInSettings represents the global settings deciding how often computers check in and which login and startup
+// hooks the Jamf agent installs
+type CheckInSettings struct {
+	CheckInFrequency                 int  `json:"checkInFrequency"`
+	CreateHooks                      bool `json:"createHooks"`
+	HookLog                          bool `json:"hookLog"`
+	HookPolicies                     bool `json:"hookPolicies"`
+	CreateStartupScript              bool `json:"createStartupScript"`
+	StartupLog                       bool `json:"startupLog"`
+	StartupPolicies                  bool `json:"startupPolicies"`
+	StartupSsh                       bool `json:"startupSsh"`
+	EnableLocalConfigurationProfiles bool `json:"enableLocalConfigurationProfiles"`
+}
+
+func (c *CheckInSettingsServiceOp) Get(ctx context.Context) (*CheckInSettings, *Response, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, checkInBasePath, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var settings CheckInSettings
+	resp, err := c.client.Do(ctx, req, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &settings, resp, err
+}
+
+func (c *CheckInSettingsServiceOp) Update(ctx context.Context, settings *CheckInSettings) (*CheckInSettings, *Response, error) {
+	if settings == nil {
+		return nil, nil, NewArgError("settings", "cannot be nil")
+	} else if settings.CheckInFrequency <= 0 {
+		return nil, nil, NewArgError("CheckInFrequency", "must be a positive number of minutes")
+	}
+
+	req, err := c.client.NewRequest(ctx, http.MethodPut, checkInBasePath, settings, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated CheckInSettings
+	resp, err := c.client.Do(ctx, req, &updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &updated, resp, err
+}
+
+// ListHistory returns the change history of the check-in settings.
+func (c *CheckInSettingsServiceOp) ListHistory(ctx context.Context, opts *ListOptions) ([]HistoryEntry, *Response, error) {
+	return listHistory(ctx, c.client, checkInBasePath+"/history", opts)
+}
+
+// AddHistoryNote adds a note to the change history of the check-in settings.
+func (c *CheckInSettingsServiceOp) AddHistoryNote(ctx context.Context, note string) (*HistoryNoteResponse, *Response, error) {
+	return addHistoryNote(ctx, c.client, checkInBasePath+"/history", note)
+}
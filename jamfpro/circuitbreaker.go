@@ -0,0 +1,81 @@
+package jamfpro
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by Do without sending the request while the client's circuit breaker is open, after
+// too many consecutive calls failed with a server error or timed out. Check for it with errors.Is.
+var ErrCircuitOpen = errors.New("jamfpro: circuit breaker is open, Jamf Pro is failing requests")
+
+// circuitBreaker tracks consecutive failed calls. Once failureThreshold have failed it opens, failing calls fast for
+// coolDown. After that, a single call is let through: if it succeeds the breaker closes again, otherwise it stays
+// open for another coolDown.
+type circuitBreaker struct {
+	failureThreshold int
+	coolDown         time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// allow returns ErrCircuitOpen if a call may not be made now. A nil breaker allows every call.
+func (b *circuitBreaker) allow() error {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.failureThreshold {
+		return nil
+	}
+	if b.probing || time.Now().Before(b.openUntil) {
+		return ErrCircuitOpen
+	}
+	b.probing = true
+	return nil
+}
+
+// record updates the breaker with the outcome of a call it allowed.
+func (b *circuitBreaker) record(resp *Response, err error) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if !isDegraded(resp, err) {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.failures >= b.failureThreshold {
+		b.openUntil = time.Now().Add(b.coolDown)
+	}
+}
+
+// isDegraded reports whether a call failed in a way that suggests the instance is struggling: a server error, or no
+// response before a timeout.
+func isDegraded(resp *Response, err error) bool {
+	if resp != nil && resp.Response != nil {
+		return resp.StatusCode >= http.StatusInternalServerError
+	}
+	if err == nil {
+		return false
+	}
+
+	var netErr net.Error
+	return errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout())
+}
@@ -0,0 +1,102 @@
+package jamfpro
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const classicPathPrefix = "/JSSResource/"
+
+// ClassicCall describes a request to a Classic API endpoint
+type ClassicCall struct {
+	Method string
+	// Path is the request path, e.g. /JSSResource/computergroups/id/7
+	Path string
+	// Resource is the Classic resource the path belongs to, e.g. computergroups
+	Resource string
+}
+
+// ClassicUsageRecorder is told about every request the client sends to a Classic API endpoint. Implementations must
+// be safe for concurrent use.
+type ClassicUsageRecorder interface {
+	RecordClassicCall(ClassicCall)
+}
+
+// ClassicUsageRecorderFunc adapts a function to a ClassicUsageRecorder
+type ClassicUsageRecorderFunc func(ClassicCall)
+
+func (f ClassicUsageRecorderFunc) RecordClassicCall(call ClassicCall) {
+	f(call)
+}
+
+// ClassicUsageCounter is a ClassicUsageRecorder counting calls per resource and method, to inventory which Classic
+// API endpoints an integration still depends on.
+type ClassicUsageCounter struct {
+	mu     sync.Mutex
+	counts map[ClassicUsageKey]int
+}
+
+// ClassicUsageKey identifies the calls a ClassicUsageCounter counts together
+type ClassicUsageKey struct {
+	Resource string
+	Method   string
+}
+
+// ClassicUsage is the number of calls made to one Classic resource with one method
+type ClassicUsage struct {
+	ClassicUsageKey
+	Count int
+}
+
+var _ ClassicUsageRecorder = &ClassicUsageCounter{}
+
+// NewClassicUsageCounter returns an empty ClassicUsageCounter
+func NewClassicUsageCounter() *ClassicUsageCounter {
+	return &ClassicUsageCounter{counts: make(map[ClassicUsageKey]int)}
+}
+
+func (c *ClassicUsageCounter) RecordClassicCall(call ClassicCall) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.counts == nil {
+		c.counts = make(map[ClassicUsageKey]int)
+	}
+	c.counts[ClassicUsageKey{Resource: call.Resource, Method: call.Method}]++
+}
+
+// Usage returns the calls counted so far, sorted by resource and then method.
+func (c *ClassicUsageCounter) Usage() []ClassicUsage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	usage := make([]ClassicUsage, 0, len(c.counts))
+	for key, count := range c.counts {
+		usage = append(usage, ClassicUsage{ClassicUsageKey: key, Count: count})
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Resource != usage[j].Resource {
+			return usage[i].Resource < usage[j].Resource
+		}
+		return usage[i].Method < usage[j].Method
+	})
+	return usage
+}
+
+// recordClassicUsage reports req to the client's ClassicUsageRecorder if it is a Classic API request.
+func (c *Client) recordClassicUsage(req *http.Request) {
+	if c.classicUsage == nil {
+		return
+	}
+
+	i := strings.Index(req.URL.Path, classicPathPrefix)
+	if i < 0 {
+		return
+	}
+
+	path := req.URL.Path[i:]
+	resource, _ := apiRoute(path)
+	c.classicUsage.RecordClassicCall(ClassicCall{Method: req.Method, Path: path, Resource: resource})
+}
@@ -1,54 +1,186 @@
 package jamfpro
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"io"
+	"log/slog"
+	"mime/multipart"
 	"net/http"
 	"net/url"
-	"reflect"
+	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/google/go-querystring/query"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
 	uriOAuthToken = "/api/oauth/token"
+
+	// maxTokenResponseSize bounds how much of a token response is read, as it is read whole to report errors.
+	maxTokenResponseSize = 64 << 10
 )
 
-// Client ... stores an object to talk with Jamf API
+// Client ... stores an object to talk with Jamf API. A Client is safe for concurrent use by multiple goroutines.
 type Client struct {
 	clientId, clientSecret string
-	token                  *string
-	tokenExpiration        *time.Time
-	apBalanceId            string
-	jamfProIngress         string
+	tokenStore             TokenStore
+
+	// tokenURL replaces the instance's own token endpoint if set, scopes are requested with every token, and
+	// discoveryURL is where the token endpoint is looked up if tokenURL is not set
+	tokenURL     string
+	scopes       []string
+	discoveryURL string
+
+	// refreshMu is held while a bearer token is requested, and guards the token endpoint once it has been discovered
+	refreshMu sync.Mutex
+
+	// mu guards the bearer token and session affinity cookies, which are replaced while requests are in flight
+	mu              sync.RWMutex
+	token           *string
+	tokenExpiration *time.Time
+	apBalanceId     string
+	jamfProIngress  string
+	affinity        SessionAffinity
+	affinityHook    func(SessionAffinityChange)
 
 	instanceUrl *url.URL
 
-	// The Http Client that is used to make requests
+	// The Http Client that is used to make requests, and the timeouts its transport is given unless the caller
+	// supplied their own client without asking for timeouts
 	client           *http.Client
-	HttpRetryTimeout time.Duration
-
-	ApiRoles       ApiRolesService
-	Buildings      BuildingsService
-	Categories     CategoriesService
-	Computers      ComputersService
-	ComputerGroups ComputerGroupsService
-	Departments    DepartmentsService
-
-	// Option to specify extra headers like User-Agent
+	customHTTPClient bool
+	connectTimeout   time.Duration
+	readTimeout      time.Duration
+	timeoutsSet      bool
+	userAgent        string
+	classicUsage     ClassicUsageRecorder
+	tracer           trace.Tracer
+	metrics          Metrics
+	breaker          *circuitBreaker
+	guard            *instanceGuard
+	readOnly         bool
+	logger           *slog.Logger
+
+	ActivationCode                      ActivationCodeService
+	AdvancedComputerSearches            AdvancedComputerSearchesService
+	AdvancedMobileDeviceSearches        AdvancedMobileDeviceSearchesService
+	AdvancedUserSearches                AdvancedUserSearchesService
+	AllowedFileExtensions               AllowedFileExtensionsService
+	ApiRoles                            ApiRolesService
+	AppStoreCountryCodes                AppStoreCountryCodesService
+	Buildings                           BuildingsService
+	Categories                          CategoriesService
+	ChangeManagement                    ChangeManagementService
+	CheckInSettings                     CheckInSettingsService
+	CloudServices                       CloudServicesService
+	ComputerApplications                ComputerApplicationsService
+	ComputerInventoryCollectionSettings ComputerInventoryCollectionSettingsService
+	ComputerInvitations                 ComputerInvitationsService
+	ComputerPrestages                   ComputerPrestagesService
+	Computers                           ComputersService
+	ComputerGroups                      ComputerGroupsService
+	ConnectionTests                     ConnectionTestsService
+	DeclarativeManagement               DeclarativeManagementService
+	Departments                         DepartmentsService
+	DeviceAssignments                   DeviceAssignmentsService
+	DirectoryBindings                   DirectoryBindingsService
+	DiskEncryptionConfigurations        DiskEncryptionConfigurationsService
+	DockItems                           DockItemsService
+	Ebooks                              EbooksService
+	EngageSettings                      EngageSettingsService
+	FileUploads                         FileUploadsService
+	GroupAccounts                       GroupAccountsService
+	GSXConnection                       GSXConnectionService
+	History                             HistoryService
+	InventoryPreload                    InventoryPreloadService
+	JamfConnect                         JamfConnectService
+	LogFlush                            LogFlushService
+	MacOSConfigurationProfiles          MacOSConfigurationProfilesService
+	MdmCommands                         MdmCommandsService
+	MobileDeviceApps                    MobileDeviceAppsService
+	MobileDeviceCommands                MobileDeviceCommandsService
+	MobileDeviceConfigurationProfiles   MobileDeviceConfigurationProfilesService
+	MobileDeviceInvitations             MobileDeviceInvitationsService
+	MobileDevicesInventory              MobileDevicesInventoryService
+	Policies                            PoliciesService
+	PushCertificate                     PushCertificateService
+	ReenrollmentSettings                ReenrollmentSettingsService
+	RemoteAdministration                RemoteAdministrationService
+	RemovableMacAddresses               RemovableMacAddressesService
+	Scripts                             ScriptsService
+	SettingsSnapshot                    SettingsSnapshotService
+	Sites                               SitesService
+	Webhooks                            WebhooksService
+
+	// Option to specify extra headers like User-Agent. These are added to every request created by NewRequest, and
+	// to the token requests, and take precedence over the headers the client sets itself.
 	ExtraHeader map[string]string
 }
 
 // Response is a Jamf Pro response. This wraps the standard http.Response returned from Jamf Pro.
 type Response struct {
 	*http.Response
+
+	// TotalCount is the number of results of a paginated Jamf Pro API list across all pages, and Page and PageSize
+	// the page that was requested, with Jamf Pro's defaults filled in. All three are zero for other responses.
+	TotalCount int
+	Page       int
+	PageSize   int
+
+	// RequestID is the correlation ID Jamf Pro, or the load balancer in front of it, assigned to the request. Log it
+	// to match failed requests with server logs and support cases. It is empty if the response carries none.
+	RequestID string
+
+	// ETag is the entity tag of the returned version of the resource, if Jamf Pro sent one. Pass it to
+	// ContextWithIfMatch to only update the resource if nobody has changed it since.
+	ETag string
+}
+
+// requestIDHeaders are the headers a request ID is read from, in order of preference.
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "X-Trace-Id", "X-Amzn-Trace-Id"}
+
+const defaultPageSize = 100
+
+// maxPooledBuffer is the largest buffer put back into bufferPool, so one huge response does not stay in memory.
+const maxPooledBuffer = 1 << 20
+
+// bufferPool holds the buffers JSON responses are read into.
+var bufferPool = sync.Pool{New: func() interface{} { return new(bytes.Buffer) }}
+
+// readerPool holds the buffered readers XML responses are decoded from. xml.Decoder buffers any reader that is not an
+// io.ByteReader itself, so handing it a pooled one saves allocating a new buffer for every response.
+var readerPool = sync.Pool{New: func() interface{} { return bufio.NewReader(nil) }}
+
+func getReader(r io.Reader) *bufio.Reader {
+	br := readerPool.Get().(*bufio.Reader)
+	br.Reset(r)
+	return br
+}
+
+func putReader(br *bufio.Reader) {
+	br.Reset(nil)
+	readerPool.Put(br)
+}
+
+func getBuffer() *bytes.Buffer {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	return buf
+}
+
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() <= maxPooledBuffer {
+		bufferPool.Put(buf)
+	}
 }
 
 // An ErrorResponse reports the error caused by an API request
@@ -58,6 +190,10 @@ type ErrorResponse struct {
 
 	// Error message
 	Message string `json:"message"`
+
+	// Code is the short error code the Classic API puts above its message, e.g. Conflict. It is empty for Jamf Pro
+	// API errors.
+	Code string `json:"-"`
 }
 
 type responseOAuthToken struct {
@@ -68,13 +204,21 @@ type responseOAuthToken struct {
 }
 
 type FormOptions struct {
-	ClientId     string `url:"client_id"`
-	ClientSecret string `url:"client_secret"`
-	GrantType    string `url:"grant_type"`
+	ClientId     string
+	ClientSecret string
+	GrantType    string
+}
+
+// EncodeQuery adds the options as the client_id, client_secret and grant_type form fields.
+func (o *FormOptions) EncodeQuery(q *QueryParams) {
+	if o == nil {
+		return
+	}
+	q.Set("client_id", o.ClientId).Set("client_secret", o.ClientSecret).Set("grant_type", o.GrantType)
 }
 
 // NewClient ... returns a new jamf.Client which can be used to access the API using the new bearer tokens
-func NewClient(clientId, clientSecret, instance string, sessionToken string) (*Client, error) {
+func NewClient(clientId, clientSecret, instance string, sessionToken string, opts ...ClientOption) (*Client, error) {
 
 	instanceUrl, err := url.Parse(instance)
 
@@ -82,35 +226,98 @@ func NewClient(clientId, clientSecret, instance string, sessionToken string) (*C
 		return nil, err
 	}
 	c := &Client{
-		clientId:         clientId,
-		clientSecret:     clientSecret,
-		instanceUrl:      instanceUrl,
-		token:            nil,
-		client:           http.DefaultClient,
-		HttpRetryTimeout: 60 * time.Second,
-		ExtraHeader:      make(map[string]string),
+		clientId:       clientId,
+		clientSecret:   clientSecret,
+		instanceUrl:    instanceUrl,
+		token:          nil,
+		client:         http.DefaultClient,
+		connectTimeout: defaultConnectTimeout,
+		readTimeout:    defaultReadTimeout,
+		userAgent:      defaultUserAgent(),
+		ExtraHeader:    make(map[string]string),
 	}
 
+	c.ActivationCode = &ActivationCodeServiceOp{client: c}
+	c.AdvancedComputerSearches = &AdvancedComputerSearchesServiceOp{client: c}
+	c.AdvancedMobileDeviceSearches = &AdvancedMobileDeviceSearchesServiceOp{client: c}
+	c.AdvancedUserSearches = &AdvancedUserSearchesServiceOp{client: c}
+	c.AllowedFileExtensions = &AllowedFileExtensionsServiceOp{client: c}
 	c.ApiRoles = &ApiRolesServiceOp{client: c}
+	c.AppStoreCountryCodes = &AppStoreCountryCodesServiceOp{client: c}
 	c.Buildings = &BuildingsServiceOp{client: c}
 	c.Categories = &CategoriesServiceOp{client: c}
+	c.ChangeManagement = &ChangeManagementServiceOp{client: c}
+	c.CheckInSettings = &CheckInSettingsServiceOp{client: c}
+	c.CloudServices = &CloudServicesServiceOp{client: c}
+	c.ComputerApplications = &ComputerApplicationsServiceOp{client: c}
+	c.ComputerInventoryCollectionSettings = &ComputerInventoryCollectionSettingsServiceOp{client: c}
+	c.ComputerInvitations = &ComputerInvitationsServiceOp{client: c}
+	c.ComputerPrestages = &ComputerPrestagesServiceOp{client: c}
 	c.Computers = &ComputersServiceOp{client: c}
 	c.ComputerGroups = &ComputerGroupsServiceOp{client: c}
+	c.ConnectionTests = &ConnectionTestsServiceOp{client: c}
+	c.DeclarativeManagement = &DeclarativeManagementServiceOp{client: c}
 	c.Departments = &DepartmentsServiceOp{client: c}
+	c.DeviceAssignments = &DeviceAssignmentsServiceOp{client: c}
+	c.DirectoryBindings = &DirectoryBindingsServiceOp{client: c}
+	c.DiskEncryptionConfigurations = &DiskEncryptionConfigurationsServiceOp{client: c}
+	c.DockItems = &DockItemsServiceOp{client: c}
+	c.Ebooks = &EbooksServiceOp{client: c}
+	c.EngageSettings = &EngageSettingsServiceOp{client: c}
+	c.FileUploads = &FileUploadsServiceOp{client: c}
+	c.GroupAccounts = &GroupAccountsServiceOp{client: c}
+	c.GSXConnection = &GSXConnectionServiceOp{client: c}
+	c.History = &HistoryServiceOp{client: c}
+	c.InventoryPreload = &InventoryPreloadServiceOp{client: c}
+	c.JamfConnect = &JamfConnectServiceOp{client: c}
+	c.LogFlush = &LogFlushServiceOp{client: c}
+	c.MacOSConfigurationProfiles = &MacOSConfigurationProfilesServiceOp{client: c}
+	c.MdmCommands = &MdmCommandsServiceOp{client: c}
+	c.MobileDeviceApps = &MobileDeviceAppsServiceOp{client: c}
+	c.MobileDeviceCommands = &MobileDeviceCommandsServiceOp{client: c}
+	c.MobileDeviceConfigurationProfiles = &MobileDeviceConfigurationProfilesServiceOp{client: c}
+	c.MobileDeviceInvitations = &MobileDeviceInvitationsServiceOp{client: c}
+	c.MobileDevicesInventory = &MobileDevicesInventoryServiceOp{client: c}
+	c.Policies = &PoliciesServiceOp{client: c}
+	c.PushCertificate = &PushCertificateServiceOp{client: c}
+	c.ReenrollmentSettings = &ReenrollmentSettingsServiceOp{client: c}
+	c.RemoteAdministration = &RemoteAdministrationServiceOp{client: c}
+	c.RemovableMacAddresses = &RemovableMacAddressesServiceOp{client: c}
+	c.Scripts = &ScriptsServiceOp{client: c}
+	c.SettingsSnapshot = &SettingsSnapshotServiceOp{client: c}
+	c.Sites = &SitesServiceOp{client: c}
+	c.Webhooks = &WebhooksServiceOp{client: c}
 
 	if sessionToken != "" {
-		c.apBalanceId = sessionToken
-		c.jamfProIngress = sessionToken
+		c.setSessionAffinity(SessionAffinityPinned(sessionToken))
 	}
 
-	if err := c.refreshAuthToken(); err != nil {
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.timeoutsSet || !c.customHTTPClient {
+		if err := c.setTimeouts(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.refreshAuthToken(context.Background()); err != nil {
 		return c, errors.Wrap(err, "Error getting bearer auth token")
 	}
 
 	return c, nil
 }
 
+// GetSessionToken returns the affinity cookie the client sends, preferring APBALANCEID.
+//
+// Deprecated: use APBalanceID or JamfProIngress, which tell the two cookies apart.
 func (c *Client) GetSessionToken() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	if c.apBalanceId != "" {
 		return c.apBalanceId
 	} else if c.jamfProIngress != "" {
@@ -119,25 +326,60 @@ func (c *Client) GetSessionToken() string {
 	return ""
 }
 
-func (c *Client) refreshAuthToken() error {
-	if c.tokenExpiration != nil {
-		if c.tokenExpiration.After(time.Now()) {
+// refreshAuthToken requests a new bearer token unless the current one is still valid. The request is sent with ctx
+// through the configured HTTP client, so it goes through the same transport, TLS settings and proxy as every other
+// request, and carries the ExtraHeader headers.
+func (c *Client) refreshAuthToken(ctx context.Context) error {
+	// Run one refresh at a time so concurrent callers wait for it instead of each requesting their own token. c.mu is
+	// only taken to read and swap the token, so requests that do not need a new one are not held up by the exchange.
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	c.mu.Lock()
+	if c.tokenExpiration != nil && tokenUsable(*c.tokenExpiration) {
+		c.mu.Unlock()
+		return nil
+	}
+	c.token = nil
+	c.mu.Unlock()
+
+	if c.tokenStore != nil {
+		stored, err := c.tokenStore.Load(c.tokenStoreKey())
+		if err != nil {
+			c.warn("Could not load the bearer token from the token store, requesting a new one", "error", err)
+		} else if stored.Valid() {
+			c.setToken(stored.AccessToken, stored.Expiration)
 			return nil
 		}
 	}
 
-	c.token = nil
-
 	var out *responseOAuthToken
 	data := url.Values{}
 	data.Set("client_id", c.clientId)
 	data.Set("client_secret", c.clientSecret)
 	data.Set("grant_type", "client_credentials")
+	if len(c.scopes) > 0 {
+		data.Set("scope", strings.Join(c.scopes, " "))
+	}
 
-	client := &http.Client{}
+	tokenURL, err := c.tokenEndpoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Send the token request with the configured client, so it uses the same TLS and proxy settings as every other
+	// request.
+	client := c.client
 
-	req, err := http.NewRequest(http.MethodPost, c.instanceUrl.String()+uriOAuthToken, strings.NewReader(data.Encode()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return err
+	}
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", c.userAgent)
+	for k, v := range c.ExtraHeader {
+		req.Header.Set(k, v)
+	}
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -147,28 +389,102 @@ func (c *Client) refreshAuthToken() error {
 	defer resp.Body.Close()
 
 	// Try and grab the instance within the cluster we're talking to to avoid replication lag
-	for i := 0; i < len(resp.Cookies()); i++ {
-		if resp.Cookies()[i].Name == "jpro-ingress" && c.jamfProIngress == "" {
-			c.jamfProIngress = resp.Cookies()[i].Value
-			break
-		} else if resp.Cookies()[i].Name == "APBALANCEID" && c.apBalanceId == "" {
-			c.apBalanceId = resp.Cookies()[i].Value
-			break
+	c.trackSessionAffinity(resp)
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxTokenResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return newAuthError(resp.StatusCode, body, nil)
+	}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return newAuthError(resp.StatusCode, body, err)
+	}
+	if out == nil || out.AccessToken == nil || *out.AccessToken == "" {
+		return newAuthError(resp.StatusCode, body, errors.New("the response has no access_token"))
+	}
+	if out.ExpiresIn == nil {
+		return newAuthError(resp.StatusCode, body, errors.New("the response has no expires_in"))
+	}
+
+	expiration := time.Now().Add(time.Duration(*out.ExpiresIn) * time.Second)
+	c.setToken(*out.AccessToken, expiration)
+	if c.metrics != nil {
+		c.metrics.TokenRefreshed()
+	}
+
+	if c.tokenStore != nil {
+		// The token is usable without being stored, so a store that cannot be written only costs the next client a
+		// token request
+		if err := c.tokenStore.Save(c.tokenStoreKey(), &Token{AccessToken: *out.AccessToken, Expiration: expiration}); err != nil {
+			c.warn("Could not save the bearer token to the token store", "error", err)
 		}
 	}
 
-	decodeErr := json.NewDecoder(resp.Body).Decode(&out)
-	if decodeErr != nil {
+	return nil
+}
+
+// setToken replaces the client's bearer token with token, which expires at expiration.
+func (c *Client) setToken(token string, expiration time.Time) {
+	c.mu.Lock()
+	c.token = &token
+	c.tokenExpiration = &expiration
+	c.mu.Unlock()
+}
+
+// warn logs msg with args to the logger given with WithLogger, if any.
+func (c *Client) warn(msg string, args ...any) {
+	if c.logger != nil {
+		c.logger.Warn(msg, args...)
+	}
+}
+
+type unauthenticatedKey struct{}
+
+// withoutToken marks the requests made with ctx as not needing a bearer token, for endpoints such as the health check
+// that answer before the instance can issue one.
+func withoutToken(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unauthenticatedKey{}, true)
+}
+
+// ensureToken requests a new bearer token with ctx if the client has none, e.g. after InvalidateToken, or its token
+// is about to expire.
+func (c *Client) ensureToken(ctx context.Context) error {
+	if ctx.Value(unauthenticatedKey{}) != nil {
 		return nil
 	}
-	c.token = out.AccessToken
-	expiration := time.Now().Add(time.Duration(*out.ExpiresIn) * time.Second)
-	c.tokenExpiration = &expiration
 
-	return nil
+	c.mu.RLock()
+	valid := c.token != nil && c.tokenExpiration != nil && tokenUsable(*c.tokenExpiration)
+	c.mu.RUnlock()
+	if valid {
+		return nil
+	}
+	return errors.Wrap(c.refreshAuthToken(ctx), "Error getting bearer auth token")
+}
+
+// hasToken reports whether the client has been issued a bearer token
+func (c *Client) hasToken() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.token != nil
 }
 
-func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}, contentType string) (*http.Request, error) {
+// tokenStoreKey identifies this client's tokens in its TokenStore
+func (c *Client) tokenStoreKey() string {
+	key := c.instanceUrl.String() + "|" + c.clientId
+	if len(c.scopes) > 0 {
+		key += "|" + strings.Join(c.scopes, " ")
+	}
+	return key
+}
+
+// NewRequest creates an API request. urlStr is resolved relative to the instance URL, and body is encoded according
+// to contentType. A body that is an io.Reader is sent as it is, streamed rather than buffered, and closed after the
+// request if it is also an io.Closer. Any opts are applied after the client's own headers have been set.
+func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}, contentType string, opts ...RequestOption) (*http.Request, error) {
 	u, err := c.instanceUrl.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -183,33 +499,46 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 		}
 
 	default:
+		if r, ok := body.(io.Reader); ok {
+			request, err = newStreamingRequest(method, u.String(), r)
+			if err != nil {
+				return nil, err
+			}
+			if contentType == "" {
+				contentType = "application/octet-stream"
+			}
+			request.Header.Set("Content-Type", contentType)
+			break
+		}
+
+		// The body is encoded into a buffer of its own rather than a pooled one, as the request keeps it for retries
+		// and redirects, and callers may send a request more than once or not at all.
 		buf := new(bytes.Buffer)
 		if body != nil {
 			switch contentType {
 			case "application/xml":
-				err := xml.NewEncoder(buf).Encode(body)
-				if err != nil {
-					return nil, err
-				}
+				err = xml.NewEncoder(buf).Encode(body)
 			case "application/x-www-form-urlencoded":
-				b, err := query.Values(body)
-				if err != nil {
-					return nil, err
+				switch form := body.(type) {
+				case url.Values:
+					buf.WriteString(form.Encode())
+				case QueryEncoder:
+					var q QueryParams
+					form.EncodeQuery(&q)
+					buf.WriteString(q.Encode())
+				default:
+					err = NewArgError("body", "must be url.Values or a QueryEncoder to be sent as a form")
 				}
-				buf = bytes.NewBufferString(b.Encode())
 			case "application/json":
 				err = json.NewEncoder(buf).Encode(body)
-				if err != nil {
-					return nil, err
-				}
 			default:
 				err = json.NewEncoder(buf).Encode(body)
-				if err != nil {
-					return nil, err
-				}
 				contentType = "application/json"
 			}
 		}
+		if err != nil {
+			return nil, err
+		}
 
 		request, err = http.NewRequest(method, u.String(), buf)
 		if err != nil {
@@ -220,34 +549,194 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 	if contentType != "application/xml" {
 		request.Header.Set("Accept", "application/json")
 	}
-	if c.jamfProIngress != "" {
-		jamfProIngressCookie := &http.Cookie{Name: "jpro-ingress", Value: c.jamfProIngress, HttpOnly: false}
-		request.AddCookie(jamfProIngressCookie)
-	} else if c.apBalanceId != "" {
-		apBalanceIdCookie := &http.Cookie{Name: "APBALANCEID", Value: c.apBalanceId, HttpOnly: false}
-		request.AddCookie(apBalanceIdCookie)
+	if err := c.ensureToken(ctx); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	token, jamfProIngress, apBalanceId := c.token, c.jamfProIngress, c.apBalanceId
+	c.mu.RUnlock()
+
+	addSessionAffinity(request, jamfProIngress, apBalanceId)
+
+	if token != nil {
+		request.Header.Set("Authorization", "Bearer "+*token)
+	}
+
+	if etag, ok := ctx.Value(ifMatchKey{}).(string); ok && method != http.MethodGet && method != http.MethodHead {
+		request.Header.Set("If-Match", etag)
+	}
+
+	request.Header.Set("User-Agent", c.userAgent)
+	for k, v := range c.ExtraHeader {
+		request.Header.Set(k, v)
 	}
 
-	request.Header.Set("Authorization", "Bearer "+*c.token)
+	applyRequestOptions(request, opts)
 
 	return request, nil
 }
 
+// newUploadRequest creates a multipart/form-data request uploading content as the file fileName in the form field
+// fieldName, as the Jamf Pro API expects for file uploads. content is streamed, so large packages and icons are
+// never held in memory.
+func (c *Client) newUploadRequest(ctx context.Context, method, urlStr, fieldName, fileName string, content io.Reader, opts ...RequestOption) (*http.Request, error) {
+	var head bytes.Buffer
+	writer := multipart.NewWriter(&head)
+	if _, err := writer.CreateFormFile(fieldName, fileName); err != nil {
+		return nil, err
+	}
+
+	// Closing the writer appends the closing boundary, which goes after the content.
+	headLen := head.Len()
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	tail := append([]byte(nil), head.Bytes()[headLen:]...)
+	head.Truncate(headLen)
+
+	body := io.MultiReader(bytes.NewReader(head.Bytes()), content, bytes.NewReader(tail))
+	request, err := c.NewRequest(ctx, method, urlStr, body, writer.FormDataContentType(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if size, ok := readerSize(content); ok {
+		request.ContentLength = int64(len(head.Bytes())) + size + int64(len(tail))
+	}
+	if closer, ok := content.(io.Closer); ok {
+		request.Body = readCloser{Reader: body, Closer: closer}
+	}
+
+	return request, nil
+}
+
+// newStreamingRequest creates a request sending r as its body. Where the size of r can be found without reading it,
+// the request gets a Content-Length; otherwise it is sent chunked.
+func newStreamingRequest(method, url string, r io.Reader) (*http.Request, error) {
+	request, err := http.NewRequest(method, url, r)
+	if err != nil {
+		return nil, err
+	}
+	if request.ContentLength == 0 && request.Body != nil && request.Body != http.NoBody {
+		if size, ok := readerSize(r); ok {
+			request.ContentLength = size
+		} else {
+			request.ContentLength = -1
+		}
+	}
+	return request, nil
+}
+
+// readerSize returns the number of bytes left in r, if that is known without reading it.
+func readerSize(r io.Reader) (int64, bool) {
+	switch v := r.(type) {
+	case interface{ Len() int }:
+		return int64(v.Len()), true
+	case interface {
+		Stat() (os.FileInfo, error)
+		io.Seeker
+	}:
+		info, err := v.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return 0, false
+		}
+		offset, err := v.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, false
+		}
+		return info.Size() - offset, true
+	}
+	return 0, false
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
 // newResponse creates a new Response for the provided http.Response
 func newResponse(r *http.Response) *Response {
-	response := Response{Response: r}
+	response := Response{Response: r, ETag: r.Header.Get("ETag")}
+	for _, header := range requestIDHeaders {
+		if id := r.Header.Get(header); id != "" {
+			response.RequestID = id
+			break
+		}
+	}
 
 	return &response
 }
 
+// populatePageValues fills in the pagination fields if body is a paginated list, recognised by its totalCount.
+func (r *Response) populatePageValues(body []byte) {
+	if len(body) == 0 || body[0] != '{' {
+		return
+	}
+
+	var page struct {
+		TotalCount *int `json:"totalCount"`
+	}
+	if err := json.Unmarshal(body, &page); err != nil || page.TotalCount == nil {
+		return
+	}
+
+	r.TotalCount = *page.TotalCount
+	r.PageSize = defaultPageSize
+	if r.Request != nil {
+		query := r.Request.URL.Query()
+		if p, err := strconv.Atoi(query.Get("page")); err == nil {
+			r.Page = p
+		}
+		if size, err := strconv.Atoi(query.Get("page-size")); err == nil {
+			r.PageSize = size
+		}
+	}
+}
+
 // Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
-// the raw response will be written to v, without attempting to decode it.
-func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+// the raw response will be written to v, without attempting to decode it. Responses are requested gzip compressed and
+// decompressed before anything reads them. Any opts are applied to a copy of req, so req itself can be reused.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}, opts ...RequestOption) (*Response, error) {
+	if len(opts) > 0 {
+		req = req.Clone(ctx)
+		applyRequestOptions(req, opts)
+	}
+	if timeout, ok := req.Context().Value(requestTimeoutKey{}).(time.Duration); ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	if err := c.checkReadOnly(ctx, req); err != nil {
+		return nil, err
+	}
+	if err := c.guard.check(ctx, c.instanceUrl, req); err != nil {
+		return nil, err
+	}
+	c.recordClassicUsage(req)
+	if err := c.breaker.allow(); err != nil {
+		return nil, err
+	}
+
+	ctx, span := c.startSpan(ctx, req)
+	start := time.Now()
+	response, err := c.do(ctx, req, v)
+	c.breaker.record(response, err)
+	c.observeRequest(req, start, response, err)
+	endSpan(span, response, err)
+
+	return response, err
+}
+
+// do sends req and decodes its response into v, as described on Do.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	requestCompression(req)
 	resp, err := DoRequestWithClient(ctx, c.client, req)
 	if err != nil {
 		return nil, err
 	}
+	decompressResponse(resp)
 
 	defer func() {
 		// Ensure the response body is fully read and closed
@@ -267,6 +756,7 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	}()
 
 	response := newResponse(resp)
+	c.trackSessionAffinity(resp)
 
 	err = CheckResponse(resp)
 	if err != nil {
@@ -280,15 +770,30 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 				return nil, err
 			}
 		} else if strings.Contains(resp.Header.Get("Content-Type"), "xml") {
-			err = xml.NewDecoder(resp.Body).Decode(v)
+			br := getReader(resp.Body)
+			defer putReader(br)
+			err = xml.NewDecoder(br).Decode(v)
 			if err != nil {
 				return nil, err
 			}
 		} else {
-			err = json.NewDecoder(resp.Body).Decode(v)
-			if err != nil {
+			buf := getBuffer()
+			defer putBuffer(buf)
+			if resp.ContentLength > 0 && resp.ContentLength <= maxPooledBuffer {
+				buf.Grow(int(resp.ContentLength))
+			}
+			if _, err := buf.ReadFrom(resp.Body); err != nil {
+				return nil, err
+			}
+			data := buf.Bytes()
+			if len(bytes.TrimSpace(data)) == 0 {
+				// Keep reporting empty bodies as io.EOF, as json.Decoder does; callers rely on it.
+				return nil, io.EOF
+			}
+			if err = json.Unmarshal(data, v); err != nil {
 				return nil, err
 			}
+			response.populatePageValues(data)
 		}
 	}
 
@@ -311,7 +816,8 @@ func (r *ErrorResponse) Error() string {
 
 // CheckResponse checks the API response for errors, and returns them if present. A response is considered an
 // error if it has a status code outside the 200 range. API error responses are expected to have either no response
-// body, or a JSON response body that maps to ErrorResponse. Any other response body will be silently ignored.
+// body, or a JSON response body that maps to ErrorResponse. The HTML error pages of the Classic API are reduced to
+// their error code and message. Any other response body will be silently ignored.
 // If the API error response does not include the request ID in its body, the one from its header will be used.
 func CheckResponse(r *http.Response) error {
 	if c := r.StatusCode; c >= 200 && c <= 299 {
@@ -322,34 +828,10 @@ func CheckResponse(r *http.Response) error {
 	data, err := io.ReadAll(r.Body)
 	if err == nil && len(data) > 0 {
 		errorResponse.Message = string(data)
+		if code, message, ok := parseClassicError(data); ok {
+			errorResponse.Code, errorResponse.Message = code, message
+		}
 	}
 
 	return errorResponse
 }
-
-func addOptions(s string, opt interface{}) (string, error) {
-	v := reflect.ValueOf(opt)
-
-	if v.Kind() == reflect.Ptr && v.IsNil() {
-		return s, nil
-	}
-
-	origURL, err := url.Parse(s)
-	if err != nil {
-		return s, err
-	}
-
-	origValues := origURL.Query()
-
-	newValues, err := query.Values(opt)
-	if err != nil {
-		return s, err
-	}
-
-	for k, v := range newValues {
-		origValues[k] = v
-	}
-
-	origURL.RawQuery = origValues.Encode()
-	return origURL.String(), nil
-}
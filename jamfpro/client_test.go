@@ -0,0 +1,148 @@
+package jamfpro
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// departmentPage answers a page of a list of total departments, as the Jamf Pro API pages them, 100 to a page unless
+// the request asks for another page size.
+func departmentPage(total int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pageSize, err := strconv.Atoi(r.URL.Query().Get("page-size"))
+		if err != nil {
+			pageSize = 100
+		}
+
+		var b strings.Builder
+		fmt.Fprintf(&b, `{"totalCount":%d,"results":[`, total)
+		for i := page*pageSize + 1; i <= min(total, (page+1)*pageSize); i++ {
+			if i > page*pageSize+1 {
+				b.WriteByte(',')
+			}
+			fmt.Fprintf(&b, `{"id":"%d","name":"Department %d"}`, i, i)
+		}
+		b.WriteString(`]}`)
+
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, b.String())
+	})
+}
+
+func BenchmarkListDepartments(b *testing.B) {
+	client, _ := newTestClient(b, departmentPage(250))
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		departments, _, err := client.Departments.List(ctx)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(departments) != 250 {
+			b.Fatalf("listed %d departments, want 250", len(departments))
+		}
+	}
+}
+
+func BenchmarkNewRequestXML(b *testing.B) {
+	client, _ := newTestClient(b, http.NotFoundHandler())
+	ctx := context.Background()
+	request := &ComputerGroupRequest{Name: "Lab Machines", Computers: make([]Computer, 200)}
+	for i := range request.Computers {
+		request.Computers[i] = Computer{Id: FromInt(i + 1), SerialNumber: fmt.Sprintf("C02XK1ZZJ%03d", i)}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.NewRequest(ctx, http.MethodPost, computerGroupsBasePath+"/id/0", request, "application/xml"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// onlyReader hides every method of its reader but Read, as a response body does.
+type onlyReader struct {
+	r io.Reader
+}
+
+func (o onlyReader) Read(p []byte) (int, error) {
+	return o.r.Read(p)
+}
+
+// BenchmarkDecodeXML compares decoding an XML response through the pooled reader Do uses with decoding it straight
+// from the body.
+func BenchmarkDecodeXML(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "computergroups", "detail.xml"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			br := getReader(onlyReader{bytes.NewReader(data)})
+			var group ComputerGroup
+			if err := xml.NewDecoder(br).Decode(&group); err != nil {
+				b.Fatal(err)
+			}
+			putReader(br)
+		}
+	})
+	b.Run("unpooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var group ComputerGroup
+			if err := xml.NewDecoder(onlyReader{bytes.NewReader(data)}).Decode(&group); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// BenchmarkDecodeJSON compares reading a JSON response into the pooled buffer Do uses with reading it with io.ReadAll.
+func BenchmarkDecodeJSON(b *testing.B) {
+	data := []byte(computerListJSON(1000))
+
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf := getBuffer()
+			buf.Grow(len(data))
+			if _, err := buf.ReadFrom(onlyReader{bytes.NewReader(data)}); err != nil {
+				b.Fatal(err)
+			}
+			var list ComputerListResponse
+			if err := json.Unmarshal(buf.Bytes(), &list); err != nil {
+				b.Fatal(err)
+			}
+			putBuffer(buf)
+		}
+	})
+	b.Run("unpooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			read, err := io.ReadAll(onlyReader{bytes.NewReader(data)})
+			if err != nil {
+				b.Fatal(err)
+			}
+			var list ComputerListResponse
+			if err := json.Unmarshal(read, &list); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
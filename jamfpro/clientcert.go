@@ -0,0 +1,81 @@
+package jamfpro
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// clientCertificateFiles presents a client certificate loaded from a certificate and key file. The files are loaded
+// again when the certificate has expired or the files have changed, so a certificate renewed on disk is picked up
+// without restarting.
+type clientCertificateFiles struct {
+	certFile, keyFile string
+
+	mu       sync.Mutex
+	cert     *tls.Certificate
+	notAfter time.Time
+	modTime  time.Time
+}
+
+func newClientCertificateFiles(certFile, keyFile string) (*clientCertificateFiles, error) {
+	files := &clientCertificateFiles{certFile: certFile, keyFile: keyFile}
+	if err := files.load(); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// GetClientCertificate implements tls.Config.GetClientCertificate.
+func (f *clientCertificateFiles) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if time.Now().After(f.notAfter) || f.latestModTime().After(f.modTime) {
+		if err := f.load(); err != nil && time.Now().After(f.notAfter) {
+			return nil, err
+		}
+	}
+	return f.cert, nil
+}
+
+// load reads the certificate and key files. The caller holds f.mu, or has not shared f yet.
+func (f *clientCertificateFiles) load() error {
+	modTime := f.latestModTime()
+	cert, err := tls.LoadX509KeyPair(f.certFile, f.keyFile)
+	if err != nil {
+		return fmt.Errorf("loading client certificate: %w", err)
+	}
+
+	leaf, err := certificateLeaf(&cert)
+	if err != nil {
+		return err
+	}
+
+	f.cert, f.notAfter, f.modTime = &cert, leaf.NotAfter, modTime
+	return nil
+}
+
+// latestModTime returns when the certificate or key file was last modified, or the zero time if that is unknown.
+func (f *clientCertificateFiles) latestModTime() time.Time {
+	var latest time.Time
+	for _, name := range []string{f.certFile, f.keyFile} {
+		if info, err := os.Stat(name); err == nil && info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest
+}
+
+// certificateLeaf returns the parsed leaf of cert
+func certificateLeaf(cert *tls.Certificate) (*x509.Certificate, error) {
+	if cert.Leaf != nil {
+		return cert.Leaf, nil
+	} else if len(cert.Certificate) == 0 {
+		return nil, NewArgError("cert", "it contains no certificate")
+	}
+	return x509.ParseCertificate(cert.Certificate[0])
+}
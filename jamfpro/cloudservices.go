@@ -0,0 +1,175 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+const (
+	cloudServicesConnectionBasePath    = "uapi/v1/csa/token"
+	cloudServicesNotificationsBasePath = "uapi/v1/cloud-services/notifications"
+)
+
+type CloudServicesService interface {
+	GetConnection(context.Context) (*CloudServicesConnection, *Response, error)
+	Connect(context.Context, *CloudServicesConnectionRequest) (*CloudServicesConnection, *Response, error)
+	UpdateConnection(context.Context, *CloudServicesConnectionRequest) (*CloudServicesConnection, *Response, error)
+	Disconnect(context.Context) (*Response, error)
+	GetNotificationSettings(context.Context) (*CloudServicesNotificationSettings, *Response, error)
+	UpdateNotificationSettings(context.Context, *CloudServicesNotificationSettings) (*CloudServicesNotificationSettings, *Response, error)
+}
+
+// CloudServicesServiceOp handles communication with the Jamf Cloud Services connection
+// methods of the Jamf Pro API.
+type CloudServicesServiceOp struct {
+	client *Client
+}
+
+var _ CloudServicesService = &CloudServicesServiceOp{}
+
+// CloudServicesConnection describes the connection of Jamf Pro to Jamf Cloud Services, which integrations such as the
+// Teams and Slack notifications go through. RefreshExpiration is in seconds since the epoch.
+type CloudServicesConnection struct {
+	TenantId          string   `json:"tenantId"`
+	Subject           string   `json:"subject"`
+	RefreshExpiration int64    `json:"refreshExpiration"`
+	Scopes            []string `json:"scopes"`
+}
+
+// CloudServicesConnectionRequest holds the Jamf Account the connection to Jamf Cloud Services is made with.
+type CloudServicesConnectionRequest struct {
+	EmailAddress string `json:"emailAddress"`
+	Password     string `json:"password"`
+}
+
+// CloudServicesNotificationSettings holds the channels Jamf Pro posts its notifications to through Jamf Cloud
+// Services.
+type CloudServicesNotificationSettings struct {
+	Teams NotificationChannel `json:"teams"`
+	Slack NotificationChannel `json:"slack"`
+}
+
+// NotificationChannel is a Teams or Slack channel notifications are posted to through its incoming webhook.
+type NotificationChannel struct {
+	Enabled    bool   `json:"enabled"`
+	WebhookUrl string `json:"webhookUrl,omitempty"`
+}
+
+// GetConnection returns the connection to Jamf Cloud Services. The error matches ErrNotFound if the instance is not
+// connected.
+func (c *CloudServicesServiceOp) GetConnection(ctx context.Context) (*CloudServicesConnection, *Response, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, cloudServicesConnectionBasePath, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var connection CloudServicesConnection
+	resp, err := c.client.Do(ctx, req, &connection)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &connection, resp, err
+}
+
+// Connect connects the instance to Jamf Cloud Services with a Jamf Account.
+func (c *CloudServicesServiceOp) Connect(ctx context.Context, request *CloudServicesConnectionRequest) (*CloudServicesConnection, *Response, error) {
+	return c.connect(ctx, http.MethodPost, request)
+}
+
+// UpdateConnection connects the instance to Jamf Cloud Services again, with another Jamf Account.
+func (c *CloudServicesServiceOp) UpdateConnection(ctx context.Context, request *CloudServicesConnectionRequest) (*CloudServicesConnection, *Response, error) {
+	return c.connect(ctx, http.MethodPut, request)
+}
+
+// Disconnect removes the connection to Jamf Cloud Services, which stops the integrations that go through it.
+func (c *CloudServicesServiceOp) Disconnect(ctx context.Context) (*Response, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodDelete, cloudServicesConnectionBasePath, nil, "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+func (c *CloudServicesServiceOp) GetNotificationSettings(ctx context.Context) (*CloudServicesNotificationSettings, *Response, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, cloudServicesNotificationsBasePath, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var settings CloudServicesNotificationSettings
+	resp, err := c.client.Do(ctx, req, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &settings, resp, err
+}
+
+// UpdateNotificationSettings replaces the notification channels. Enabled channels must have an https webhook URL.
+func (c *CloudServicesServiceOp) UpdateNotificationSettings(ctx context.Context, settings *CloudServicesNotificationSettings) (*CloudServicesNotificationSettings, *Response, error) {
+	if settings == nil {
+		return nil, nil, NewArgError("settings", "cannot be nil")
+	}
+	if err := validateNotificationChannel("Teams", settings.Teams); err != nil {
+		return nil, nil, err
+	}
+	if err := validateNotificationChannel("Slack", settings.Slack); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.client.NewRequest(ctx, http.MethodPut, cloudServicesNotificationsBasePath, settings, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated CloudServicesNotificationSettings
+	resp, err := c.client.Do(ctx, req, &updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &updated, resp, err
+}
+
+func (c *CloudServicesServiceOp) connect(ctx context.Context, method string, request *CloudServicesConnectionRequest) (*CloudServicesConnection, *Response, error) {
+	if request == nil {
+		return nil, nil, NewArgError("connectionRequest", "cannot be nil")
+	} else if request.EmailAddress == "" {
+		return nil, nil, NewArgError("EmailAddress", "cannot be empty")
+	} else if request.Password == "" {
+		return nil, nil, NewArgError("Password", "cannot be empty")
+	}
+
+	req, err := c.client.NewRequest(ctx, method, cloudServicesConnectionBasePath, request, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var connection CloudServicesConnection
+	resp, err := c.client.Do(ctx, req, &connection)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &connection, resp, err
+}
+
+// validateNotificationChannel checks that an enabled channel has a webhook to post to
+func validateNotificationChannel(name string, channel NotificationChannel) error {
+	if !channel.Enabled {
+		return nil
+	}
+	webhook, err := url.Parse(channel.WebhookUrl)
+	if err != nil || webhook.Scheme != "https" || webhook.Host == "" {
+		return NewArgError(name+".WebhookUrl", "must be an https URL when the channel is enabled")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package jamfpro
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// requestCompression asks for a gzip compressed response, unless req already says which encodings it accepts. The
+// header is set explicitly, rather than left to http.Transport, so that responses are compressed even through
+// transports that disable compression or are not an *http.Transport at all; decompressResponse undoes it.
+func requestCompression(req *http.Request) {
+	if req.Header.Get("Accept-Encoding") == "" {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+}
+
+// decompressResponse replaces the body of a gzip compressed response with its decompressed content, and removes the
+// headers describing the compressed body.
+func decompressResponse(resp *http.Response) {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return
+	}
+
+	resp.Body = &gzipBody{body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+}
+
+// gzipBody decompresses a response body as it is read. The gzip header is only read on the first Read, so an empty
+// body, as sent with some error responses, reads as empty rather than failing.
+type gzipBody struct {
+	body io.ReadCloser
+	zr   *gzip.Reader
+	err  error
+}
+
+func (b *gzipBody) Read(p []byte) (int, error) {
+	if b.err != nil {
+		return 0, b.err
+	}
+	if b.zr == nil {
+		b.zr, b.err = gzip.NewReader(b.body)
+		if b.err != nil {
+			return 0, b.err
+		}
+	}
+	return b.zr.Read(p)
+}
+
+func (b *gzipBody) Close() error {
+	return b.body.Close()
+}
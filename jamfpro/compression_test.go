@@ -0,0 +1,59 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestDoDecompressesGzipResponses(t *testing.T) {
+	body := computerListJSON(3)
+	client, _ := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Accept-Encoding"); got != "gzip" {
+			t.Errorf("Accept-Encoding = %q, want gzip", got)
+		}
+		writeBody(w, r, "application/json", body)
+	}))
+
+	computers, _, err := client.Computers.List(context.Background())
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(computers) != 3 || computers[2].Name != "MacBook-00003" {
+		t.Errorf("List = %+v, want the 3 computers", computers)
+	}
+}
+
+// benchmarkListComputers lists 5000 computers, sending acceptEncoding unless it is empty, and reports the bytes the
+// server wrote per list.
+func benchmarkListComputers(b *testing.B, acceptEncoding string) {
+	body := computerListJSON(5000)
+	var written int64
+	client, _ := newTestClient(b, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var n int64
+		writeBody(countingWriter{ResponseWriter: w, n: &n}, r, "application/json", body)
+		atomic.AddInt64(&written, n)
+	}))
+	if acceptEncoding != "" {
+		client.ExtraHeader["Accept-Encoding"] = acceptEncoding
+	}
+
+	ctx := context.Background()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := client.Computers.List(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(atomic.LoadInt64(&written))/float64(b.N), "wire-B/op")
+}
+
+func BenchmarkListComputersGzip(b *testing.B) {
+	benchmarkListComputers(b, "")
+}
+
+func BenchmarkListComputersIdentity(b *testing.B) {
+	benchmarkListComputers(b, "identity")
+}
@@ -0,0 +1,226 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	computerApplicationsBasePath     = "JSSResource/computerapplications/application"
+	computerApplicationUsageBasePath = "JSSResource/computerapplicationusage"
+
+	// applicationUsageDateFormat is how the Classic API takes and reports the days of application usage
+	applicationUsageDateFormat = "2006-01-02"
+
+	// defaultUsageWindow is the number of days of usage ForEachUsage fetches per request
+	defaultUsageWindow = 31
+)
+
+type ComputerApplicationsService interface {
+	GetByName(context.Context, string, *ComputerApplicationOptions) (*ComputerApplication, *Response, error)
+	GetUsage(context.Context, int, time.Time, time.Time) ([]ApplicationUsageDay, *Response, error)
+	ForEachUsage(context.Context, int, *ApplicationUsageOptions, func(ApplicationUsageDay) error) error
+}
+
+// ComputerApplicationsServiceOp handles communication with the computer application and application usage related
+// methods of the Jamf Pro API.
+type ComputerApplicationsServiceOp struct {
+	client *Client
+}
+
+var _ ComputerApplicationsService = &ComputerApplicationsServiceOp{}
+
+// ComputerApplicationOptions narrows down the computers returned by ComputerApplications.GetByName.
+type ComputerApplicationOptions struct {
+	// Version only returns computers with this version of the application installed.
+	Version string
+
+	// Inventory adds these inventory fields, e.g. "Operating System Version", to each computer returned, as
+	// ComputerApplicationComputer.Inventory.
+	Inventory []string
+}
+
+// ComputerApplication lists the computers an application is installed on, by version. The name may contain *
+// wildcards, in which case the versions of every matching application are returned.
+type ComputerApplication struct {
+	XMLName         xml.Name                      `xml:"computer_applications"`
+	Versions        []ComputerApplicationVersion  `xml:"versions>version"`
+	UniqueComputers []ComputerApplicationComputer `xml:"unique_computers>computer"`
+}
+
+// ComputerApplicationVersion is a version of an application and the computers it is installed on
+type ComputerApplicationVersion struct {
+	Number    string                        `xml:"number"`
+	Computers []ComputerApplicationComputer `xml:"computers>computer"`
+}
+
+// ComputerApplicationComputer is a computer an application is installed on
+type ComputerApplicationComputer struct {
+	Id           ID     `xml:"id"`
+	Name         string `xml:"name"`
+	Udid         string `xml:"udid"`
+	SerialNumber string `xml:"serial_number"`
+	MacAddress   string `xml:"mac_address"`
+
+	// Inventory holds the fields asked for with ComputerApplicationOptions.Inventory, keyed by the XML element Jamf
+	// Pro reports them as, e.g. Operating_System_Version.
+	Inventory ComputerApplicationInventory `xml:",any"`
+}
+
+// ComputerApplicationInventory holds the additional inventory fields of a computer an application is installed on.
+type ComputerApplicationInventory map[string]string
+
+// UnmarshalXML collects the inventory fields, one element at a time.
+func (i *ComputerApplicationInventory) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value string
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	if *i == nil {
+		*i = make(ComputerApplicationInventory)
+	}
+	(*i)[start.Name.Local] = value
+	return nil
+}
+
+// ApplicationUsageDay is the usage of applications on a computer on one day
+type ApplicationUsageDay struct {
+	Date string `xml:"date"`
+
+	// Total is the number of minutes any application was in use.
+	Total int                `xml:"total"`
+	Apps  []ApplicationUsage `xml:"apps>app"`
+}
+
+// Time returns the day as a time at midnight UTC.
+func (d ApplicationUsageDay) Time() (time.Time, error) {
+	return time.Parse(applicationUsageDateFormat, d.Date)
+}
+
+// ApplicationUsage is the number of minutes an application was open, and in the foreground, on one day
+type ApplicationUsage struct {
+	Name       string `xml:"name"`
+	Version    string `xml:"version"`
+	Foreground int    `xml:"foreground"`
+	Open       int    `xml:"open"`
+}
+
+// ApplicationUsageListResponse represents the raw API response to getting the application usage of a computer
+type ApplicationUsageListResponse struct {
+	XMLName xml.Name              `xml:"computer_application_usage"`
+	Usage   []ApplicationUsageDay `xml:"usage"`
+}
+
+// ApplicationUsageOptions selects the days of usage visited by ComputerApplications.ForEachUsage. Start and End are
+// both included, and only their dates matter.
+type ApplicationUsageOptions struct {
+	Start time.Time
+	End   time.Time
+
+	// WindowDays is the number of days fetched per request, 31 if it is not positive. The Classic API returns a
+	// range in one response, so long ranges are split to bound the size of each.
+	WindowDays int
+}
+
+// GetByName returns the computers the application called name is installed on, grouped by version.
+func (c *ComputerApplicationsServiceOp) GetByName(ctx context.Context, name string, opts *ComputerApplicationOptions) (*ComputerApplication, *Response, error) {
+	if name == "" {
+		return nil, nil, NewArgError("name", "cannot be empty")
+	}
+
+	path := pathJoin(computerApplicationsBasePath, name)
+	if opts != nil {
+		if opts.Version != "" {
+			path = pathJoin(path, "version", opts.Version)
+		}
+		if len(opts.Inventory) > 0 {
+			path = pathJoin(path, "inventory", strings.Join(opts.Inventory, ","))
+		}
+	}
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var application ComputerApplication
+	resp, err := c.client.Do(ctx, req, &application)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &application, resp, err
+}
+
+// GetUsage returns the application usage of the computer with the given ID on each day from start to end, both
+// included.
+func (c *ComputerApplicationsServiceOp) GetUsage(ctx context.Context, id int, start, end time.Time) ([]ApplicationUsageDay, *Response, error) {
+	if id == 0 {
+		return nil, nil, NewArgError("computer ID", "cannot be 0")
+	} else if end.Before(start) {
+		return nil, nil, NewArgError("end", "cannot be before start")
+	}
+
+	path := computerApplicationUsageBasePath + "/id/" + strconv.Itoa(id) + "/" +
+		start.Format(applicationUsageDateFormat) + "_" + end.Format(applicationUsageDateFormat)
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var usageResponse ApplicationUsageListResponse
+	resp, err := c.client.Do(ctx, req, &usageResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return usageResponse.Usage, resp, err
+}
+
+// ForEachUsage calls handle with the application usage of the computer with the given ID on each day in the range
+// of opts, in order, fetching opts.WindowDays at a time. It stops at the first error from the server or handle.
+func (c *ComputerApplicationsServiceOp) ForEachUsage(ctx context.Context, id int, opts *ApplicationUsageOptions, handle func(ApplicationUsageDay) error) error {
+	if opts == nil {
+		return NewArgError("opts", "cannot be nil")
+	} else if handle == nil {
+		return NewArgError("handle", "cannot be nil")
+	}
+	window := opts.WindowDays
+	if window <= 0 {
+		window = defaultUsageWindow
+	}
+
+	start := truncateToDay(opts.Start)
+	end := truncateToDay(opts.End)
+	if end.Before(start) {
+		return NewArgError("End", "cannot be before Start")
+	}
+
+	for from := start; !from.After(end); from = from.AddDate(0, 0, window) {
+		to := from.AddDate(0, 0, window-1)
+		if to.After(end) {
+			to = end
+		}
+
+		days, _, err := c.GetUsage(ctx, id, from, to)
+		if err != nil {
+			return err
+		}
+		for _, day := range days {
+			if err := handle(day); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// truncateToDay returns the date of t, at midnight UTC.
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
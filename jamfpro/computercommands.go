@@ -0,0 +1,242 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const (
+	computerCommandsBasePath = "JSSResource/computercommands/command"
+	computerInventoryV1Path  = "uapi/v1/computer-inventory"
+	mdmRenewProfilePath      = "uapi/v1/mdm/renew-profile"
+)
+
+// ErrNotMDMCapable matches, with errors.Is, the *MDMCapabilityError returned when a computer cannot be sent an MDM
+// command because it has no MDM profile.
+var ErrNotMDMCapable = errors.New("jamfpro: the computer is not MDM capable")
+
+// MDMCapabilityError is returned by the Computers methods that send MDM commands when the computer lacks what the
+// command needs, e.g. an MDM profile or a management ID.
+type MDMCapabilityError struct {
+	ComputerId int
+	// Reason says what the computer lacks
+	Reason string
+}
+
+func (e *MDMCapabilityError) Error() string {
+	return fmt.Sprintf("jamfpro: computer %d %s", e.ComputerId, e.Reason)
+}
+
+// Is makes errors.Is match ErrNotMDMCapable.
+func (e *MDMCapabilityError) Is(target error) bool {
+	return target == ErrNotMDMCapable
+}
+
+// EraseOptions holds the parameters of an erase command sent with Computers.Erase
+type EraseOptions struct {
+	// Pin is the six digit Find My PIN required to unlock the Mac after it has been erased. It is required for Intel
+	// Macs without a T2 chip and ignored otherwise.
+	Pin string
+	// ObliterationBehavior decides what happens if the Mac cannot erase itself, one of the ObliterationBehavior*
+	// constants. Defaults to ObliterationBehaviorDefault.
+	ObliterationBehavior string
+}
+
+// Lock sends a DEVICE_LOCK command to a computer and returns the UUID of the queued command, which can be used to poll
+// its status. pin is the six digit PIN needed to unlock the computer again.
+func (c *ComputersServiceOp) Lock(ctx context.Context, id int, pin string) (string, *Response, error) {
+	if err := validateMdmPin(pin); err != nil {
+		return "", nil, err
+	}
+
+	managementId, resp, err := c.managementId(ctx, id)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return sendMdmCommand(ctx, c.client, managementId, MdmCommandData{
+		CommandType: MdmCommandTypeDeviceLock,
+		Pin:         pin,
+	})
+}
+
+// Erase sends an ERASE_DEVICE command to a computer and returns the UUID of the queued command, which can be used to
+// poll its status.
+func (c *ComputersServiceOp) Erase(ctx context.Context, id int, opts EraseOptions) (string, *Response, error) {
+	if opts.Pin != "" {
+		if err := validateMdmPin(opts.Pin); err != nil {
+			return "", nil, err
+		}
+	}
+
+	switch opts.ObliterationBehavior {
+	case "":
+		opts.ObliterationBehavior = ObliterationBehaviorDefault
+	case ObliterationBehaviorDefault, ObliterationBehaviorDoNotObliterate, ObliterationBehaviorObliterateWithWarning, ObliterationBehaviorAlways:
+	default:
+		return "", nil, NewArgError("ObliterationBehavior", fmt.Sprintf("%q is not a known obliteration behavior", opts.ObliterationBehavior))
+	}
+
+	managementId, resp, err := c.managementId(ctx, id)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return sendMdmCommand(ctx, c.client, managementId, MdmCommandData{
+		CommandType:          MdmCommandTypeEraseDevice,
+		Pin:                  opts.Pin,
+		ObliterationBehavior: opts.ObliterationBehavior,
+	})
+}
+
+// managementId looks up the MDM management ID of a computer, which MDM commands are addressed to
+func (c *ComputersServiceOp) managementId(ctx context.Context, id int) (string, *Response, error) {
+	inventory, resp, err := c.GetInventoryDetail(ctx, id)
+	if err != nil {
+		return "", resp, err
+	}
+
+	if inventory.General == nil || inventory.General.ManagementId == "" {
+		return "", resp, &MDMCapabilityError{ComputerId: id, Reason: "has no MDM management ID"}
+	}
+
+	return inventory.General.ManagementId, resp, err
+}
+
+// computerUnmanageResponse represents the raw API response to sending the UnmanageDevice command
+type computerUnmanageResponse struct {
+	XMLName xml.Name `xml:"computer_command"`
+	Command struct {
+		CommandUuid string `xml:"command_uuid"`
+	} `xml:"command"`
+}
+
+// removeMdmProfileResponse represents the raw API response to removing the MDM profile of a computer
+type removeMdmProfileResponse struct {
+	DeviceId    string `json:"deviceId"`
+	CommandUuid string `json:"commandUuid"`
+}
+
+// renewMdmProfileRequest represents a request to renew the MDM profiles of devices
+type renewMdmProfileRequest struct {
+	Udids []string `json:"udids"`
+}
+
+// renewMdmProfileResponse represents the raw API response to renewing MDM profiles, which lists the devices that
+// were not renewed
+type renewMdmProfileResponse struct {
+	UdidsNotProcessed struct {
+		Udids []string `json:"udids"`
+	} `json:"udidsNotProcessed"`
+}
+
+// Unmanage sends the UnmanageDevice command to a computer, which removes its MDM profile and marks it unmanaged in
+// Jamf Pro. It returns the UUID of the queued command. The computer must be enrolled again to be managed.
+func (c *ComputersServiceOp) Unmanage(ctx context.Context, id int) (string, *Response, error) {
+	if _, resp, err := c.mdmCapableInventory(ctx, id); err != nil {
+		return "", resp, err
+	}
+
+	ctx = withDestructive(ctx)
+	path := computerCommandsBasePath + "/UnmanageDevice/id/" + strconv.Itoa(id)
+	req, err := c.client.NewRequest(ctx, http.MethodPost, path, nil, "application/xml")
+	if err != nil {
+		return "", nil, err
+	}
+
+	var out computerUnmanageResponse
+	resp, err := c.client.Do(ctx, req, &out)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return out.Command.CommandUuid, resp, err
+}
+
+// RemoveMDMProfile removes the MDM profile from a computer, leaving it managed by the Jamf agent only, and returns
+// the UUID of the queued command.
+func (c *ComputersServiceOp) RemoveMDMProfile(ctx context.Context, id int) (string, *Response, error) {
+	if _, resp, err := c.mdmCapableInventory(ctx, id); err != nil {
+		return "", resp, err
+	}
+
+	ctx = withDestructive(ctx)
+	path := computerInventoryV1Path + "/" + strconv.Itoa(id) + "/remove-mdm-profile"
+	req, err := c.client.NewRequest(ctx, http.MethodPost, path, nil, "application/json")
+	if err != nil {
+		return "", nil, err
+	}
+
+	var out removeMdmProfileResponse
+	resp, err := c.client.Do(ctx, req, &out)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return out.CommandUuid, resp, err
+}
+
+// Reenroll renews the MDM profile of a computer, having it enroll again with a new identity, e.g. after the
+// certificate of its MDM profile has expired or the instance's push certificate has been replaced.
+func (c *ComputersServiceOp) Reenroll(ctx context.Context, id int) (*Response, error) {
+	inventory, resp, err := c.mdmCapableInventory(ctx, id)
+	if err != nil {
+		return resp, err
+	}
+	if inventory.Udid == "" {
+		return resp, &MDMCapabilityError{ComputerId: id, Reason: "has no UDID"}
+	}
+
+	req, err := c.client.NewRequest(ctx, http.MethodPost, mdmRenewProfilePath, &renewMdmProfileRequest{Udids: []string{inventory.Udid}}, "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	var out renewMdmProfileResponse
+	resp, err = c.client.Do(ctx, req, &out)
+	if err != nil {
+		return resp, err
+	}
+
+	for _, udid := range out.UdidsNotProcessed.Udids {
+		if udid == inventory.Udid {
+			return resp, &MDMCapabilityError{ComputerId: id, Reason: "was not renewed, as Jamf Pro cannot send it MDM commands"}
+		}
+	}
+	return resp, nil
+}
+
+// mdmCapableInventory returns the inventory of a computer, or an *MDMCapabilityError if it has no MDM profile
+func (c *ComputersServiceOp) mdmCapableInventory(ctx context.Context, id int) (*ComputerInventory, *Response, error) {
+	if id == 0 {
+		return nil, nil, NewArgError("computer ID", "cannot be 0")
+	}
+
+	inventory, resp, err := c.GetInventoryDetail(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if inventory.General == nil || !inventory.General.MdmCapable.Capable {
+		return nil, resp, &MDMCapabilityError{ComputerId: id, Reason: "has no MDM profile"}
+	}
+
+	return inventory, resp, nil
+}
+
+// validateMdmPin checks that pin is the six digit PIN MDM lock and erase commands require
+func validateMdmPin(pin string) error {
+	if len(pin) != 6 {
+		return NewArgError("pin", "must be exactly 6 digits")
+	}
+	for _, r := range pin {
+		if r < '0' || r > '9' {
+			return NewArgError("pin", "must only contain digits")
+		}
+	}
+	return nil
+}
@@ -9,15 +9,26 @@ import (
 	"time"
 )
 
-const computerGroupsBasePath = "JSSResource/computergroups"
+const (
+	computerGroupsBasePath      = "JSSResource/computergroups"
+	smartComputerGroupsBasePath = "uapi/v1/smart-computer-groups"
+)
 
 type ComputerGroupsService interface {
-	List(context.Context) ([]ComputerGroup, *Response, error)
+	List(context.Context) ([]ComputerGroupListItem, *Response, error)
 	GetByID(context.Context, int) (*ComputerGroup, *Response, error)
+	GetManyByID(context.Context, []int, int) (map[int]*ComputerGroup, error)
 	GetByName(context.Context, string) (*ComputerGroup, *Response, error)
 	Create(context.Context, *ComputerGroupRequest) (*ComputerGroup, *Response, error)
 	Update(context.Context, int, *ComputerGroupRequest) (*ComputerGroup, *Response, error)
 	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+	Recalculate(context.Context, int) (int, *Response, error)
+	UpdateMembership(context.Context, int, *ComputerGroupMembershipRequest) (*Response, error)
+	AddComputersBySerialNumber(context.Context, int, []string) (*Response, error)
+	RemoveComputersBySerialNumber(context.Context, int, []string) (*Response, error)
+	AddComputersByUDID(context.Context, int, []string) (*Response, error)
+	RemoveComputersByUDID(context.Context, int, []string) (*Response, error)
 }
 
 // ComputerGroupsServiceOp handles communication with the computer group-related
@@ -28,25 +39,34 @@ type ComputerGroupsServiceOp struct {
 
 var _ ComputerGroupsService = &ComputerGroupsServiceOp{}
 
-// ComputerGroup represents a Jamf Pro ComputerGroup
+// ComputerGroup represents a Jamf Pro ComputerGroup, as returned when getting a single group. It decodes from both the
+// XML and the JSON form of the Classic API record.
 type ComputerGroup struct {
-	Id      int    `xml:"id"`
-	Name    string `xml:"name"`
-	IsSmart bool   `xml:"is_smart"`
+	Id      ID     `json:"id" xml:"id"`
+	Name    string `json:"name" xml:"name"`
+	IsSmart bool   `json:"is_smart" xml:"is_smart"`
 	//TODO: Sites
 	//Site         Site   `json:"site"`
-	Criteria  []ComputerGroupCriteria `xml:"criteria>criterion,omitempty"`
-	Computers []Computer              `xml:"computers>computer,omitempty"`
+	Criteria  []ComputerGroupCriteria `json:"criteria,omitempty" xml:"criteria>criterion,omitempty"`
+	Computers []Computer              `json:"computers,omitempty" xml:"computers>computer,omitempty"`
 }
 
 type ComputerGroupCriteria struct {
-	Name         string `xml:"name"`
-	Priority     int    `xml:"priority"`
-	AndOr        string `xml:"and_or"`
-	SearchType   string `xml:"search_type"`
-	Value        string `xml:"value"`
-	OpeningParen bool   `xml:"opening_paren"`
-	ClosingParen bool   `xml:"closing_paren"`
+	Name         string     `json:"name" xml:"name"`
+	Priority     int        `json:"priority" xml:"priority"`
+	AndOr        AndOr      `json:"and_or" xml:"and_or"`
+	SearchType   SearchType `json:"search_type" xml:"search_type"`
+	Value        string     `json:"value" xml:"value"`
+	OpeningParen bool       `json:"opening_paren" xml:"opening_paren"`
+	ClosingParen bool       `json:"closing_paren" xml:"closing_paren"`
+}
+
+// ComputerGroupListItem is the summary of a computer group returned when listing all computer groups. Get the group
+// by ID for its criteria or members.
+type ComputerGroupListItem struct {
+	Id      ID     `json:"id" xml:"id"`
+	Name    string `json:"name" xml:"name"`
+	IsSmart bool   `json:"is_smart" xml:"is_smart"`
 }
 
 type ComputerGroupRequest struct {
@@ -60,12 +80,46 @@ type ComputerGroupRequest struct {
 }
 
 type ComputerGroupResponse struct {
-	Id int `xml:"id"`
+	Id ID `xml:"id"`
+}
+
+// ComputerGroupMembershipRequest represents a request to add computers to, or remove them from, a static computer
+// group without sending the full member list.
+type ComputerGroupMembershipRequest struct {
+	Additions []ComputerGroupMember
+	Deletions []ComputerGroupMember
+}
+
+// ComputerGroupMember identifies a computer in a ComputerGroupMembershipRequest. Set one of the fields.
+type ComputerGroupMember struct {
+	Id           ID     `xml:"id,omitempty"`
+	SerialNumber string `xml:"serial_number,omitempty"`
+	Udid         string `xml:"udid,omitempty"`
+}
+
+// computerGroupMembershipPayload is the XML sent for a ComputerGroupMembershipRequest. Jamf Pro treats an empty
+// computer_deletions element the same as a missing one, but encoding/xml cannot omit a parent element of an empty
+// slice, so each list is wrapped in a pointer.
+type computerGroupMembershipPayload struct {
+	XMLName   xml.Name                 `xml:"computer_group"`
+	Additions *computerGroupMemberList `xml:"computer_additions,omitempty"`
+	Deletions *computerGroupMemberList `xml:"computer_deletions,omitempty"`
+}
+
+type computerGroupMemberList struct {
+	Computers []ComputerGroupMember `xml:"computer"`
 }
 
-// ComputerGroupListResponse represents the raw API response to getting all computerGroups
+// RecalculateResponse represents an API response to recalculating smart group membership
+type RecalculateResponse struct {
+	Count int `json:"count"`
+}
+
+// ComputerGroupListResponse represents the raw API response to getting all computerGroups, in either content type
 type ComputerGroupListResponse struct {
-	ComputerGroups *[]ComputerGroup `json:"computer_groups"`
+	XMLName        xml.Name                 `json:"-" xml:"computer_groups"`
+	Size           int                      `json:"-" xml:"size"`
+	ComputerGroups *[]ComputerGroupListItem `json:"computer_groups" xml:"computer_group"`
 }
 
 // Create creates a ComputerGroup record in Jamf Pro.
@@ -75,9 +129,12 @@ func (c *ComputerGroupsServiceOp) Create(ctx context.Context, request *ComputerG
 		return nil, nil, NewArgError("createRequest", "cannot be nil")
 	}
 
-	if request.IsSmart && len(request.Criteria) < 0 {
+	if request.IsSmart && len(request.Criteria) == 0 {
 		return nil, nil, NewArgError("Criteria", "Criteria must be supplied for a Smart Group")
 	}
+	if err := ValidateCriteria(request.Criteria); err != nil {
+		return nil, nil, err
+	}
 
 	req, err := c.client.NewRequest(ctx, http.MethodPost, path, request, "application/xml")
 	if err != nil {
@@ -90,22 +147,7 @@ func (c *ComputerGroupsServiceOp) Create(ctx context.Context, request *ComputerG
 		return nil, resp, err
 	}
 
-	if computerGroupCreation.Id == 0 {
-		return nil, resp, err
-	}
-
-	// Below, we are attempting to work around Jamf Pro replication lag. It may take a while for the API changes to
-	// actually take place on the server, so we wait until the API shows us it has happened.
-	intendedComputerGroup := c.createComputerGroupFromRequest(*request)
-	createdComputerGroup, resp, err := c.client.ComputerGroups.GetByID(ctx, computerGroupCreation.Id)
-	interval := 1
-	for resp.StatusCode != http.StatusOK && !AreGroupsEquivalent(&intendedComputerGroup, createdComputerGroup) {
-		time.Sleep(time.Duration(interval) * time.Second)
-		createdComputerGroup, resp, err = c.client.ComputerGroups.GetByID(ctx, computerGroupCreation.Id)
-		interval = interval * 2
-	}
-	computerGroup := c.createComputerGroupFromResponse(*computerGroupCreation, *request)
-	return &computerGroup, resp, err
+	return getCreated(ctx, resp, computerGroupCreation.Id, c.GetByID)
 }
 
 // Update updates a ComputerGroup record in Jamf Pro.
@@ -114,6 +156,9 @@ func (c *ComputerGroupsServiceOp) Update(ctx context.Context, i int, request *Co
 	if request == nil {
 		return nil, nil, NewArgError("createRequest", "cannot be nil")
 	}
+	if err := ValidateCriteria(request.Criteria); err != nil {
+		return nil, nil, err
+	}
 
 	req, err := c.client.NewRequest(ctx, http.MethodPut, path, request, "application/xml")
 	if err != nil {
@@ -125,31 +170,11 @@ func (c *ComputerGroupsServiceOp) Update(ctx context.Context, i int, request *Co
 	if err != nil {
 		return nil, resp, err
 	}
-	retryCount := 5
-	if resp.StatusCode == 404 {
-		for resp.StatusCode == 404 && retryCount > 0 {
-			time.Sleep(time.Duration(2) * time.Second)
-			resp, err = c.client.Do(ctx, req, computerGroupUpdate)
-			retryCount = retryCount - 1
-		}
-	}
 
-	if computerGroupUpdate.Id == 0 {
-		return nil, resp, err
-	}
-
-	// Below, we are attempting to work around Jamf Pro replication lag. It may take a while for the API changes to
-	// actually take place on the server, so we wait until the API shows us it has happened.
-	intendedComputerGroup := c.createComputerGroupFromRequest(*request)
-	updatedComputerGroup, resp, err := c.client.ComputerGroups.GetByID(ctx, computerGroupUpdate.Id)
-	interval := 1
-	for resp.StatusCode != http.StatusOK && !AreGroupsEquivalent(&intendedComputerGroup, updatedComputerGroup) {
-		time.Sleep(time.Duration(interval) * time.Second)
-		updatedComputerGroup, resp, err = c.client.ComputerGroups.GetByID(ctx, computerGroupUpdate.Id)
-		interval = interval * 2
-	}
-	computerGroup := c.createComputerGroupFromResponse(*computerGroupUpdate, *request)
-	return &computerGroup, resp, err
+	// Jamf Pro replicates the change between its nodes after answering, so wait until the group shows it
+	return getUpdated(ctx, resp, computerGroupUpdate.Id, c.GetByID, func(g *ComputerGroup) bool {
+		return g.Name == request.Name && g.IsSmart == request.IsSmart
+	})
 }
 
 func (c *ComputerGroupsServiceOp) Delete(ctx context.Context, i int) (*Response, error) {
@@ -169,9 +194,9 @@ func (c *ComputerGroupsServiceOp) Delete(ctx context.Context, i int) (*Response,
 	_, resp, err := c.client.ComputerGroups.GetByID(ctx, i)
 	interval := 1
 	limit := 5
-	for resp.StatusCode != http.StatusNotFound && limit > 0 {
+	for retry := 1; resp.StatusCode != http.StatusNotFound && limit > 0; retry++ {
 		time.Sleep(time.Duration(interval) * time.Second)
-		_, resp, err = c.client.ComputerGroups.GetByID(ctx, i)
+		_, resp, err = c.client.ComputerGroups.GetByID(withRetryCount(ctx, retry), i)
 		interval = interval * 2
 		limit = limit - 1
 	}
@@ -184,7 +209,126 @@ func (c *ComputerGroupsServiceOp) Delete(ctx context.Context, i int) (*Response,
 
 }
 
-func (c *ComputerGroupsServiceOp) List(ctx context.Context) ([]ComputerGroup, *Response, error) {
+// DeleteByName deletes the computer group called name. The error matches ErrNotFound if there is none.
+func (c *ComputerGroupsServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "computer group", name, c.GetByName, func(g *ComputerGroup) ID { return g.Id }, c.Delete)
+}
+
+// Recalculate forces Jamf Pro to re-evaluate the membership of a smart computer group, and returns the number of
+// computers in the group afterwards.
+func (c *ComputerGroupsServiceOp) Recalculate(ctx context.Context, id int) (int, *Response, error) {
+	path := smartComputerGroupsBasePath + "/" + strconv.Itoa(id) + "/recalculate"
+	if id == 0 {
+		return 0, nil, NewArgError("computer group ID", "cannot be 0")
+	}
+
+	req, err := c.client.NewRequest(ctx, http.MethodPost, path, nil, "application/json")
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var recalculation RecalculateResponse
+	resp, err := c.client.Do(ctx, req, &recalculation)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return recalculation.Count, resp, err
+}
+
+// UpdateMembership adds and removes members of a static computer group in a single request.
+func (c *ComputerGroupsServiceOp) UpdateMembership(ctx context.Context, id int, request *ComputerGroupMembershipRequest) (*Response, error) {
+	path := computerGroupsBasePath + "/id/" + strconv.Itoa(id)
+	if request == nil {
+		return nil, NewArgError("membershipRequest", "cannot be nil")
+	} else if id == 0 {
+		return nil, NewArgError("computer group ID", "cannot be 0")
+	} else if len(request.Additions) == 0 && len(request.Deletions) == 0 {
+		return nil, NewArgError("membershipRequest", "must add or remove at least one computer")
+	}
+
+	payload := computerGroupMembershipPayload{}
+	if len(request.Additions) > 0 {
+		payload.Additions = &computerGroupMemberList{Computers: request.Additions}
+	}
+	if len(request.Deletions) > 0 {
+		payload.Deletions = &computerGroupMemberList{Computers: request.Deletions}
+	}
+
+	req, err := c.client.NewRequest(ctx, http.MethodPut, path, payload, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	membershipUpdate := new(ComputerGroupResponse)
+	resp, err := c.client.Do(ctx, req, membershipUpdate)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// AddComputersBySerialNumber adds the computers with the given serial numbers to a static computer group.
+func (c *ComputerGroupsServiceOp) AddComputersBySerialNumber(ctx context.Context, id int, serialNumbers []string) (*Response, error) {
+	members, err := computerGroupMembers("serialNumbers", serialNumbers, func(s string) ComputerGroupMember {
+		return ComputerGroupMember{SerialNumber: s}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.UpdateMembership(ctx, id, &ComputerGroupMembershipRequest{Additions: members})
+}
+
+// RemoveComputersBySerialNumber removes the computers with the given serial numbers from a static computer group.
+func (c *ComputerGroupsServiceOp) RemoveComputersBySerialNumber(ctx context.Context, id int, serialNumbers []string) (*Response, error) {
+	members, err := computerGroupMembers("serialNumbers", serialNumbers, func(s string) ComputerGroupMember {
+		return ComputerGroupMember{SerialNumber: s}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.UpdateMembership(ctx, id, &ComputerGroupMembershipRequest{Deletions: members})
+}
+
+// AddComputersByUDID adds the computers with the given UDIDs to a static computer group.
+func (c *ComputerGroupsServiceOp) AddComputersByUDID(ctx context.Context, id int, udids []string) (*Response, error) {
+	members, err := computerGroupMembers("udids", udids, func(s string) ComputerGroupMember {
+		return ComputerGroupMember{Udid: s}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.UpdateMembership(ctx, id, &ComputerGroupMembershipRequest{Additions: members})
+}
+
+// RemoveComputersByUDID removes the computers with the given UDIDs from a static computer group.
+func (c *ComputerGroupsServiceOp) RemoveComputersByUDID(ctx context.Context, id int, udids []string) (*Response, error) {
+	members, err := computerGroupMembers("udids", udids, func(s string) ComputerGroupMember {
+		return ComputerGroupMember{Udid: s}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.UpdateMembership(ctx, id, &ComputerGroupMembershipRequest{Deletions: members})
+}
+
+func computerGroupMembers(arg string, identifiers []string, member func(string) ComputerGroupMember) ([]ComputerGroupMember, error) {
+	if len(identifiers) == 0 {
+		return nil, NewArgError(arg, "cannot be empty")
+	}
+
+	members := make([]ComputerGroupMember, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		if identifier == "" {
+			return nil, NewArgError(arg, "cannot contain empty values")
+		}
+		members = append(members, member(identifier))
+	}
+	return members, nil
+}
+
+func (c *ComputerGroupsServiceOp) List(ctx context.Context) ([]ComputerGroupListItem, *Response, error) {
 	return c.list(ctx)
 }
 
@@ -211,6 +355,13 @@ func (c *ComputerGroupsServiceOp) GetByID(ctx context.Context, Id int) (*Compute
 	return &computerGroupResponse, resp, err
 }
 
+// GetManyByID fetches the computer groups with the given IDs, with at most concurrency requests in flight, as the Classic API
+// cannot fetch several at once. The records fetched are returned keyed by ID even if others failed, which are
+// reported in a *BatchGetError.
+func (c *ComputerGroupsServiceOp) GetManyByID(ctx context.Context, ids []int, concurrency int) (map[int]*ComputerGroup, error) {
+	return getManyByID(ctx, ids, concurrency, c.GetByID)
+}
+
 func (c *ComputerGroupsServiceOp) GetByName(ctx context.Context, computerGroupName string) (*ComputerGroup, *Response, error) {
 	computerGroups, _, err := c.list(ctx)
 	var id int
@@ -220,13 +371,13 @@ func (c *ComputerGroupsServiceOp) GetByName(ctx context.Context, computerGroupNa
 
 	for i := range computerGroups {
 		if computerGroups[i].Name == computerGroupName {
-			id = computerGroups[i].Id
+			id = computerGroups[i].Id.Int()
 			break
 		}
 	}
 
-	if err != nil {
-		return nil, nil, err
+	if id == 0 {
+		return nil, nil, notFoundByName("computer group", computerGroupName)
 	}
 
 	computerGroup, resp, err := c.GetByID(ctx, id)
@@ -237,7 +388,7 @@ func (c *ComputerGroupsServiceOp) GetByName(ctx context.Context, computerGroupNa
 	return computerGroup, resp, err
 }
 
-func (c *ComputerGroupsServiceOp) list(ctx context.Context) ([]ComputerGroup, *Response, error) {
+func (c *ComputerGroupsServiceOp) list(ctx context.Context) ([]ComputerGroupListItem, *Response, error) {
 	path := computerGroupsBasePath
 	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
 	if err != nil {
@@ -249,26 +400,14 @@ func (c *ComputerGroupsServiceOp) list(ctx context.Context) ([]ComputerGroup, *R
 	if err != nil {
 		return nil, resp, err
 	}
+	if computerGroupResponse.ComputerGroups == nil {
+		// An empty JSON list still has the key, and an empty XML list is a computer_groups element with only a size,
+		// so anything else means the body was not the list, rather than no groups.
+		if computerGroupResponse.XMLName.Local == "" {
+			return nil, resp, fmt.Errorf("jamfpro: the computer group list response has no computer_groups")
+		}
+		return []ComputerGroupListItem{}, resp, err
+	}
 
 	return *computerGroupResponse.ComputerGroups, resp, err
-
-}
-
-func (c *ComputerGroupsServiceOp) createComputerGroupFromRequest(request ComputerGroupRequest) ComputerGroup {
-	computerGroup := new(ComputerGroup)
-	computerGroup.Name = request.Name
-	computerGroup.IsSmart = request.IsSmart
-	computerGroup.Criteria = request.Criteria
-	computerGroup.Computers = request.Computers
-	return *computerGroup
-}
-
-func (c *ComputerGroupsServiceOp) createComputerGroupFromResponse(response ComputerGroupResponse, request ComputerGroupRequest) ComputerGroup {
-	computerGroup := new(ComputerGroup)
-	computerGroup.Id = response.Id
-	computerGroup.Name = request.Name
-	computerGroup.IsSmart = request.IsSmart
-	computerGroup.Criteria = request.Criteria
-	computerGroup.Computers = request.Computers
-	return *computerGroup
 }
@@ -0,0 +1,162 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// serveFixture answers every request with the fixture at testdata/name, with the content type of its extension.
+func serveFixture(t *testing.T, name string) http.Handler {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	contentType := "application/json"
+	if strings.HasSuffix(name, ".xml") {
+		contentType = "application/xml"
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.Write(data)
+	})
+}
+
+func TestComputerGroupsList(t *testing.T) {
+	groups := []ComputerGroupListItem{
+		{Id: "1", Name: "All Managed Clients", IsSmart: true},
+		{Id: "7", Name: "Lab Machines", IsSmart: false},
+	}
+	tests := []struct {
+		fixture string
+		want    []ComputerGroupListItem
+	}{
+		{"computergroups/list.json", groups},
+		{"computergroups/list.xml", groups},
+		{"computergroups/list_empty.json", []ComputerGroupListItem{}},
+		{"computergroups/list_empty.xml", []ComputerGroupListItem{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			client, _ := newTestClient(t, serveFixture(t, tt.fixture))
+
+			got, _, err := client.ComputerGroups.List(context.Background())
+			if err != nil {
+				t.Fatalf("List: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("List = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComputerGroupsListRejectsOtherBodies(t *testing.T) {
+	client, _ := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"computers":[]}`))
+	}))
+
+	if _, _, err := client.ComputerGroups.List(context.Background()); err == nil {
+		t.Error("List of a body without computer_groups succeeded")
+	}
+}
+
+var wantComputerGroupDetail = ComputerGroup{
+	Id:      "1",
+	Name:    "All Managed Clients",
+	IsSmart: true,
+	Criteria: []ComputerGroupCriteria{
+		{Name: "Operating System Version", Priority: 0, AndOr: CriterionAnd, SearchType: SearchTypeLike, Value: "14."},
+	},
+	Computers: []Computer{{Id: "12", Name: "MacBook-00012", SerialNumber: "C02XK1ZZJG5H"}},
+}
+
+func TestComputerGroupDetailXML(t *testing.T) {
+	client, _ := newTestClient(t, serveFixture(t, "computergroups/detail.xml"))
+
+	got, _, err := client.ComputerGroups.GetByID(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	// GetByID keeps only the criteria of a smart group, so compare against a copy without members.
+	want := wantComputerGroupDetail
+	want.Computers = nil
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("GetByID = %+v, want %+v", *got, want)
+	}
+}
+
+func TestComputerGroupDetailJSON(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "computergroups", "detail.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var detail struct {
+		ComputerGroup ComputerGroup `json:"computer_group"`
+	}
+	if err := json.Unmarshal(data, &detail); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !reflect.DeepEqual(detail.ComputerGroup, wantComputerGroupDetail) {
+		t.Errorf("decoded %+v, want %+v", detail.ComputerGroup, wantComputerGroupDetail)
+	}
+}
+
+// computerGroupUpdates answers updates to computer group 7 with the ID id, and lookups of the group with name.
+func computerGroupUpdates(id, name string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		if r.Method == http.MethodPut {
+			fmt.Fprintf(w, `<computer_group><id>%s</id></computer_group>`, id)
+			return
+		}
+		fmt.Fprintf(w, `<computer_group><id>7</id><name>%s</name><is_smart>false</is_smart><computers><size>1</size>`+
+			`<computer><id>12</id><name>MacBook-00012</name></computer></computers></computer_group>`, name)
+	})
+}
+
+func TestComputerGroupsUpdateReturnsTheStoredGroup(t *testing.T) {
+	client, _ := newTestClient(t, computerGroupUpdates("7", "Lab Macs"))
+
+	got, _, err := client.ComputerGroups.Update(context.Background(), 7, &ComputerGroupRequest{Name: "Lab Macs"})
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if got.Id.Int() != 7 || got.Name != "Lab Macs" || len(got.Computers) != 1 {
+		t.Errorf("Update = %+v, want the group as the server returns it", got)
+	}
+}
+
+func TestComputerGroupsUpdateWithoutID(t *testing.T) {
+	client, _ := newTestClient(t, computerGroupUpdates("", "Lab Macs"))
+
+	got, resp, err := client.ComputerGroups.Update(context.Background(), 7, &ComputerGroupRequest{Name: "Lab Macs"})
+	if !errors.Is(err, ErrMissingID) || got != nil {
+		t.Errorf("Update of a response without an ID = %+v, %v, want ErrMissingID", got, err)
+	}
+	if resp == nil {
+		t.Error("Update did not return the response to the update")
+	}
+}
+
+func TestComputerGroupsUpdateStopsWaitingWhenCancelled(t *testing.T) {
+	client, _ := newTestClient(t, computerGroupUpdates("7", "Old name"))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, _, err := client.ComputerGroups.Update(ctx, 7, &ComputerGroupRequest{Name: "Lab Macs"}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Update waiting for a change that does not replicate = %v, want the context's error", err)
+	}
+}
@@ -0,0 +1,256 @@
+package jamfpro
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+const (
+	computerGroupsV1BasePath = "uapi/v1/computer-groups"
+	smartGroupsV1BasePath    = computerGroupsV1BasePath + "/smart-groups"
+)
+
+// ComputerGroupsV1ServiceOp implements ComputerGroupsService with the Jamf Pro API computer group endpoints,
+// keeping the Classic API for what those endpoints do not cover yet: static groups and their membership. Enable it
+// with WithComputerGroupsV1.
+type ComputerGroupsV1ServiceOp struct {
+	client  *Client
+	classic *ComputerGroupsServiceOp
+}
+
+var _ ComputerGroupsService = &ComputerGroupsV1ServiceOp{}
+
+// ComputerGroupV1 is a computer group as listed by the Jamf Pro API
+type ComputerGroupV1 struct {
+	Id         ID     `json:"id"`
+	Name       string `json:"name"`
+	SmartGroup bool   `json:"smartGroup"`
+}
+
+// SmartComputerGroupV1 is a smart computer group as the Jamf Pro API represents it
+type SmartComputerGroupV1 struct {
+	Id          ID                      `json:"id,omitempty"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description,omitempty"`
+	SiteId      *ID                     `json:"siteId,omitempty"`
+	Criteria    []SmartGroupCriterionV1 `json:"criteria"`
+}
+
+// SmartGroupCriterionV1 is a smart group criterion as the Jamf Pro API represents it
+type SmartGroupCriterionV1 struct {
+	Name         string     `json:"name"`
+	Priority     int        `json:"priority"`
+	AndOr        AndOr      `json:"andOr"`
+	SearchType   SearchType `json:"searchType"`
+	Value        string     `json:"value"`
+	OpeningParen bool       `json:"openingParen"`
+	ClosingParen bool       `json:"closingParen"`
+}
+
+func (c *ComputerGroupsV1ServiceOp) List(ctx context.Context) ([]ComputerGroupListItem, *Response, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, computerGroupsV1BasePath, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var groups []ComputerGroupV1
+	resp, err := c.client.Do(ctx, req, &groups)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	computerGroups := make([]ComputerGroupListItem, len(groups))
+	for i, group := range groups {
+		computerGroups[i] = ComputerGroupListItem{Id: group.Id, Name: group.Name, IsSmart: group.SmartGroup}
+	}
+	return computerGroups, resp, err
+}
+
+// GetByID returns a smart group from the Jamf Pro API, or a static group from the Classic API.
+func (c *ComputerGroupsV1ServiceOp) GetByID(ctx context.Context, id int) (*ComputerGroup, *Response, error) {
+	path := smartGroupsV1BasePath + "/" + strconv.Itoa(id)
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var smartGroup SmartComputerGroupV1
+	resp, err := c.client.Do(ctx, req, &smartGroup)
+	if isNotFound(err) {
+		return c.classic.GetByID(ctx, id)
+	} else if err != nil {
+		return nil, resp, err
+	}
+
+	return smartGroup.computerGroup(), resp, err
+}
+
+func (c *ComputerGroupsV1ServiceOp) GetManyByID(ctx context.Context, ids []int, concurrency int) (map[int]*ComputerGroup, error) {
+	return getManyByID(ctx, ids, concurrency, c.GetByID)
+}
+
+func (c *ComputerGroupsV1ServiceOp) GetByName(ctx context.Context, name string) (*ComputerGroup, *Response, error) {
+	groups, resp, err := c.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, group := range groups {
+		if group.Name == name {
+			return c.GetByID(ctx, group.Id.Int())
+		}
+	}
+	return nil, resp, notFoundByName("computer group", name)
+}
+
+// Create creates smart groups with the Jamf Pro API and static groups with the Classic API.
+func (c *ComputerGroupsV1ServiceOp) Create(ctx context.Context, request *ComputerGroupRequest) (*ComputerGroup, *Response, error) {
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	} else if !request.IsSmart {
+		return c.classic.Create(ctx, request)
+	}
+
+	smartGroup, err := newSmartComputerGroupV1(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.client.NewRequest(ctx, http.MethodPost, smartGroupsV1BasePath, smartGroup, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var groupCreation HistoryNoteResponse
+	resp, err := c.client.Do(ctx, req, &groupCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, groupCreation.Id, c.GetByID)
+}
+
+// Update updates smart groups with the Jamf Pro API and static groups with the Classic API.
+func (c *ComputerGroupsV1ServiceOp) Update(ctx context.Context, id int, request *ComputerGroupRequest) (*ComputerGroup, *Response, error) {
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if id == 0 {
+		return nil, nil, NewArgError("computer group ID", "cannot be 0")
+	} else if !request.IsSmart {
+		return c.classic.Update(ctx, id, request)
+	}
+
+	smartGroup, err := newSmartComputerGroupV1(request)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := smartGroupsV1BasePath + "/" + strconv.Itoa(id)
+
+	req, err := c.client.NewRequest(ctx, http.MethodPut, path, smartGroup, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated SmartComputerGroupV1
+	resp, err := c.client.Do(ctx, req, &updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated.computerGroup(), resp, err
+}
+
+// Delete deletes a smart group with the Jamf Pro API, falling back to the Classic API for static groups.
+func (c *ComputerGroupsV1ServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := smartGroupsV1BasePath + "/" + strconv.Itoa(id)
+
+	req, err := c.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(ctx, req, nil)
+	if isNotFound(err) {
+		return c.classic.Delete(ctx, id)
+	} else if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// DeleteByName deletes the computer group called name. The error matches ErrNotFound if there is none.
+func (c *ComputerGroupsV1ServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "computer group", name, c.GetByName, func(g *ComputerGroup) ID { return g.Id }, c.Delete)
+}
+
+func (c *ComputerGroupsV1ServiceOp) Recalculate(ctx context.Context, id int) (int, *Response, error) {
+	return c.classic.Recalculate(ctx, id)
+}
+
+func (c *ComputerGroupsV1ServiceOp) UpdateMembership(ctx context.Context, id int, request *ComputerGroupMembershipRequest) (*Response, error) {
+	return c.classic.UpdateMembership(ctx, id, request)
+}
+
+func (c *ComputerGroupsV1ServiceOp) AddComputersBySerialNumber(ctx context.Context, id int, serialNumbers []string) (*Response, error) {
+	return c.classic.AddComputersBySerialNumber(ctx, id, serialNumbers)
+}
+
+func (c *ComputerGroupsV1ServiceOp) RemoveComputersBySerialNumber(ctx context.Context, id int, serialNumbers []string) (*Response, error) {
+	return c.classic.RemoveComputersBySerialNumber(ctx, id, serialNumbers)
+}
+
+func (c *ComputerGroupsV1ServiceOp) AddComputersByUDID(ctx context.Context, id int, udids []string) (*Response, error) {
+	return c.classic.AddComputersByUDID(ctx, id, udids)
+}
+
+func (c *ComputerGroupsV1ServiceOp) RemoveComputersByUDID(ctx context.Context, id int, udids []string) (*Response, error) {
+	return c.classic.RemoveComputersByUDID(ctx, id, udids)
+}
+
+func newSmartComputerGroupV1(request *ComputerGroupRequest) (*SmartComputerGroupV1, error) {
+	if len(request.Criteria) == 0 {
+		return nil, NewArgError("Criteria", "Criteria must be supplied for a Smart Group")
+	}
+	if err := ValidateCriteria(request.Criteria); err != nil {
+		return nil, err
+	}
+
+	smartGroup := &SmartComputerGroupV1{Name: request.Name, Criteria: make([]SmartGroupCriterionV1, len(request.Criteria))}
+	for i, criterion := range request.Criteria {
+		smartGroup.Criteria[i] = SmartGroupCriterionV1{
+			Name:         criterion.Name,
+			Priority:     criterion.Priority,
+			AndOr:        criterion.AndOr,
+			SearchType:   criterion.SearchType,
+			Value:        criterion.Value,
+			OpeningParen: criterion.OpeningParen,
+			ClosingParen: criterion.ClosingParen,
+		}
+	}
+	return smartGroup, nil
+}
+
+func (s *SmartComputerGroupV1) computerGroup() *ComputerGroup {
+	group := &ComputerGroup{Id: s.Id, Name: s.Name, IsSmart: true, Criteria: make([]ComputerGroupCriteria, len(s.Criteria))}
+	for i, criterion := range s.Criteria {
+		group.Criteria[i] = ComputerGroupCriteria{
+			Name:         criterion.Name,
+			Priority:     criterion.Priority,
+			AndOr:        criterion.AndOr,
+			SearchType:   criterion.SearchType,
+			Value:        criterion.Value,
+			OpeningParen: criterion.OpeningParen,
+			ClosingParen: criterion.ClosingParen,
+		}
+	}
+	return group
+}
+
+// isNotFound reports whether err means the object does not exist
+func isNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
@@ -0,0 +1,156 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+)
+
+const computerInventoryCollectionSettingsBasePath = "uapi/v1/computer-inventory-collection-settings"
+
+// Scopes for custom inventory collection paths
+const (
+	InventoryCustomPathScopeApp    = "APP"
+	InventoryCustomPathScopeFont   = "FONT"
+	InventoryCustomPathScopePlugin = "PLUGIN"
+)
+
+type ComputerInventoryCollectionSettingsService interface {
+	Get(context.Context) (*ComputerInventoryCollectionSettings, *Response, error)
+	Update(context.Context, *ComputerInventoryCollectionPreferences) (*ComputerInventoryCollectionSettings, *Response, error)
+	AddCustomPath(context.Context, string, string) (*InventoryCustomPath, *Response, error)
+	DeleteCustomPath(context.Context, string) (*Response, error)
+}
+
+// ComputerInventoryCollectionSettingsServiceOp handles communication with the computer inventory collection
+// settings methods of the Jamf Pro API.
+type ComputerInventoryCollectionSettingsServiceOp struct {
+	client *Client
+}
+
+var _ ComputerInventoryCollectionSettingsService = &ComputerInventoryCollectionSettingsServiceOp{}
+
+// ComputerInventoryCollectionSettings represents the global settings deciding what computers report in inventory
+type ComputerInventoryCollectionSettings struct {
+	Preferences      ComputerInventoryCollectionPreferences `json:"computerInventoryCollectionPreferences"`
+	ApplicationPaths []InventoryCustomPath                  `json:"applicationPaths"`
+	FontPaths        []InventoryCustomPath                  `json:"fontPaths"`
+	PluginPaths      []InventoryCustomPath                  `json:"pluginPaths"`
+}
+
+type ComputerInventoryCollectionPreferences struct {
+	MonitorApplicationUsage                      bool `json:"monitorApplicationUsage"`
+	IncludeFonts                                 bool `json:"includeFonts"`
+	IncludePlugins                               bool `json:"includePlugins"`
+	IncludePackages                              bool `json:"includePackages"`
+	IncludeSoftwareUpdates                       bool `json:"includeSoftwareUpdates"`
+	IncludeSoftwareId                            bool `json:"includeSoftwareId"`
+	IncludeAccounts                              bool `json:"includeAccounts"`
+	CalculateSizes                               bool `json:"calculateSizes"`
+	IncludeHiddenAccounts                        bool `json:"includeHiddenAccounts"`
+	IncludePrinters                              bool `json:"includePrinters"`
+	IncludeServices                              bool `json:"includeServices"`
+	CollectSyncedMobileDeviceInfo                bool `json:"collectSyncedMobileDeviceInfo"`
+	UpdateLdapInfoOnComputerInventorySubmissions bool `json:"updateLdapInfoOnComputerInventorySubmissions"`
+	MonitorBeacons                               bool `json:"monitorBeacons"`
+	AllowChangingUserAndLocation                 bool `json:"allowChangingUserAndLocation"`
+	UseUnixUserPaths                             bool `json:"useUnixUserPaths"`
+	CollectUnmanagedCertificates                 bool `json:"collectUnmanagedCertificates"`
+}
+
+// InventoryCustomPath is an additional path searched for applications, fonts or plug-ins during inventory collection
+type InventoryCustomPath struct {
+	Id   ID     `json:"id,omitempty"`
+	Path string `json:"path"`
+}
+
+type inventoryCustomPathRequest struct {
+	Scope string `json:"scope"`
+	Path  string `json:"path"`
+}
+
+type computerInventoryCollectionSettingsUpdateRequest struct {
+	Preferences *ComputerInventoryCollectionPreferences `json:"computerInventoryCollectionPreferences"`
+}
+
+func (c *ComputerInventoryCollectionSettingsServiceOp) Get(ctx context.Context) (*ComputerInventoryCollectionSettings, *Response, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, computerInventoryCollectionSettingsBasePath, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var settings ComputerInventoryCollectionSettings
+	resp, err := c.client.Do(ctx, req, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &settings, resp, err
+}
+
+// Update replaces the inventory collection preferences. Custom paths are managed with AddCustomPath and
+// DeleteCustomPath.
+func (c *ComputerInventoryCollectionSettingsServiceOp) Update(ctx context.Context, preferences *ComputerInventoryCollectionPreferences) (*ComputerInventoryCollectionSettings, *Response, error) {
+	if preferences == nil {
+		return nil, nil, NewArgError("preferences", "cannot be nil")
+	}
+
+	request := &computerInventoryCollectionSettingsUpdateRequest{Preferences: preferences}
+	req, err := c.client.NewRequest(ctx, http.MethodPatch, computerInventoryCollectionSettingsBasePath, request, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var settings ComputerInventoryCollectionSettings
+	resp, err := c.client.Do(ctx, req, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &settings, resp, err
+}
+
+// AddCustomPath adds a path to search during inventory collection. scope is one of the InventoryCustomPathScope*
+// constants.
+func (c *ComputerInventoryCollectionSettingsServiceOp) AddCustomPath(ctx context.Context, scope, path string) (*InventoryCustomPath, *Response, error) {
+	switch scope {
+	case InventoryCustomPathScopeApp, InventoryCustomPathScopeFont, InventoryCustomPathScopePlugin:
+	default:
+		return nil, nil, NewArgError("scope", "must be one of APP, FONT or PLUGIN")
+	}
+	if path == "" {
+		return nil, nil, NewArgError("path", "cannot be empty")
+	}
+
+	request := &inventoryCustomPathRequest{Scope: scope, Path: path}
+	req, err := c.client.NewRequest(ctx, http.MethodPost, computerInventoryCollectionSettingsBasePath+"/custom-path", request, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var customPath InventoryCustomPath
+	resp, err := c.client.Do(ctx, req, &customPath)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &customPath, resp, err
+}
+
+func (c *ComputerInventoryCollectionSettingsServiceOp) DeleteCustomPath(ctx context.Context, id string) (*Response, error) {
+	if id == "" {
+		return nil, NewArgError("custom path ID", "cannot be empty")
+	}
+	path := pathJoin(computerInventoryCollectionSettingsBasePath, "custom-path", id)
+
+	req, err := c.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
@@ -0,0 +1,236 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const computerInvitationsBasePath = "JSSResource/computerinvitations"
+
+// Invitation types of computer enrollment invitations
+const (
+	ComputerInvitationTypeDefault            = "DEFAULT"
+	ComputerInvitationTypeUserInitiatedURL   = "USER_INITIATED_URL"
+	ComputerInvitationTypeUserInitiatedEmail = "USER_INITIATED_EMAIL"
+)
+
+type ComputerInvitationsService interface {
+	List(context.Context) ([]ComputerInvitationListItem, *Response, error)
+	GetByID(context.Context, int) (*ComputerInvitation, *Response, error)
+	GetByInvitation(context.Context, string) (*ComputerInvitation, *Response, error)
+	Create(context.Context, *ComputerInvitationRequest) (*ComputerInvitation, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteExpired(context.Context) ([]ComputerInvitationListItem, error)
+	EnrollmentURL(*ComputerInvitation) string
+}
+
+// ComputerInvitationsServiceOp handles communication with the computer enrollment invitation related
+// methods of the Jamf Pro API.
+type ComputerInvitationsServiceOp struct {
+	client *Client
+}
+
+var _ ComputerInvitationsService = &ComputerInvitationsServiceOp{}
+
+// ComputerInvitation represents a Jamf Pro computer enrollment invitation. Invitation is the code that enrolls a
+// computer, as used in the link returned by EnrollmentURL.
+type ComputerInvitation struct {
+	XMLName                     xml.Name         `xml:"computer_invitation"`
+	Id                          ID               `xml:"id"`
+	Invitation                  string           `xml:"invitation"`
+	InvitationStatus            string           `xml:"invitation_status"`
+	InvitationType              string           `xml:"invitation_type"`
+	ExpirationDateEpoch         int64            `xml:"expiration_date_epoch"`
+	SshUsername                 string           `xml:"ssh_username"`
+	MultipleUsersAllowed        bool             `xml:"multiple_users_allowed"`
+	TimesUsed                   int              `xml:"times_used"`
+	CreateAccountIfDoesNotExist bool             `xml:"create_account_if_does_not_exist"`
+	HideAccount                 bool             `xml:"hide_account"`
+	LockDownSsh                 bool             `xml:"lock_down_ssh"`
+	EnrollIntoSite              ClassicReference `xml:"enroll_into_site"`
+	KeepExistingSiteMembership  bool             `xml:"keep_existing_site_membership"`
+	Site                        ClassicReference `xml:"site"`
+}
+
+// Expiration returns when the invitation expires.
+func (i *ComputerInvitation) Expiration() time.Time {
+	return time.UnixMilli(i.ExpirationDateEpoch)
+}
+
+// ComputerInvitationListItem is the summary of a computer enrollment invitation returned when listing all of them
+type ComputerInvitationListItem struct {
+	Id                  ID     `xml:"id"`
+	Invitation          string `xml:"invitation"`
+	InvitationType      string `xml:"invitation_type"`
+	ExpirationDateEpoch int64  `xml:"expiration_date_epoch"`
+}
+
+// Expiration returns when the invitation expires.
+func (i ComputerInvitationListItem) Expiration() time.Time {
+	return time.UnixMilli(i.ExpirationDateEpoch)
+}
+
+// ComputerInvitationListResponse represents the raw API response to getting all computer enrollment invitations
+type ComputerInvitationListResponse struct {
+	XMLName     xml.Name                     `xml:"computer_invitations"`
+	Size        int                          `xml:"size"`
+	Invitations []ComputerInvitationListItem `xml:"computer_invitation"`
+}
+
+// ComputerInvitationRequest represents a request to create a computer enrollment invitation. Expiration is required,
+// and must be in the future.
+type ComputerInvitationRequest struct {
+	XMLName                     xml.Name          `xml:"computer_invitation"`
+	InvitationType              string            `xml:"invitation_type"`
+	Expiration                  time.Time         `xml:"-"`
+	ExpirationDateEpoch         int64             `xml:"expiration_date_epoch"`
+	SshUsername                 string            `xml:"ssh_username,omitempty"`
+	SshPassword                 string            `xml:"ssh_password,omitempty"`
+	MultipleUsersAllowed        bool              `xml:"multiple_users_allowed"`
+	CreateAccountIfDoesNotExist bool              `xml:"create_account_if_does_not_exist"`
+	HideAccount                 bool              `xml:"hide_account"`
+	LockDownSsh                 bool              `xml:"lock_down_ssh"`
+	EnrollIntoSite              *ClassicReference `xml:"enroll_into_site,omitempty"`
+	KeepExistingSiteMembership  bool              `xml:"keep_existing_site_membership"`
+}
+
+// ComputerInvitationResponse represents the API response to creating a computer enrollment invitation
+type ComputerInvitationResponse struct {
+	Id ID `xml:"id"`
+}
+
+func (c *ComputerInvitationsServiceOp) List(ctx context.Context) ([]ComputerInvitationListItem, *Response, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, computerInvitationsBasePath, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var listResponse ComputerInvitationListResponse
+	resp, err := c.client.Do(ctx, req, &listResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return listResponse.Invitations, resp, err
+}
+
+func (c *ComputerInvitationsServiceOp) GetByID(ctx context.Context, id int) (*ComputerInvitation, *Response, error) {
+	return c.get(ctx, computerInvitationsBasePath+"/id/"+strconv.Itoa(id))
+}
+
+// GetByInvitation returns the invitation with the given invitation code.
+func (c *ComputerInvitationsServiceOp) GetByInvitation(ctx context.Context, invitation string) (*ComputerInvitation, *Response, error) {
+	if invitation == "" {
+		return nil, nil, NewArgError("invitation", "cannot be empty")
+	}
+	return c.get(ctx, pathJoin(computerInvitationsBasePath, "invitation", invitation))
+}
+
+// Create creates a computer enrollment invitation and returns it as stored by the server, with its invitation code.
+func (c *ComputerInvitationsServiceOp) Create(ctx context.Context, request *ComputerInvitationRequest) (*ComputerInvitation, *Response, error) {
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+	epoch, err := invitationExpiration(request.Expiration)
+	if err != nil {
+		return nil, nil, err
+	}
+	body := *request
+	body.ExpirationDateEpoch = epoch
+	if body.InvitationType == "" {
+		body.InvitationType = ComputerInvitationTypeDefault
+	}
+
+	req, err := c.client.NewRequest(ctx, http.MethodPost, computerInvitationsBasePath+"/id/0", &body, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invitationCreation := new(ComputerInvitationResponse)
+	resp, err := c.client.Do(ctx, req, invitationCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, invitationCreation.Id, c.GetByID)
+}
+
+func (c *ComputerInvitationsServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := computerInvitationsBasePath + "/id/" + strconv.Itoa(id)
+
+	req, err := c.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DeleteExpired deletes the invitations that have expired, and returns them. It stops at the first invitation that
+// cannot be deleted, returning those deleted before it.
+func (c *ComputerInvitationsServiceOp) DeleteExpired(ctx context.Context) ([]ComputerInvitationListItem, error) {
+	invitations, _, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var deleted []ComputerInvitationListItem
+	for _, invitation := range invitations {
+		if invitation.ExpirationDateEpoch == 0 || invitation.Expiration().After(now) {
+			continue
+		}
+		if _, err := c.Delete(ctx, invitation.Id.Int()); err != nil && err.Error() != "EOF" {
+			return deleted, err
+		}
+		deleted = append(deleted, invitation)
+	}
+	return deleted, nil
+}
+
+// EnrollmentURL returns the link that enrolls a computer with invitation, for user-initiated enrollment.
+func (c *ComputerInvitationsServiceOp) EnrollmentURL(invitation *ComputerInvitation) string {
+	return enrollmentURL(c.client, invitation.Invitation)
+}
+
+func (c *ComputerInvitationsServiceOp) get(ctx context.Context, path string) (*ComputerInvitation, *Response, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var invitation ComputerInvitation
+	resp, err := c.client.Do(ctx, req, &invitation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &invitation, resp, err
+}
+
+// invitationExpiration checks the expiry of a new enrollment invitation, and returns it in milliseconds since the
+// epoch as the Classic API takes it.
+func invitationExpiration(expiration time.Time) (int64, error) {
+	if expiration.IsZero() {
+		return 0, NewArgError("Expiration", "must be set")
+	} else if !expiration.After(time.Now()) {
+		return 0, NewArgError("Expiration", "must be in the future")
+	}
+	return expiration.UnixMilli(), nil
+}
+
+// enrollmentURL returns the user-initiated enrollment link of the instance for an invitation code.
+func enrollmentURL(client *Client, invitation string) string {
+	enroll := client.instanceUrl.JoinPath("enroll")
+	enroll.RawQuery = url.Values{"invitation": {invitation}}.Encode()
+	return enroll.String()
+}
@@ -0,0 +1,168 @@
+package jamfpro
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	computerPrestagesBasePath = "uapi/v2/computer-prestages"
+
+	// prestageScopeAttempts is how often a scope change is tried before an optimistic lock failure is returned
+	prestageScopeAttempts = 5
+
+	prestageScopeBackoff = 250 * time.Millisecond
+)
+
+type ComputerPrestagesService interface {
+	GetScope(context.Context, int) (*PrestageScope, *Response, error)
+	AssignSerialNumbers(context.Context, int, []string) (*PrestageScope, *Response, error)
+	RemoveSerialNumbers(context.Context, int, []string) (*PrestageScope, *Response, error)
+}
+
+// ComputerPrestagesServiceOp handles communication with the computer PreStage enrollment related
+// methods of the Jamf Pro API.
+type ComputerPrestagesServiceOp struct {
+	client *Client
+}
+
+var _ ComputerPrestagesService = &ComputerPrestagesServiceOp{}
+
+// PrestageScope lists the devices assigned to a PreStage enrollment. VersionLock is the version of the scope, which
+// changes to it must quote so that concurrent changes are not lost.
+type PrestageScope struct {
+	PrestageId  ID                   `json:"prestageId"`
+	Assignments []PrestageAssignment `json:"assignments"`
+	VersionLock int                  `json:"versionLock"`
+}
+
+// PrestageAssignment is a device assigned to a PreStage enrollment
+type PrestageAssignment struct {
+	SerialNumber   string `json:"serialNumber"`
+	AssignmentDate string `json:"assignmentDate"`
+	UserAssigned   string `json:"userAssigned"`
+}
+
+// PrestageScopeUpdateRequest represents a request to add devices to, or remove them from, the scope of a PreStage
+// enrollment.
+type PrestageScopeUpdateRequest struct {
+	SerialNumbers []string `json:"serialNumbers"`
+	VersionLock   int      `json:"versionLock"`
+}
+
+// GetScope returns the devices assigned to the computer PreStage enrollment with the given ID.
+func (c *ComputerPrestagesServiceOp) GetScope(ctx context.Context, id int) (*PrestageScope, *Response, error) {
+	if id == 0 {
+		return nil, nil, NewArgError("prestage ID", "cannot be 0")
+	}
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, computerPrestagesBasePath+"/"+strconv.Itoa(id)+"/scope", nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var scope PrestageScope
+	resp, err := c.client.Do(ctx, req, &scope)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &scope, resp, err
+}
+
+// AssignSerialNumbers adds the computers with the given serial numbers to the scope of the computer PreStage
+// enrollment with the given ID, and returns the new scope. Serial numbers already in the scope are skipped.
+//
+// Scope changes are guarded by the scope's version lock. If another client changes the scope at the same time, the
+// scope is fetched again and the change retried, so the error only matches ErrConflict after repeated failures.
+func (c *ComputerPrestagesServiceOp) AssignSerialNumbers(ctx context.Context, id int, serials []string) (*PrestageScope, *Response, error) {
+	return c.updateScope(ctx, id, serials, "add-multiple", true)
+}
+
+// RemoveSerialNumbers removes the computers with the given serial numbers from the scope of the computer PreStage
+// enrollment with the given ID, and returns the new scope. Serial numbers not in the scope are skipped. Conflicts
+// with concurrent changes are retried as they are by AssignSerialNumbers.
+func (c *ComputerPrestagesServiceOp) RemoveSerialNumbers(ctx context.Context, id int, serials []string) (*PrestageScope, *Response, error) {
+	return c.updateScope(ctx, id, serials, "delete-multiple", false)
+}
+
+// updateScope adds serials to, or with assign unset removes them from, the scope of a PreStage enrollment through
+// the scope endpoint called action. Each attempt starts from the current scope, so serials that a concurrent change
+// already took care of are not sent again.
+func (c *ComputerPrestagesServiceOp) updateScope(ctx context.Context, id int, serials []string, action string, assign bool) (*PrestageScope, *Response, error) {
+	if id == 0 {
+		return nil, nil, NewArgError("prestage ID", "cannot be 0")
+	} else if len(serials) == 0 {
+		return nil, nil, NewArgError("serials", "must contain at least one serial number")
+	}
+	for _, serial := range serials {
+		if serial == "" {
+			return nil, nil, NewArgError("serials", "cannot contain an empty serial number")
+		}
+	}
+	path := computerPrestagesBasePath + "/" + strconv.Itoa(id) + "/scope/" + action
+
+	backoff := prestageScopeBackoff
+	for attempt := 0; ; attempt++ {
+		attemptCtx := ctx
+		if attempt > 0 {
+			attemptCtx = withRetryCount(ctx, attempt)
+		}
+
+		scope, resp, err := c.GetScope(attemptCtx, id)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		pending := pendingSerials(scope, serials, assign)
+		if len(pending) == 0 {
+			return scope, resp, nil
+		}
+
+		req, err := c.client.NewRequest(attemptCtx, http.MethodPost, path, &PrestageScopeUpdateRequest{SerialNumbers: pending, VersionLock: scope.VersionLock}, "application/json")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var updated PrestageScope
+		resp, err = c.client.Do(attemptCtx, req, &updated)
+		if err == nil {
+			return &updated, resp, nil
+		}
+		if !errors.Is(err, ErrConflict) || attempt == prestageScopeAttempts-1 {
+			return nil, resp, err
+		}
+
+		// Spread the retries of clients that collided, so they do not collide again.
+		wait := backoff + time.Duration(rand.Int63n(int64(backoff)))
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, resp, ctx.Err()
+		}
+		backoff *= 2
+	}
+}
+
+// pendingSerials returns the serials that still need to be added to scope, or with assign unset, removed from it.
+func pendingSerials(scope *PrestageScope, serials []string, assign bool) []string {
+	assigned := make(map[string]bool, len(scope.Assignments))
+	for _, assignment := range scope.Assignments {
+		assigned[assignment.SerialNumber] = true
+	}
+
+	var pending []string
+	seen := make(map[string]bool, len(serials))
+	for _, serial := range serials {
+		if seen[serial] || assigned[serial] == assign {
+			continue
+		}
+		seen[serial] = true
+		pending = append(pending, serial)
+	}
+	return pending
+}
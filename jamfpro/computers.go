@@ -9,16 +9,34 @@ import (
 	"time"
 )
 
-const computersBasePath = "JSSResource/computers"
+const (
+	computersBasePath   = "JSSResource/computers"
+	computersV1BasePath = "uapi/v1/computers"
+)
 
 type ComputersService interface {
 	List(context.Context) ([]Computer, *Response, error)
 	GetByID(context.Context, int) (*Computer, *Response, error)
+	GetManyByID(context.Context, []int, int) (map[int]*Computer, error)
 	GetByName(context.Context, string) (*Computer, *Response, error)
 	GetBySerialNumber(context.Context, string) (*Computer, *Response, error)
+	ExistsBySerial(context.Context, string) (bool, *Response, error)
 	Create(context.Context, *ComputerCreateRequest) (*Computer, *Response, error)
 	Update(context.Context, int, *ComputerUpdateRequest) (*Computer, *Response, error)
 	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+	GetInventoryDetail(context.Context, int) (*ComputerInventory, *Response, error)
+	UpdateInventoryDetail(context.Context, int, *ComputerInventoryUpdateRequest) (*ComputerInventory, *Response, error)
+	ListInventory(context.Context, *ComputerInventoryListOptions) ([]ComputerInventory, *Response, error)
+	StreamList(context.Context, func(Computer) error) (*Response, error)
+	StreamInventory(context.Context, *ComputerInventoryListOptions, func(ComputerInventory) error) (*Response, error)
+	UpdatePurchasing(context.Context, int, Purchasing) (*ComputerInventory, *Response, error)
+	Lock(context.Context, int, string) (string, *Response, error)
+	Erase(context.Context, int, EraseOptions) (string, *Response, error)
+	Unmanage(context.Context, int) (string, *Response, error)
+	RemoveMDMProfile(context.Context, int) (string, *Response, error)
+	Reenroll(context.Context, int) (*Response, error)
+	RecalculateSmartGroups(context.Context, int) (int, *Response, error)
 }
 
 // ComputersServiceOp handles communication with the computer-related
@@ -31,7 +49,7 @@ var _ ComputersService = &ComputersServiceOp{}
 
 // Computer represents a Jamf Pro Computer
 type Computer struct {
-	Id           int             `json:"id" xml:"id"`
+	Id           ID              `json:"id" xml:"id"`
 	Name         string          `json:"name" xml:"name,omitempty"`
 	General      ComputerGeneral `json:"general,omitempty" xml:"-"`
 	SerialNumber string          `json:"serial_number,omitempty" xml:"serial_number,omitempty"`
@@ -39,7 +57,7 @@ type Computer struct {
 }
 
 type ComputerGeneral struct {
-	Id           int    `json:"id"`
+	Id           ID     `json:"id"`
 	Name         string `json:"name"`
 	AssetTag     string `json:"asset_tag"`
 	Platform     string `json:"platform"`
@@ -68,7 +86,7 @@ type ComputerGetResponse struct {
 }
 
 type ComputerCreateResponse struct {
-	Id int `xml:"id"`
+	Id ID `xml:"id"`
 }
 
 // ComputerListResponse represents the raw API response to getting all computers
@@ -102,6 +120,13 @@ func (c *ComputersServiceOp) GetByID(ctx context.Context, Id int) (*Computer, *R
 	return &computerResponse.Computer, resp, err
 }
 
+// GetManyByID fetches the computers with the given IDs, with at most concurrency requests in flight, as the Classic API
+// cannot fetch several at once. The records fetched are returned keyed by ID even if others failed, which are
+// reported in a *BatchGetError.
+func (c *ComputersServiceOp) GetManyByID(ctx context.Context, ids []int, concurrency int) (map[int]*Computer, error) {
+	return getManyByID(ctx, ids, concurrency, c.GetByID)
+}
+
 func (c *ComputersServiceOp) GetByName(ctx context.Context, computerName string) (*Computer, *Response, error) {
 	computers, _, err := c.list(ctx)
 	var id int
@@ -111,13 +136,13 @@ func (c *ComputersServiceOp) GetByName(ctx context.Context, computerName string)
 
 	for i := range computers {
 		if computers[i].Name == computerName {
-			id = computers[i].Id
+			id = computers[i].Id.Int()
 			break
 		}
 	}
 
-	if err != nil {
-		return nil, nil, err
+	if id == 0 {
+		return nil, nil, notFoundByName("computer", computerName)
 	}
 
 	computer, resp, err := c.GetByID(ctx, id)
@@ -133,8 +158,14 @@ func (c *ComputersServiceOp) GetByName(ctx context.Context, computerName string)
 	return computer, resp, err
 }
 
+// GetBySerialNumber returns the computer with the given serial number. A serialNumber no device can have is refused
+// with an *ArgError before any request is sent.
 func (c *ComputersServiceOp) GetBySerialNumber(ctx context.Context, serialNumber string) (*Computer, *Response, error) {
-	path := computersBasePath + "/serialnumber/" + serialNumber
+	if err := validateSerialNumber(serialNumber); err != nil {
+		return nil, nil, err
+	}
+
+	path := pathJoin(computersBasePath, "serialnumber", serialNumber)
 	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
 	if err != nil {
 		return nil, nil, err
@@ -154,13 +185,37 @@ func (c *ComputersServiceOp) GetBySerialNumber(ctx context.Context, serialNumber
 	return &computerResponse.Computer, resp, err
 }
 
+// ExistsBySerial reports whether a computer has the given serial number. Only the general subset of the record is
+// requested, and its body is not decoded.
+func (c *ComputersServiceOp) ExistsBySerial(ctx context.Context, serialNumber string) (bool, *Response, error) {
+	if err := validateSerialNumber(serialNumber); err != nil {
+		return false, nil, err
+	}
+
+	path := pathJoin(computersBasePath, "serialnumber", serialNumber, "subset", "General")
+	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := c.client.Do(ctx, req, nil)
+	exists, err := existence(err)
+	return exists, resp, err
+}
+
 // Create creates a Computer record in Jamf Pro. Note that possibilities here are intentionally limited - this function
-// really only serves to create dummy computer records for testing the datasource facility.
+// really only serves to create dummy computer records for testing the datasource facility. The serial number and UDID
+// are checked, and the UDID normalized, before the request is sent.
 func (c *ComputersServiceOp) Create(ctx context.Context, request *ComputerCreateRequest) (*Computer, *Response, error) {
 	path := computersBasePath + "/id/0"
 	if request == nil {
 		return nil, nil, NewArgError("createRequest", "cannot be nil")
 	}
+	general, err := normalizeComputerGeneral(request.General)
+	if err != nil {
+		return nil, nil, err
+	}
+	request = &ComputerCreateRequest{General: general}
 
 	req, err := c.client.NewRequest(ctx, http.MethodPost, path, request, "application/xml")
 	if err != nil {
@@ -173,16 +228,7 @@ func (c *ComputersServiceOp) Create(ctx context.Context, request *ComputerCreate
 		return nil, resp, err
 	}
 
-	intendedComputerRecord := c.createComputerFromCreationResponse(*computerCreation, *request)
-
-	createdComputerRecord, resp, err := c.client.Computers.GetByID(ctx, intendedComputerRecord.Id)
-	interval := 1
-	for resp.StatusCode != http.StatusOK && !AreComputerRecordsEquivalent(&intendedComputerRecord, createdComputerRecord) {
-		time.Sleep(time.Duration(interval) * time.Second)
-		createdComputerRecord, resp, err = c.client.Computers.GetByID(ctx, intendedComputerRecord.Id)
-		interval = interval * 2
-	}
-	return &intendedComputerRecord, resp, err
+	return getCreated(ctx, resp, computerCreation.Id, c.GetByID)
 }
 
 // Update updates a Computer record in Jamf Pro. Note that possibilities here are intentionally limited - this function
@@ -194,6 +240,11 @@ func (c *ComputersServiceOp) Update(ctx context.Context, i int, request *Compute
 	} else if i == 0 {
 		return nil, nil, NewArgError("computer ID", "cannot be 0")
 	}
+	general, err := normalizeComputerGeneral(request.General)
+	if err != nil {
+		return nil, nil, err
+	}
+	request = &ComputerUpdateRequest{General: general}
 
 	req, err := c.client.NewRequest(ctx, http.MethodPut, path, request, "application/xml")
 	if err != nil {
@@ -206,16 +257,10 @@ func (c *ComputersServiceOp) Update(ctx context.Context, i int, request *Compute
 		return nil, resp, err
 	}
 
-	intendedComputerRecord := c.createComputerFromUpdateResponse(*computerUpdate, *request)
-
-	updatedComputerRecord, resp, err := c.client.Computers.GetByID(ctx, intendedComputerRecord.Id)
-	interval := 1
-	for resp.StatusCode != http.StatusOK && !AreComputerRecordsEquivalent(&intendedComputerRecord, updatedComputerRecord) {
-		time.Sleep(time.Duration(interval) * time.Second)
-		updatedComputerRecord, resp, err = c.client.Computers.GetByID(ctx, intendedComputerRecord.Id)
-		interval = interval * 2
-	}
-	return &intendedComputerRecord, resp, err
+	// Jamf Pro replicates the change between its nodes after answering, so wait until the record shows it
+	return getUpdated(ctx, resp, computerUpdate.Id, c.GetByID, func(computer *Computer) bool {
+		return computer.Name == general.Name && computer.SerialNumber == general.SerialNumber
+	})
 }
 
 func (c *ComputersServiceOp) Delete(ctx context.Context, i int) (*Response, error) {
@@ -234,9 +279,9 @@ func (c *ComputersServiceOp) Delete(ctx context.Context, i int) (*Response, erro
 	_, resp, err := c.client.Computers.GetByID(ctx, i)
 	interval := 1
 	limit := 5
-	for resp.StatusCode != http.StatusNotFound && limit > 0 {
+	for retry := 1; resp.StatusCode != http.StatusNotFound && limit > 0; retry++ {
 		time.Sleep(time.Duration(interval) * time.Second)
-		_, resp, err = c.client.Computers.GetByID(ctx, i)
+		_, resp, err = c.client.Computers.GetByID(withRetryCount(ctx, retry), i)
 		interval = interval * 2
 		limit = limit - 1
 	}
@@ -247,6 +292,33 @@ func (c *ComputersServiceOp) Delete(ctx context.Context, i int) (*Response, erro
 	return deletionResp, deletionErr
 }
 
+// DeleteByName deletes the computer called name. The error matches ErrNotFound if there is none.
+func (c *ComputersServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "computer", name, c.GetByName, func(computer *Computer) ID { return computer.Id }, c.Delete)
+}
+
+// RecalculateSmartGroups forces Jamf Pro to re-evaluate which smart groups a computer belongs to, e.g. after its
+// inventory has been changed, and returns the number of smart groups it is a member of afterwards.
+func (c *ComputersServiceOp) RecalculateSmartGroups(ctx context.Context, id int) (int, *Response, error) {
+	path := computersV1BasePath + "/" + strconv.Itoa(id) + "/recalculate-smart-groups"
+	if id == 0 {
+		return 0, nil, NewArgError("computer ID", "cannot be 0")
+	}
+
+	req, err := c.client.NewRequest(ctx, http.MethodPost, path, nil, "application/json")
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var recalculation RecalculateResponse
+	resp, err := c.client.Do(ctx, req, &recalculation)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return recalculation.Count, resp, err
+}
+
 func (c *ComputersServiceOp) list(ctx context.Context) ([]Computer, *Response, error) {
 	path := computersBasePath
 	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
@@ -264,24 +336,17 @@ func (c *ComputersServiceOp) list(ctx context.Context) ([]Computer, *Response, e
 
 }
 
-func (c *ComputersServiceOp) createComputerFromRequest(request ComputerCreateRequest) Computer {
-	computer := new(Computer)
-	return *computer
-}
-
-func (c *ComputersServiceOp) createComputerFromCreationResponse(response ComputerCreateResponse, request ComputerCreateRequest) Computer {
-	return Computer{
-		Id:           response.Id,
-		Name:         request.General.Name,
-		SerialNumber: request.General.SerialNumber,
+// normalizeComputerGeneral checks the serial number of general and normalizes its UDID, if it has one.
+func normalizeComputerGeneral(general ComputerCreateGeneral) (ComputerCreateGeneral, error) {
+	if err := validateSerialNumber(general.SerialNumber); err != nil {
+		return general, err
 	}
-
-}
-
-func (c *ComputersServiceOp) createComputerFromUpdateResponse(response ComputerCreateResponse, request ComputerUpdateRequest) Computer {
-	return Computer{
-		Id:           response.Id,
-		Name:         request.General.Name,
-		SerialNumber: request.General.SerialNumber,
+	if general.Udid != "" {
+		udid, err := NormalizeUDID(general.Udid)
+		if err != nil {
+			return general, err
+		}
+		general.Udid = udid
 	}
+	return general, nil
 }
@@ -0,0 +1,67 @@
+package jamfpro
+
+// FileVault 2 states of a partition
+const (
+	FileVaultStateEncrypted   = "ENCRYPTED"
+	FileVaultStateEncrypting  = "ENCRYPTING"
+	FileVaultStateDecrypting  = "DECRYPTING"
+	FileVaultStateUnencrypted = "UNENCRYPTED"
+	FileVaultStateUnknown     = "UNKNOWN"
+)
+
+// ComputerSecuritySections are the inventory sections ComputerInventory.SecurityPosture reads. Pass them to
+// ListInventory to fetch everything it needs in one request.
+var ComputerSecuritySections = []string{
+	ComputerInventorySectionGeneral,
+	ComputerInventorySectionHardware,
+	ComputerInventorySectionOperatingSystem,
+	ComputerInventorySectionSecurity,
+	ComputerInventorySectionDiskEncryption,
+}
+
+// ComputerSecurityPosture is the security state of a single computer, gathered from several inventory sections.
+// Fields whose section was not returned are left at their zero value.
+type ComputerSecurityPosture struct {
+	ComputerId       ID
+	Name             string
+	SerialNumber     string
+	OSVersion        string
+	FileVaultState   string
+	SIPEnabled       bool
+	GatekeeperStatus string
+	FirewallEnabled  bool
+	XProtectVersion  string
+	MdmCapable       bool
+	Supervised       bool
+}
+
+// Encrypted reports whether the boot partition of the computer is fully encrypted with FileVault 2.
+func (p ComputerSecurityPosture) Encrypted() bool {
+	return p.FileVaultState == FileVaultStateEncrypted
+}
+
+// SecurityPosture returns the security state of the computer.
+func (c *ComputerInventory) SecurityPosture() ComputerSecurityPosture {
+	posture := ComputerSecurityPosture{ComputerId: c.Id}
+	if c.General != nil {
+		posture.Name = c.General.Name
+		posture.MdmCapable = c.General.MdmCapable.Capable
+		posture.Supervised = c.General.Supervised
+	}
+	if c.Hardware != nil {
+		posture.SerialNumber = c.Hardware.SerialNumber
+	}
+	if c.OperatingSystem != nil {
+		posture.OSVersion = c.OperatingSystem.Version
+	}
+	if c.Security != nil {
+		posture.SIPEnabled = c.Security.SipStatus == "ENABLED"
+		posture.GatekeeperStatus = c.Security.GatekeeperStatus
+		posture.FirewallEnabled = c.Security.FirewallEnabled
+		posture.XProtectVersion = c.Security.XprotectVersion
+	}
+	if c.DiskEncryption != nil {
+		posture.FileVaultState = c.DiskEncryption.BootPartitionEncryptionDetails.PartitionFileVault2State
+	}
+	return posture
+}
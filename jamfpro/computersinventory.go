@@ -0,0 +1,342 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+const (
+	computersInventoryBasePath       = "uapi/v1/computers-inventory"
+	computersInventoryDetailBasePath = "uapi/v1/computers-inventory-detail"
+)
+
+// Sections of the computer inventory that can be requested with ComputerInventoryListOptions
+const (
+	ComputerInventorySectionGeneral             = "GENERAL"
+	ComputerInventorySectionDiskEncryption      = "DISK_ENCRYPTION"
+	ComputerInventorySectionPurchasing          = "PURCHASING"
+	ComputerInventorySectionUserAndLocation     = "USER_AND_LOCATION"
+	ComputerInventorySectionHardware            = "HARDWARE"
+	ComputerInventorySectionOperatingSystem     = "OPERATING_SYSTEM"
+	ComputerInventorySectionSecurity            = "SECURITY"
+	ComputerInventorySectionExtensionAttributes = "EXTENSION_ATTRIBUTES"
+	ComputerInventorySectionAttachments         = "ATTACHMENTS"
+)
+
+// ComputerInventory represents a computer as returned by the v1 computers inventory endpoints. Sections that were
+// not requested or not returned by the server are nil.
+type ComputerInventory struct {
+	Id                  ID                                    `json:"id"`
+	Udid                string                                `json:"udid"`
+	General             *ComputerInventoryGeneral             `json:"general,omitempty"`
+	Purchasing          *ComputerInventoryPurchasing          `json:"purchasing,omitempty"`
+	UserAndLocation     *ComputerInventoryUserAndLocation     `json:"userAndLocation,omitempty"`
+	Hardware            *ComputerInventoryHardware            `json:"hardware,omitempty"`
+	OperatingSystem     *ComputerInventoryOperatingSystem     `json:"operatingSystem,omitempty"`
+	Security            *ComputerInventorySecurity            `json:"security,omitempty"`
+	DiskEncryption      *ComputerInventoryDiskEncryption      `json:"diskEncryption,omitempty"`
+	ExtensionAttributes []ComputerInventoryExtensionAttribute `json:"extensionAttributes,omitempty"`
+	Attachments         []ComputerInventoryAttachment         `json:"attachments,omitempty"`
+}
+
+// ComputerInventoryAttachment describes a file attached to a computer, which FileUploads.DownloadComputerAttachment
+// retrieves.
+type ComputerInventoryAttachment struct {
+	Id        ID     `json:"id"`
+	Name      string `json:"name"`
+	FileType  string `json:"fileType"`
+	SizeBytes int64  `json:"sizeBytes"`
+}
+
+type ComputerInventoryGeneral struct {
+	Name                                 string                                `json:"name"`
+	LastIpAddress                        string                                `json:"lastIpAddress"`
+	LastReportedIp                       string                                `json:"lastReportedIp"`
+	JamfBinaryVersion                    string                                `json:"jamfBinaryVersion"`
+	Platform                             string                                `json:"platform"`
+	Barcode1                             string                                `json:"barcode1"`
+	Barcode2                             string                                `json:"barcode2"`
+	AssetTag                             string                                `json:"assetTag"`
+	RemoteManagement                     ComputerInventoryRemoteManagement     `json:"remoteManagement"`
+	Supervised                           bool                                  `json:"supervised"`
+	MdmCapable                           ComputerInventoryMdmCapability        `json:"mdmCapable"`
+	ReportDate                           string                                `json:"reportDate"`
+	LastContactTime                      string                                `json:"lastContactTime"`
+	LastEnrolledDate                     string                                `json:"lastEnrolledDate"`
+	MdmProfileExpiration                 string                                `json:"mdmProfileExpiration"`
+	InitialEntryDate                     string                                `json:"initialEntryDate"`
+	Site                                 ComputerInventorySite                 `json:"site"`
+	EnrolledViaAutomatedDeviceEnrollment bool                                  `json:"enrolledViaAutomatedDeviceEnrollment"`
+	UserApprovedMdm                      bool                                  `json:"userApprovedMdm"`
+	ManagementId                         string                                `json:"managementId"`
+	ExtensionAttributes                  []ComputerInventoryExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type ComputerInventoryRemoteManagement struct {
+	Managed            bool   `json:"managed"`
+	ManagementUsername string `json:"managementUsername"`
+}
+
+type ComputerInventoryMdmCapability struct {
+	Capable      bool     `json:"capable"`
+	CapableUsers []string `json:"capableUsers"`
+}
+
+type ComputerInventorySite struct {
+	Id   ID     `json:"id"`
+	Name string `json:"name"`
+}
+
+type ComputerInventoryPurchasing struct {
+	Leased              bool                                  `json:"leased"`
+	Purchased           bool                                  `json:"purchased"`
+	PoNumber            string                                `json:"poNumber"`
+	PoDate              string                                `json:"poDate"`
+	Vendor              string                                `json:"vendor"`
+	WarrantyDate        string                                `json:"warrantyDate"`
+	AppleCareId         string                                `json:"appleCareId"`
+	LeaseDate           string                                `json:"leaseDate"`
+	PurchasePrice       string                                `json:"purchasePrice"`
+	LifeExpectancy      int                                   `json:"lifeExpectancy"`
+	PurchasingAccount   string                                `json:"purchasingAccount"`
+	PurchasingContact   string                                `json:"purchasingContact"`
+	ExtensionAttributes []ComputerInventoryExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type ComputerInventoryUserAndLocation struct {
+	Username            string                                `json:"username"`
+	Realname            string                                `json:"realname"`
+	Email               string                                `json:"email"`
+	Position            string                                `json:"position"`
+	Phone               string                                `json:"phone"`
+	DepartmentId        ID                                    `json:"departmentId"`
+	BuildingId          ID                                    `json:"buildingId"`
+	Room                string                                `json:"room"`
+	ExtensionAttributes []ComputerInventoryExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type ComputerInventoryHardware struct {
+	Make                  string                                `json:"make"`
+	Model                 string                                `json:"model"`
+	ModelIdentifier       string                                `json:"modelIdentifier"`
+	SerialNumber          string                                `json:"serialNumber"`
+	ProcessorType         string                                `json:"processorType"`
+	ProcessorArchitecture string                                `json:"processorArchitecture"`
+	CoreCount             int                                   `json:"coreCount"`
+	MacAddress            string                                `json:"macAddress"`
+	AltMacAddress         string                                `json:"altMacAddress"`
+	NetworkAdapterType    string                                `json:"networkAdapterType"`
+	AltNetworkAdapterType string                                `json:"altNetworkAdapterType"`
+	TotalRamMegabytes     int64                                 `json:"totalRamMegabytes"`
+	AppleSilicon          bool                                  `json:"appleSilicon"`
+	ExtensionAttributes   []ComputerInventoryExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type ComputerInventoryOperatingSystem struct {
+	Name                     string                                `json:"name"`
+	Version                  string                                `json:"version"`
+	Build                    string                                `json:"build"`
+	SupplementalBuildVersion string                                `json:"supplementalBuildVersion"`
+	RapidSecurityResponse    string                                `json:"rapidSecurityResponse"`
+	ActiveDirectoryStatus    string                                `json:"activeDirectoryStatus"`
+	FileVault2Status         string                                `json:"fileVault2Status"`
+	ExtensionAttributes      []ComputerInventoryExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type ComputerInventorySecurity struct {
+	SipStatus                    string `json:"sipStatus"`
+	GatekeeperStatus             string `json:"gatekeeperStatus"`
+	XprotectVersion              string `json:"xprotectVersion"`
+	AutoLoginDisabled            bool   `json:"autoLoginDisabled"`
+	RemoteDesktopEnabled         bool   `json:"remoteDesktopEnabled"`
+	ActivationLockEnabled        bool   `json:"activationLockEnabled"`
+	RecoveryLockEnabled          bool   `json:"recoveryLockEnabled"`
+	FirewallEnabled              bool   `json:"firewallEnabled"`
+	SecureBootLevel              string `json:"secureBootLevel"`
+	ExternalBootLevel            string `json:"externalBootLevel"`
+	BootstrapTokenAllowed        bool   `json:"bootstrapTokenAllowed"`
+	BootstrapTokenEscrowedStatus string `json:"bootstrapTokenEscrowedStatus"`
+}
+
+type ComputerInventoryDiskEncryption struct {
+	BootPartitionEncryptionDetails      ComputerInventoryPartitionEncryption `json:"bootPartitionEncryptionDetails"`
+	IndividualRecoveryKeyValidityStatus string                               `json:"individualRecoveryKeyValidityStatus"`
+	InstitutionalRecoveryKeyPresent     bool                                 `json:"institutionalRecoveryKeyPresent"`
+	DiskEncryptionConfigurationName     string                               `json:"diskEncryptionConfigurationName"`
+	FileVault2EnabledUserNames          []string                             `json:"fileVault2EnabledUserNames"`
+	FileVault2EligibilityMessage        string                               `json:"fileVault2EligibilityMessage"`
+}
+
+type ComputerInventoryPartitionEncryption struct {
+	PartitionName              string `json:"partitionName"`
+	PartitionFileVault2State   string `json:"partitionFileVault2State"`
+	PartitionFileVault2Percent int    `json:"partitionFileVault2Percent"`
+}
+
+// ComputerInventoryExtensionAttribute is the value of an extension attribute on a computer
+type ComputerInventoryExtensionAttribute struct {
+	DefinitionId string   `json:"definitionId"`
+	Name         string   `json:"name,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Enabled      bool     `json:"enabled,omitempty"`
+	MultiValue   bool     `json:"multiValue,omitempty"`
+	Values       []string `json:"values"`
+	DataType     string   `json:"dataType,omitempty"`
+	InputType    string   `json:"inputType,omitempty"`
+}
+
+// ComputerInventoryUpdateRequest represents a request to update the inventory of a computer. The update is applied
+// with merge-patch semantics: nil fields and sections are left unchanged on the server, so only set what should change.
+// As nil fields are omitted, the request cannot clear a field by sending null; blank a text field by setting it to an
+// empty string instead. Fields of other types, such as DepartmentId, cannot be cleared through this API.
+type ComputerInventoryUpdateRequest struct {
+	Udid                *string                                     `json:"udid,omitempty"`
+	General             *ComputerInventoryGeneralUpdate             `json:"general,omitempty"`
+	Purchasing          *ComputerInventoryPurchasingUpdate          `json:"purchasing,omitempty"`
+	UserAndLocation     *ComputerInventoryUserAndLocationUpdate     `json:"userAndLocation,omitempty"`
+	Hardware            *ComputerInventoryHardwareUpdate            `json:"hardware,omitempty"`
+	OperatingSystem     *ComputerInventoryOperatingSystemUpdate     `json:"operatingSystem,omitempty"`
+	ExtensionAttributes []ComputerInventoryExtensionAttributeUpdate `json:"extensionAttributes,omitempty"`
+}
+
+type ComputerInventoryGeneralUpdate struct {
+	Name                *string                                     `json:"name,omitempty"`
+	LastIpAddress       *string                                     `json:"lastIpAddress,omitempty"`
+	Barcode1            *string                                     `json:"barcode1,omitempty"`
+	Barcode2            *string                                     `json:"barcode2,omitempty"`
+	AssetTag            *string                                     `json:"assetTag,omitempty"`
+	ExtensionAttributes []ComputerInventoryExtensionAttributeUpdate `json:"extensionAttributes,omitempty"`
+}
+
+type ComputerInventoryPurchasingUpdate struct {
+	Leased              *bool                                       `json:"leased,omitempty"`
+	Purchased           *bool                                       `json:"purchased,omitempty"`
+	AppleCareId         *string                                     `json:"appleCareId,omitempty"`
+	PoNumber            *string                                     `json:"poNumber,omitempty"`
+	Vendor              *string                                     `json:"vendor,omitempty"`
+	PurchasePrice       *string                                     `json:"purchasePrice,omitempty"`
+	LifeExpectancy      *int                                        `json:"lifeExpectancy,omitempty"`
+	PurchasingAccount   *string                                     `json:"purchasingAccount,omitempty"`
+	PurchasingContact   *string                                     `json:"purchasingContact,omitempty"`
+	LeaseDate           *string                                     `json:"leaseDate,omitempty"`
+	PoDate              *string                                     `json:"poDate,omitempty"`
+	WarrantyDate        *string                                     `json:"warrantyDate,omitempty"`
+	ExtensionAttributes []ComputerInventoryExtensionAttributeUpdate `json:"extensionAttributes,omitempty"`
+}
+
+type ComputerInventoryUserAndLocationUpdate struct {
+	Username            *string                                     `json:"username,omitempty"`
+	Realname            *string                                     `json:"realname,omitempty"`
+	Email               *string                                     `json:"email,omitempty"`
+	Position            *string                                     `json:"position,omitempty"`
+	Phone               *string                                     `json:"phone,omitempty"`
+	DepartmentId        *ID                                         `json:"departmentId,omitempty"`
+	BuildingId          *ID                                         `json:"buildingId,omitempty"`
+	Room                *string                                     `json:"room,omitempty"`
+	ExtensionAttributes []ComputerInventoryExtensionAttributeUpdate `json:"extensionAttributes,omitempty"`
+}
+
+type ComputerInventoryHardwareUpdate struct {
+	NetworkAdapterType    *string                                     `json:"networkAdapterType,omitempty"`
+	MacAddress            *string                                     `json:"macAddress,omitempty"`
+	AltNetworkAdapterType *string                                     `json:"altNetworkAdapterType,omitempty"`
+	AltMacAddress         *string                                     `json:"altMacAddress,omitempty"`
+	ExtensionAttributes   []ComputerInventoryExtensionAttributeUpdate `json:"extensionAttributes,omitempty"`
+}
+
+type ComputerInventoryOperatingSystemUpdate struct {
+	ExtensionAttributes []ComputerInventoryExtensionAttributeUpdate `json:"extensionAttributes,omitempty"`
+}
+
+// ComputerInventoryExtensionAttributeUpdate sets the values of an extension attribute, identified by its definition ID
+type ComputerInventoryExtensionAttributeUpdate struct {
+	DefinitionId string   `json:"definitionId"`
+	Values       []string `json:"values"`
+}
+
+// GetInventoryDetail returns the full v1 inventory record of a computer, with every section populated.
+func (c *ComputersServiceOp) GetInventoryDetail(ctx context.Context, id int) (*ComputerInventory, *Response, error) {
+	path := computersInventoryDetailBasePath + "/" + strconv.Itoa(id)
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var computerInventory ComputerInventory
+	resp, err := c.client.Do(ctx, req, &computerInventory)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &computerInventory, resp, err
+}
+
+// UpdateInventoryDetail updates fields such as the asset tag, barcodes, purchasing information and extension attribute
+// values of a computer through the v1 API, and returns the updated inventory record.
+func (c *ComputersServiceOp) UpdateInventoryDetail(ctx context.Context, id int, request *ComputerInventoryUpdateRequest) (*ComputerInventory, *Response, error) {
+	path := computersInventoryDetailBasePath + "/" + strconv.Itoa(id)
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if id == 0 {
+		return nil, nil, NewArgError("computer ID", "cannot be 0")
+	}
+
+	req, err := c.client.NewRequest(ctx, http.MethodPatch, path, request, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var computerInventory ComputerInventory
+	resp, err := c.client.Do(ctx, req, &computerInventory)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &computerInventory, resp, err
+}
+
+// ComputerInventoryListOptions selects a page of computers, and the sections of their inventory to return. Only the
+// GENERAL section is returned if no Sections are given.
+type ComputerInventoryListOptions struct {
+	ListOptions
+	Sections []string
+}
+
+// EncodeQuery adds the list options, and a section parameter for every section.
+func (o *ComputerInventoryListOptions) EncodeQuery(q *QueryParams) {
+	if o == nil {
+		return
+	}
+	o.ListOptions.EncodeQuery(q)
+	q.Strings("section", o.Sections)
+}
+
+// ComputerInventoryListResponse represents the raw API response to listing computer inventory
+type ComputerInventoryListResponse struct {
+	TotalCount int                 `json:"totalCount"`
+	Results    []ComputerInventory `json:"results"`
+}
+
+// ListInventory returns one page of the v1 inventory records of computers. Response.TotalCount holds the number of
+// computers across all pages.
+func (c *ComputersServiceOp) ListInventory(ctx context.Context, opts *ComputerInventoryListOptions) ([]ComputerInventory, *Response, error) {
+	path, err := addOptions(computersInventoryBasePath, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var listResponse ComputerInventoryListResponse
+	resp, err := c.client.Do(ctx, req, &listResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return listResponse.Results, resp, err
+}
@@ -0,0 +1,158 @@
+package jamfpro
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+const (
+	smtpServerBasePath = "uapi/v1/smtp-server"
+	cloudLdapsBasePath = "uapi/v2/cloud-ldaps"
+)
+
+// Names of the connections ConnectionTestsService can test
+const (
+	ConnectionGSX  = "GSX"
+	ConnectionLDAP = "LDAP"
+	ConnectionSMTP = "SMTP"
+)
+
+type ConnectionTestsService interface {
+	GSX(context.Context) (*ConnectionTestResult, *Response, error)
+	LDAP(context.Context, string) (*ConnectionTestResult, *Response, error)
+	SMTP(context.Context, string) (*ConnectionTestResult, *Response, error)
+}
+
+// ConnectionTestsServiceOp handles communication with the connection test
+// methods of the Jamf Pro API.
+type ConnectionTestsServiceOp struct {
+	client *Client
+}
+
+var _ ConnectionTestsService = &ConnectionTestsServiceOp{}
+
+// ConnectionTestResult is the outcome of having Jamf Pro test one of its connections to another service. A test
+// that ran and failed is reported with Passed set to false rather than as an error; errors are kept for requests
+// that could not be made, such as authentication failures.
+type ConnectionTestResult struct {
+	Connection string
+	Passed     bool
+	Message    string
+	StatusCode int
+}
+
+type gsxTestResponse struct {
+	Code        int    `json:"code"`
+	Description string `json:"description"`
+}
+
+type cloudLdapConnectionStatus struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+type smtpTestRequest struct {
+	RecipientEmail string `json:"recipientEmail"`
+}
+
+// GSX has Jamf Pro authenticate to GSX with the configured connection.
+func (c *ConnectionTestsServiceOp) GSX(ctx context.Context) (*ConnectionTestResult, *Response, error) {
+	path := gsxConnectionBasePath + "/test"
+
+	ctx = withRead(ctx)
+	req, err := c.client.NewRequest(ctx, http.MethodPost, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var testResponse gsxTestResponse
+	resp, err := c.client.Do(ctx, req, &testResponse)
+	if result, err := connectionTestResult(ConnectionGSX, resp, err); result != nil || err != nil {
+		return result, resp, err
+	}
+
+	return &ConnectionTestResult{
+		Connection: ConnectionGSX,
+		Passed:     testResponse.Code == 0 || (testResponse.Code >= 200 && testResponse.Code <= 299),
+		Message:    testResponse.Description,
+		StatusCode: resp.StatusCode,
+	}, resp, nil
+}
+
+// LDAP has Jamf Pro connect to the cloud identity provider with the given ID.
+func (c *ConnectionTestsServiceOp) LDAP(ctx context.Context, id string) (*ConnectionTestResult, *Response, error) {
+	if id == "" {
+		return nil, nil, NewArgError("id", "cannot be empty")
+	}
+	path := pathJoin(cloudLdapsBasePath, id, "connection", "status")
+
+	req, err := c.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var status cloudLdapConnectionStatus
+	resp, err := c.client.Do(ctx, req, &status)
+	if result, err := connectionTestResult(ConnectionLDAP, resp, err); result != nil || err != nil {
+		return result, resp, err
+	}
+
+	return &ConnectionTestResult{
+		Connection: ConnectionLDAP,
+		Passed:     strings.EqualFold(status.Status, "SUCCEEDED") || strings.EqualFold(status.Status, "SUCCESS"),
+		Message:    strings.TrimSpace(status.Status + " " + status.Message),
+		StatusCode: resp.StatusCode,
+	}, resp, nil
+}
+
+// SMTP has Jamf Pro send a test email to recipientEmail through the configured SMTP server. Passing only means the
+// server accepted the message; check the recipient's inbox to confirm delivery.
+func (c *ConnectionTestsServiceOp) SMTP(ctx context.Context, recipientEmail string) (*ConnectionTestResult, *Response, error) {
+	if recipientEmail == "" {
+		return nil, nil, NewArgError("recipientEmail", "cannot be empty")
+	}
+	path := smtpServerBasePath + "/test"
+
+	ctx = withRead(ctx)
+	req, err := c.client.NewRequest(ctx, http.MethodPost, path, &smtpTestRequest{RecipientEmail: recipientEmail}, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.client.Do(ctx, req, nil)
+	if result, err := connectionTestResult(ConnectionSMTP, resp, err); result != nil || err != nil {
+		return result, resp, err
+	}
+
+	return &ConnectionTestResult{
+		Connection: ConnectionSMTP,
+		Passed:     true,
+		StatusCode: resp.StatusCode,
+	}, resp, nil
+}
+
+// connectionTestResult turns the error of a test request into a failed result when Jamf Pro ran the test and
+// rejected the connection. It returns neither a result nor an error when the request succeeded.
+func connectionTestResult(connection string, resp *Response, err error) (*ConnectionTestResult, error) {
+	if err == nil {
+		return nil, nil
+	}
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Response == nil {
+		return nil, err
+	}
+	switch code := errorResponse.Response.StatusCode; {
+	case code == http.StatusUnauthorized, code == http.StatusForbidden, code == http.StatusNotFound:
+		return nil, err
+	default:
+		return &ConnectionTestResult{
+			Connection: connection,
+			Passed:     false,
+			Message:    errorResponse.Message,
+			StatusCode: code,
+		}, nil
+	}
+}
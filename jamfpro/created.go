@@ -0,0 +1,49 @@
+package jamfpro
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const createdLookupAttempts = 5
+
+// getCreated fetches the object a Create request made, given the ID in the response to it, so that Create returns
+// the object as Jamf Pro stores it. A new object can take a moment to replicate, so the lookup is retried with
+// backoff while it is not found. resp is the response to the Create request, returned if id is zero.
+func getCreated[T any](ctx context.Context, resp *Response, id ID, get func(context.Context, int) (*T, *Response, error)) (*T, *Response, error) {
+	return getUpdated(ctx, resp, id, get, nil)
+}
+
+// getUpdated fetches the object an Update request changed, given the ID in the response to it, so that Update returns
+// the object as Jamf Pro stores it. The lookup is retried with backoff while the object is not found, or while
+// settled, if given, reports that it does not show the change yet; the last lookup is returned either way. resp is
+// the response to the Update request, returned if id is zero.
+func getUpdated[T any](ctx context.Context, resp *Response, id ID, get func(context.Context, int) (*T, *Response, error), settled func(*T) bool) (*T, *Response, error) {
+	if id.IsZero() {
+		return nil, resp, ErrMissingID
+	}
+
+	interval := time.Second
+	for retry := 0; ; retry++ {
+		lookupCtx := ctx
+		if retry > 0 {
+			lookupCtx = withRetryCount(ctx, retry)
+		}
+		object, resp, err := get(lookupCtx, id.Int())
+		if retry == createdLookupAttempts-1 {
+			return object, resp, err
+		} else if errors.Is(err, ErrNotFound) {
+			// The object has not replicated to the node answering yet
+		} else if err != nil || settled == nil || settled(object) {
+			return object, resp, err
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return nil, resp, ctx.Err()
+		}
+		interval *= 2
+	}
+}
@@ -0,0 +1,198 @@
+package jamfpro
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SearchType is the operator a smart group or advanced search criterion applies to its value.
+type SearchType string
+
+const (
+	SearchTypeIs                 SearchType = "is"
+	SearchTypeIsNot              SearchType = "is not"
+	SearchTypeLike               SearchType = "like"
+	SearchTypeNotLike            SearchType = "not like"
+	SearchTypeHas                SearchType = "has"
+	SearchTypeDoesNotHave        SearchType = "does not have"
+	SearchTypeMoreThan           SearchType = "more than"
+	SearchTypeLessThan           SearchType = "less than"
+	SearchTypeGreaterThan        SearchType = "greater than"
+	SearchTypeGreaterThanOrEqual SearchType = "greater than or equal"
+	SearchTypeLessThanOrEqual    SearchType = "less than or equal"
+	SearchTypeBeforeDate         SearchType = "before (yyyy-mm-dd)"
+	SearchTypeAfterDate          SearchType = "after (yyyy-mm-dd)"
+	SearchTypeMoreThanXDaysAgo   SearchType = "more than x days ago"
+	SearchTypeLessThanXDaysAgo   SearchType = "less than x days ago"
+	SearchTypeMemberOf           SearchType = "member of"
+	SearchTypeNotMemberOf        SearchType = "not member of"
+	SearchTypeCurrent            SearchType = "current"
+	SearchTypeNotCurrent         SearchType = "not current"
+	SearchTypeMatchesRegex       SearchType = "matches regex"
+	SearchTypeDoesNotMatchRegex  SearchType = "does not match regex"
+)
+
+var searchTypes = []SearchType{
+	SearchTypeIs, SearchTypeIsNot, SearchTypeLike, SearchTypeNotLike, SearchTypeHas, SearchTypeDoesNotHave,
+	SearchTypeMoreThan, SearchTypeLessThan, SearchTypeGreaterThan, SearchTypeGreaterThanOrEqual,
+	SearchTypeLessThanOrEqual, SearchTypeBeforeDate, SearchTypeAfterDate, SearchTypeMoreThanXDaysAgo,
+	SearchTypeLessThanXDaysAgo, SearchTypeMemberOf, SearchTypeNotMemberOf, SearchTypeCurrent,
+	SearchTypeNotCurrent, SearchTypeMatchesRegex, SearchTypeDoesNotMatchRegex,
+}
+
+// Valid reports whether Jamf Pro accepts the search type.
+func (s SearchType) Valid() bool {
+	return isKnown(s, searchTypes)
+}
+
+// UnmarshalText reads a search type, ignoring case.
+func (s *SearchType) UnmarshalText(text []byte) error {
+	*s = unmarshalEnum(text, searchTypes)
+	return nil
+}
+
+// AndOr is the operator joining a smart group or advanced search criterion to the ones before it.
+type AndOr string
+
+const (
+	CriterionAnd AndOr = "and"
+	CriterionOr  AndOr = "or"
+)
+
+// Valid reports whether Jamf Pro accepts the operator.
+func (a AndOr) Valid() bool {
+	return a == CriterionAnd || a == CriterionOr
+}
+
+// UnmarshalText reads an operator, ignoring case.
+func (a *AndOr) UnmarshalText(text []byte) error {
+	*a = unmarshalEnum(text, []AndOr{CriterionAnd, CriterionOr})
+	return nil
+}
+
+// CriteriaBuilder assembles the criteria of a smart group or advanced search, numbering priorities and tracking
+// parentheses so the result can be validated before it is sent:
+//
+//	criteria, err := jamfpro.NewCriteriaBuilder().
+//		Where("Operating System Version", jamfpro.SearchTypeLike, "14.").
+//		And("Computer Group", jamfpro.SearchTypeNotMemberOf, "Lab Machines").
+//		OpenParen().
+//		Or("Department", jamfpro.SearchTypeIs, "Design").
+//		Or("Department", jamfpro.SearchTypeIs, "Marketing").
+//		CloseParen().
+//		Build()
+type CriteriaBuilder struct {
+	criteria  []ComputerGroupCriteria
+	openParen bool
+	err       error
+}
+
+// NewCriteriaBuilder returns an empty CriteriaBuilder.
+func NewCriteriaBuilder() *CriteriaBuilder {
+	return &CriteriaBuilder{}
+}
+
+// Where adds the first criterion. It is equivalent to And, as Jamf Pro ignores the operator of the first criterion.
+func (b *CriteriaBuilder) Where(name string, searchType SearchType, value string) *CriteriaBuilder {
+	return b.add(CriterionAnd, name, searchType, value)
+}
+
+// And adds a criterion that must match as well as the preceding ones.
+func (b *CriteriaBuilder) And(name string, searchType SearchType, value string) *CriteriaBuilder {
+	return b.add(CriterionAnd, name, searchType, value)
+}
+
+// Or adds a criterion that may match instead of the preceding ones.
+func (b *CriteriaBuilder) Or(name string, searchType SearchType, value string) *CriteriaBuilder {
+	return b.add(CriterionOr, name, searchType, value)
+}
+
+// OpenParen opens a parenthesis before the next criterion.
+func (b *CriteriaBuilder) OpenParen() *CriteriaBuilder {
+	if b.openParen && b.err == nil {
+		b.err = NewArgError("Criteria", "OpenParen was called twice without a criterion in between")
+	}
+	b.openParen = true
+	return b
+}
+
+// CloseParen closes a parenthesis after the last criterion added.
+func (b *CriteriaBuilder) CloseParen() *CriteriaBuilder {
+	if len(b.criteria) == 0 || b.openParen {
+		if b.err == nil {
+			b.err = NewArgError("Criteria", "CloseParen must follow a criterion")
+		}
+		return b
+	}
+	b.criteria[len(b.criteria)-1].ClosingParen = true
+	return b
+}
+
+// Build returns the criteria, or an error if they would be rejected by Jamf Pro or have unbalanced parentheses.
+func (b *CriteriaBuilder) Build() ([]ComputerGroupCriteria, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if b.openParen {
+		return nil, NewArgError("Criteria", "OpenParen is not followed by a criterion")
+	}
+	if err := ValidateCriteria(b.criteria); err != nil {
+		return nil, err
+	}
+
+	criteria := make([]ComputerGroupCriteria, len(b.criteria))
+	copy(criteria, b.criteria)
+	return criteria, nil
+}
+
+func (b *CriteriaBuilder) add(andOr AndOr, name string, searchType SearchType, value string) *CriteriaBuilder {
+	b.criteria = append(b.criteria, ComputerGroupCriteria{
+		Name:         name,
+		Priority:     len(b.criteria),
+		AndOr:        andOr,
+		SearchType:   searchType,
+		Value:        value,
+		OpeningParen: b.openParen,
+	})
+	b.openParen = false
+	return b
+}
+
+// ValidateCriteria checks smart group or advanced search criteria for mistakes Jamf Pro would reject or silently
+// misinterpret: missing names, unknown search types or operators, duplicate priorities and unbalanced parentheses.
+// Criteria are evaluated in priority order, as Jamf Pro does.
+func ValidateCriteria(criteria []ComputerGroupCriteria) error {
+	ordered := make([]ComputerGroupCriteria, len(criteria))
+	copy(ordered, criteria)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Priority < ordered[j].Priority })
+
+	depth := 0
+	for i, criterion := range ordered {
+		label := fmt.Sprintf("criterion %d (%s)", criterion.Priority, criterion.Name)
+		if criterion.Name == "" {
+			return NewArgError("Criteria", fmt.Sprintf("criterion %d has no name", criterion.Priority))
+		} else if !SearchType(criterion.SearchType).Valid() {
+			return NewArgError("Criteria", fmt.Sprintf("%s has unknown search type %q", label, criterion.SearchType))
+		} else if criterion.AndOr != CriterionAnd && criterion.AndOr != CriterionOr {
+			return NewArgError("Criteria", fmt.Sprintf("%s has unknown operator %q, must be \"and\" or \"or\"", label, criterion.AndOr))
+		} else if criterion.Priority < 0 {
+			return NewArgError("Criteria", fmt.Sprintf("%s has a negative priority", label))
+		} else if i > 0 && ordered[i-1].Priority == criterion.Priority {
+			return NewArgError("Criteria", fmt.Sprintf("priority %d is used more than once", criterion.Priority))
+		}
+
+		if criterion.OpeningParen {
+			depth++
+		}
+		if criterion.ClosingParen {
+			depth--
+		}
+		if depth < 0 {
+			return NewArgError("Criteria", fmt.Sprintf("%s closes a parenthesis that was never opened", label))
+		}
+	}
+	if depth > 0 {
+		return NewArgError("Criteria", fmt.Sprintf("%d opened parentheses are never closed", depth))
+	}
+	return nil
+}
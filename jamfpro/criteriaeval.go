@@ -0,0 +1,504 @@
+package jamfpro
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ComputerCriterionField returns the values of a criterion field of a computer, e.g. its operating system version.
+// Fields that hold several values, such as extension attributes with several values, return them all.
+type ComputerCriterionField func(*ComputerInventory) []string
+
+// ComputerCriterionFields are the criterion fields CriteriaEvaluator understands without CriteriaEvaluatorOptions.Fields,
+// keyed by the name Jamf Pro gives them in smart group criteria. Criteria naming none of them are matched against the
+// extension attribute of the same name. They only see the inventory sections that were fetched: request the sections
+// the criteria need, e.g. with ComputerInventorySectionUserAndLocation for Username.
+var ComputerCriterionFields = map[string]ComputerCriterionField{
+	"Computer Name": generalField(func(g *ComputerInventoryGeneral) string { return g.Name }),
+	"Asset Tag":     generalField(func(g *ComputerInventoryGeneral) string { return g.AssetTag }),
+	"Barcode 1":     generalField(func(g *ComputerInventoryGeneral) string { return g.Barcode1 }),
+	"Barcode 2":     generalField(func(g *ComputerInventoryGeneral) string { return g.Barcode2 }),
+	"IP Address":    generalField(func(g *ComputerInventoryGeneral) string { return g.LastIpAddress }),
+	"Reported IP Address": generalField(func(g *ComputerInventoryGeneral) string {
+		return g.LastReportedIp
+	}),
+	"Jamf Binary Version":   generalField(func(g *ComputerInventoryGeneral) string { return g.JamfBinaryVersion }),
+	"Last Check-in":         generalField(func(g *ComputerInventoryGeneral) string { return g.LastContactTime }),
+	"Last Inventory Update": generalField(func(g *ComputerInventoryGeneral) string { return g.ReportDate }),
+	"Last Enrollment":       generalField(func(g *ComputerInventoryGeneral) string { return g.LastEnrolledDate }),
+	"Site":                  generalField(func(g *ComputerInventoryGeneral) string { return g.Site.Name }),
+	"Supervised":            generalField(func(g *ComputerInventoryGeneral) string { return yesNo(g.Supervised) }),
+	"Managed": generalField(func(g *ComputerInventoryGeneral) string {
+		return managedName(g.RemoteManagement.Managed)
+	}),
+	"User Approved MDM": generalField(func(g *ComputerInventoryGeneral) string { return yesNo(g.UserApprovedMdm) }),
+	"Enrolled via Automated Device Enrollment": generalField(func(g *ComputerInventoryGeneral) string {
+		return yesNo(g.EnrolledViaAutomatedDeviceEnrollment)
+	}),
+
+	"Username":      userField(func(u *ComputerInventoryUserAndLocation) string { return u.Username }),
+	"Full Name":     userField(func(u *ComputerInventoryUserAndLocation) string { return u.Realname }),
+	"Email Address": userField(func(u *ComputerInventoryUserAndLocation) string { return u.Email }),
+	"Position":      userField(func(u *ComputerInventoryUserAndLocation) string { return u.Position }),
+	"Phone Number":  userField(func(u *ComputerInventoryUserAndLocation) string { return u.Phone }),
+	"Room":          userField(func(u *ComputerInventoryUserAndLocation) string { return u.Room }),
+
+	"Serial Number":     hardwareField(func(h *ComputerInventoryHardware) string { return h.SerialNumber }),
+	"Model":             hardwareField(func(h *ComputerInventoryHardware) string { return h.Model }),
+	"Model Identifier":  hardwareField(func(h *ComputerInventoryHardware) string { return h.ModelIdentifier }),
+	"Processor Type":    hardwareField(func(h *ComputerInventoryHardware) string { return h.ProcessorType }),
+	"Architecture Type": hardwareField(func(h *ComputerInventoryHardware) string { return h.ProcessorArchitecture }),
+	"MAC Address":       hardwareField(func(h *ComputerInventoryHardware) string { return h.MacAddress }),
+	"Total RAM MB":      hardwareField(func(h *ComputerInventoryHardware) string { return strconv.FormatInt(h.TotalRamMegabytes, 10) }),
+	"Number of Cores":   hardwareField(func(h *ComputerInventoryHardware) string { return strconv.Itoa(h.CoreCount) }),
+	"Apple Silicon":     hardwareField(func(h *ComputerInventoryHardware) string { return yesNo(h.AppleSilicon) }),
+	"Operating System":  osField(func(o *ComputerInventoryOperatingSystem) string { return o.Name }),
+	"Operating System Version": osField(func(o *ComputerInventoryOperatingSystem) string {
+		return o.Version
+	}),
+	"Operating System Build": osField(func(o *ComputerInventoryOperatingSystem) string { return o.Build }),
+	"FileVault 2 Status":     osField(func(o *ComputerInventoryOperatingSystem) string { return o.FileVault2Status }),
+}
+
+func generalField(value func(*ComputerInventoryGeneral) string) ComputerCriterionField {
+	return func(c *ComputerInventory) []string {
+		if c.General == nil {
+			return nil
+		}
+		return []string{value(c.General)}
+	}
+}
+
+func userField(value func(*ComputerInventoryUserAndLocation) string) ComputerCriterionField {
+	return func(c *ComputerInventory) []string {
+		if c.UserAndLocation == nil {
+			return nil
+		}
+		return []string{value(c.UserAndLocation)}
+	}
+}
+
+func hardwareField(value func(*ComputerInventoryHardware) string) ComputerCriterionField {
+	return func(c *ComputerInventory) []string {
+		if c.Hardware == nil {
+			return nil
+		}
+		return []string{value(c.Hardware)}
+	}
+}
+
+func osField(value func(*ComputerInventoryOperatingSystem) string) ComputerCriterionField {
+	return func(c *ComputerInventory) []string {
+		if c.OperatingSystem == nil {
+			return nil
+		}
+		return []string{value(c.OperatingSystem)}
+	}
+}
+
+// yesNo formats a flag the way Jamf Pro shows it in smart group criteria
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+func managedName(managed bool) string {
+	if managed {
+		return "Managed"
+	}
+	return "Unmanaged"
+}
+
+// CriteriaEvaluatorOptions adds to what a CriteriaEvaluator knows about computers.
+type CriteriaEvaluatorOptions struct {
+	// Fields adds criterion fields to, or replaces fields of, ComputerCriterionFields.
+	Fields map[string]ComputerCriterionField
+
+	// Groups are the IDs of the computers in each computer group, by group name, for the "member of" and "not member
+	// of" search types.
+	Groups map[string][]ID
+
+	// Buildings and Departments are the names of buildings and departments by ID, for the Building and Department
+	// criteria, as computer inventory only holds their IDs.
+	Buildings   map[ID]string
+	Departments map[ID]string
+
+	// Now is the time the "x days ago" search types count from. It defaults to when NewCriteriaEvaluator is called.
+	Now time.Time
+}
+
+// CriteriaEvaluator matches smart group criteria against computer inventory records without Jamf Pro, to preview
+// which computers a smart group would contain before it is created. It follows Jamf Pro's semantics: comparisons
+// ignore case, "like" matches substrings, "and" binds more tightly than "or", and a criterion on a field with several
+// values matches if any value does, or for the negated search types, if none does.
+//
+// The "current" and "not current" search types depend on Apple's current releases, and are not supported.
+type CriteriaEvaluator struct {
+	criteria []compiledCriterion
+	combine  func([]bool) bool
+}
+
+type compiledCriterion struct {
+	ComputerGroupCriteria
+	field   ComputerCriterionField
+	match   func(string) (bool, error)
+	negated bool
+}
+
+// NewCriteriaEvaluator validates criteria with ValidateCriteria and prepares them for evaluation. It fails for
+// criteria it could not evaluate faithfully, such as unknown groups or invalid regular expressions.
+func NewCriteriaEvaluator(criteria []ComputerGroupCriteria, opts *CriteriaEvaluatorOptions) (*CriteriaEvaluator, error) {
+	if err := ValidateCriteria(criteria); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &CriteriaEvaluatorOptions{}
+	}
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	ordered := make([]ComputerGroupCriteria, len(criteria))
+	copy(ordered, criteria)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Priority < ordered[j].Priority })
+
+	e := &CriteriaEvaluator{}
+	for _, criterion := range ordered {
+		compiled, err := compileCriterion(criterion, opts, now)
+		if err != nil {
+			return nil, err
+		}
+		e.criteria = append(e.criteria, compiled)
+	}
+
+	p := &criteriaParser{criteria: ordered}
+	combine := p.expression()
+	if p.pos != len(ordered) {
+		return nil, NewArgError("Criteria", "the parentheses do not match up")
+	}
+	e.combine = combine
+	return e, nil
+}
+
+// Matches reports whether computer matches the criteria.
+func (e *CriteriaEvaluator) Matches(computer *ComputerInventory) (bool, error) {
+	if computer == nil {
+		return false, NewArgError("computer", "cannot be nil")
+	}
+	if len(e.criteria) == 0 {
+		// A smart group without criteria contains every computer.
+		return true, nil
+	}
+
+	results := make([]bool, len(e.criteria))
+	for i, criterion := range e.criteria {
+		matched, err := criterion.evaluate(computer)
+		if err != nil {
+			return false, fmt.Errorf("jamfpro: computer %s: %w", computer.Id, err)
+		}
+		results[i] = matched
+	}
+	return e.combine(results), nil
+}
+
+// Filter returns the computers that match the criteria, in order.
+func (e *CriteriaEvaluator) Filter(computers []ComputerInventory) ([]ComputerInventory, error) {
+	var matched []ComputerInventory
+	for i := range computers {
+		ok, err := e.Matches(&computers[i])
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, computers[i])
+		}
+	}
+	return matched, nil
+}
+
+func (c compiledCriterion) evaluate(computer *ComputerInventory) (bool, error) {
+	values := c.field(computer)
+	if len(values) == 0 {
+		// A field with no value compares as empty, as it does in Jamf Pro.
+		values = []string{""}
+	}
+
+	for _, value := range values {
+		matched, err := c.match(value)
+		if err != nil {
+			return false, fmt.Errorf("criterion %d (%s): %w", c.Priority, c.Name, err)
+		}
+		if matched {
+			return !c.negated, nil
+		}
+	}
+	return c.negated, nil
+}
+
+// compileCriterion looks up the field of criterion and builds the test its search type applies to each value. The
+// negated search types are built as their positive counterpart, and inverted over all values.
+func compileCriterion(criterion ComputerGroupCriteria, opts *CriteriaEvaluatorOptions, now time.Time) (compiledCriterion, error) {
+	compiled := compiledCriterion{ComputerGroupCriteria: criterion}
+	label := fmt.Sprintf("criterion %d (%s)", criterion.Priority, criterion.Name)
+	want := criterion.Value
+
+	compiled.field = criterionField(criterion.Name, opts)
+
+	switch SearchType(criterion.SearchType) {
+	case SearchTypeIsNot, SearchTypeNotLike, SearchTypeDoesNotHave, SearchTypeNotMemberOf, SearchTypeDoesNotMatchRegex:
+		compiled.negated = true
+	}
+
+	switch SearchType(criterion.SearchType) {
+	case SearchTypeIs, SearchTypeIsNot, SearchTypeHas, SearchTypeDoesNotHave:
+		compiled.match = func(v string) (bool, error) { return strings.EqualFold(v, want), nil }
+	case SearchTypeLike, SearchTypeNotLike:
+		lower := strings.ToLower(want)
+		compiled.match = func(v string) (bool, error) { return strings.Contains(strings.ToLower(v), lower), nil }
+	case SearchTypeMatchesRegex, SearchTypeDoesNotMatchRegex:
+		re, err := regexp.Compile("(?i)" + want)
+		if err != nil {
+			return compiled, NewArgError("Criteria", fmt.Sprintf("%s has an invalid regular expression: %v", label, err))
+		}
+		compiled.match = func(v string) (bool, error) { return re.MatchString(v), nil }
+	case SearchTypeMoreThan, SearchTypeGreaterThan:
+		compiled.match = comparison(want, func(c int) bool { return c > 0 })
+	case SearchTypeLessThan:
+		compiled.match = comparison(want, func(c int) bool { return c < 0 })
+	case SearchTypeGreaterThanOrEqual:
+		compiled.match = comparison(want, func(c int) bool { return c >= 0 })
+	case SearchTypeLessThanOrEqual:
+		compiled.match = comparison(want, func(c int) bool { return c <= 0 })
+	case SearchTypeBeforeDate, SearchTypeAfterDate:
+		day, err := time.Parse("2006-01-02", want)
+		if err != nil {
+			return compiled, NewArgError("Criteria", fmt.Sprintf("%s needs a yyyy-mm-dd date, not %q", label, want))
+		}
+		before := SearchType(criterion.SearchType) == SearchTypeBeforeDate
+		compiled.match = dateComparison(func(t time.Time) bool {
+			if before {
+				return t.Before(day)
+			}
+			return !t.Before(day.AddDate(0, 0, 1))
+		})
+	case SearchTypeMoreThanXDaysAgo, SearchTypeLessThanXDaysAgo:
+		days, err := strconv.Atoi(strings.TrimSpace(want))
+		if err != nil || days < 0 {
+			return compiled, NewArgError("Criteria", fmt.Sprintf("%s needs a number of days, not %q", label, want))
+		}
+		cutoff := now.AddDate(0, 0, -days)
+		moreThan := SearchType(criterion.SearchType) == SearchTypeMoreThanXDaysAgo
+		compiled.match = dateComparison(func(t time.Time) bool {
+			if moreThan {
+				return t.Before(cutoff)
+			}
+			return t.After(cutoff)
+		})
+	case SearchTypeMemberOf, SearchTypeNotMemberOf:
+		members, ok := opts.Groups[want]
+		if !ok {
+			return compiled, NewArgError("Criteria", fmt.Sprintf("%s refers to group %q, which is not in Groups", label, want))
+		}
+		set := make(map[ID]bool, len(members))
+		for _, id := range members {
+			set[id] = true
+		}
+		compiled.field = func(c *ComputerInventory) []string { return []string{c.Id.String()} }
+		compiled.match = func(v string) (bool, error) { return set[ID(v)], nil }
+	default:
+		return compiled, NewArgError("Criteria", fmt.Sprintf("%s uses search type %q, which cannot be evaluated offline", label, criterion.SearchType))
+	}
+
+	return compiled, nil
+}
+
+// criterionField returns the field a criterion called name refers to: one from opts.Fields or ComputerCriterionFields,
+// Building or Department resolved through opts, or otherwise the extension attribute called name.
+func criterionField(name string, opts *CriteriaEvaluatorOptions) ComputerCriterionField {
+	if field, ok := opts.Fields[name]; ok {
+		return field
+	}
+	if field, ok := ComputerCriterionFields[name]; ok {
+		return field
+	}
+
+	switch name {
+	case "Building":
+		return userField(func(u *ComputerInventoryUserAndLocation) string { return opts.Buildings[u.BuildingId] })
+	case "Department":
+		return userField(func(u *ComputerInventoryUserAndLocation) string { return opts.Departments[u.DepartmentId] })
+	}
+
+	return func(c *ComputerInventory) []string {
+		for _, attributes := range computerExtensionAttributes(c) {
+			for _, attribute := range attributes {
+				if attribute.Name == name {
+					return attribute.Values
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// computerExtensionAttributes returns the extension attributes of every section of c, which Jamf Pro files under the
+// section their inventory display setting names.
+func computerExtensionAttributes(c *ComputerInventory) [][]ComputerInventoryExtensionAttribute {
+	attributes := [][]ComputerInventoryExtensionAttribute{c.ExtensionAttributes}
+	if c.General != nil {
+		attributes = append(attributes, c.General.ExtensionAttributes)
+	}
+	if c.Purchasing != nil {
+		attributes = append(attributes, c.Purchasing.ExtensionAttributes)
+	}
+	if c.UserAndLocation != nil {
+		attributes = append(attributes, c.UserAndLocation.ExtensionAttributes)
+	}
+	if c.Hardware != nil {
+		attributes = append(attributes, c.Hardware.ExtensionAttributes)
+	}
+	if c.OperatingSystem != nil {
+		attributes = append(attributes, c.OperatingSystem.ExtensionAttributes)
+	}
+	return attributes
+}
+
+// comparison builds the test of an ordering search type. Values are compared as dotted versions, so that 14.10 is more
+// than 14.2 and plain numbers compare numerically. Empty values never match.
+func comparison(want string, accept func(int) bool) func(string) (bool, error) {
+	return func(v string) (bool, error) {
+		if strings.TrimSpace(v) == "" {
+			return false, nil
+		}
+		return accept(compareVersions(v, want)), nil
+	}
+}
+
+// compareVersions compares versions such as 14.2.1 component by component, numerically where both components are
+// integers. Missing components count as zero, so 14.2 equals 14.2.0.
+func compareVersions(a, b string) int {
+	partsA := strings.Split(strings.TrimSpace(a), ".")
+	partsB := strings.Split(strings.TrimSpace(b), ".")
+	for i := 0; i < len(partsA) || i < len(partsB); i++ {
+		pa, pb := "0", "0"
+		if i < len(partsA) {
+			pa = partsA[i]
+		}
+		if i < len(partsB) {
+			pb = partsB[i]
+		}
+
+		na, errA := strconv.Atoi(pa)
+		nb, errB := strconv.Atoi(pb)
+		if errA == nil && errB == nil {
+			if na != nb {
+				if na < nb {
+					return -1
+				}
+				return 1
+			}
+			continue
+		}
+		if c := strings.Compare(strings.ToLower(pa), strings.ToLower(pb)); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// dateComparison builds the test of a date search type. Values that are not dates, including empty ones, never match.
+func dateComparison(accept func(time.Time) bool) func(string) (bool, error) {
+	return func(v string) (bool, error) {
+		t, ok := parseInventoryDate(v)
+		if !ok {
+			return false, nil
+		}
+		return accept(t), nil
+	}
+}
+
+// parseInventoryDate parses the date formats Jamf Pro reports inventory dates in.
+func parseInventoryDate(v string) (time.Time, bool) {
+	v = strings.TrimSpace(v)
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// criteriaParser combines the results of criteria, in priority order, the way Jamf Pro does: parentheses first, then
+// "and", then "or".
+type criteriaParser struct {
+	criteria []ComputerGroupCriteria
+	pos      int
+}
+
+// expression parses terms joined by "or".
+func (p *criteriaParser) expression() func([]bool) bool {
+	terms := []func([]bool) bool{p.term()}
+	for p.pos < len(p.criteria) && p.criteria[p.pos].AndOr == CriterionOr && !p.closedBefore() {
+		terms = append(terms, p.term())
+	}
+	return func(results []bool) bool {
+		for _, term := range terms {
+			if term(results) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// term parses factors joined by "and".
+func (p *criteriaParser) term() func([]bool) bool {
+	factors := []func([]bool) bool{p.factor()}
+	for p.pos < len(p.criteria) && p.criteria[p.pos].AndOr == CriterionAnd && !p.closedBefore() {
+		factors = append(factors, p.factor())
+	}
+	return func(results []bool) bool {
+		for _, factor := range factors {
+			if !factor(results) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// factor parses a criterion, or a parenthesised expression starting at it.
+func (p *criteriaParser) factor() func([]bool) bool {
+	criterion := &p.criteria[p.pos]
+	if criterion.OpeningParen {
+		// Consume the parenthesis so the criterion is parsed as the start of the inner expression.
+		criterion.OpeningParen = false
+		inner := p.expression()
+		p.closeParen()
+		return inner
+	}
+
+	i := p.pos
+	p.pos++
+	return func(results []bool) bool { return results[i] }
+}
+
+// closedBefore reports whether the criterion before the current one closed a parenthesis that has not been matched
+// up with its opening one yet, which ends the expression inside it.
+func (p *criteriaParser) closedBefore() bool {
+	return p.pos > 0 && p.criteria[p.pos-1].ClosingParen
+}
+
+// closeParen matches up the closing parenthesis ending an inner expression.
+func (p *criteriaParser) closeParen() {
+	if p.pos > 0 {
+		p.criteria[p.pos-1].ClosingParen = false
+	}
+}
@@ -0,0 +1,117 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+const declarativeManagementBasePath = "uapi/v1/ddm"
+
+// Keys of commonly used declarative device management status items
+const (
+	DDMStatusDeclarationsActivations      = "management.declarations.activations"
+	DDMStatusDeclarationsConfigurations   = "management.declarations.configurations"
+	DDMStatusDeclarationsAssets           = "management.declarations.assets"
+	DDMStatusDeclarationsManagement       = "management.declarations.management"
+	DDMStatusClientCapabilities           = "management.client-capabilities"
+	DDMStatusOperatingSystemVersion       = "device.operating-system.version"
+	DDMStatusOperatingSystemBuild         = "device.operating-system.build-version"
+	DDMStatusSoftwareUpdateInstallState   = "softwareupdate.install-state"
+	DDMStatusSoftwareUpdatePendingVersion = "softwareupdate.pending-version"
+	DDMStatusPasscodeCompliant            = "passcode.is-compliant"
+	DDMStatusPasscodePresent              = "passcode.is-present"
+)
+
+// DeclarativeManagementService reads the status that devices report through declarative device management. Devices
+// are identified by their management ID, which is reported in the General section of computer and mobile device
+// inventory.
+type DeclarativeManagementService interface {
+	ListStatusItems(context.Context, string) ([]DDMStatusItem, *Response, error)
+	GetStatusItem(context.Context, string, string) (*DDMStatusItem, *Response, error)
+	ListDeclarations(context.Context, string) ([]DDMStatusItem, *Response, error)
+}
+
+// DeclarativeManagementServiceOp handles communication with the declarative device management related
+// methods of the Jamf Pro API.
+type DeclarativeManagementServiceOp struct {
+	client *Client
+}
+
+var _ DeclarativeManagementService = &DeclarativeManagementServiceOp{}
+
+// DDMStatusItem is a single value of the status a device reports, such as its operating system version or the state
+// of a declaration
+type DDMStatusItem struct {
+	Key            string `json:"key"`
+	Value          string `json:"value"`
+	LastUpdateTime string `json:"lastUpdateTime"`
+}
+
+// DDMStatusItemListResponse represents the raw API response to listing the status items of a device
+type DDMStatusItemListResponse struct {
+	StatusItems []DDMStatusItem `json:"statusItems"`
+}
+
+// ListStatusItems returns every status item the device with the given management ID has reported.
+func (d *DeclarativeManagementServiceOp) ListStatusItems(ctx context.Context, managementId string) ([]DDMStatusItem, *Response, error) {
+	if managementId == "" {
+		return nil, nil, NewArgError("managementId", "cannot be empty")
+	}
+
+	req, err := d.client.NewRequest(ctx, http.MethodGet, pathJoin(declarativeManagementBasePath, managementId, "status-items"), nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var statusResponse DDMStatusItemListResponse
+	resp, err := d.client.Do(ctx, req, &statusResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return statusResponse.StatusItems, resp, err
+}
+
+// GetStatusItem returns the status item with the given key, such as DDMStatusOperatingSystemVersion, reported by the
+// device with the given management ID.
+func (d *DeclarativeManagementServiceOp) GetStatusItem(ctx context.Context, managementId, key string) (*DDMStatusItem, *Response, error) {
+	if managementId == "" {
+		return nil, nil, NewArgError("managementId", "cannot be empty")
+	} else if key == "" {
+		return nil, nil, NewArgError("key", "cannot be empty")
+	}
+
+	path := pathJoin(declarativeManagementBasePath, managementId, "status-items", key)
+
+	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var statusItem DDMStatusItem
+	resp, err := d.client.Do(ctx, req, &statusItem)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &statusItem, resp, err
+}
+
+// ListDeclarations returns the status items describing the declarations on the device with the given management ID,
+// those whose keys start with "management.declarations.".
+func (d *DeclarativeManagementServiceOp) ListDeclarations(ctx context.Context, managementId string) ([]DDMStatusItem, *Response, error) {
+	statusItems, resp, err := d.ListStatusItems(ctx, managementId)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var declarations []DDMStatusItem
+	for _, statusItem := range statusItems {
+		if strings.HasPrefix(statusItem.Key, "management.declarations.") {
+			declarations = append(declarations, statusItem)
+		}
+	}
+
+	return declarations, resp, err
+}
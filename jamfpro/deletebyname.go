@@ -0,0 +1,23 @@
+package jamfpro
+
+import (
+	"context"
+)
+
+// deleteByName deletes the object that find returns for name. find returns either an error matching ErrNotFound or a
+// nil object if there is none, and the error returned then matches ErrNotFound.
+func deleteByName[T any](ctx context.Context, kind, name string, find func(context.Context, string) (*T, *Response, error), id func(*T) ID, del func(context.Context, int) (*Response, error)) (*Response, error) {
+	if name == "" {
+		return nil, NewArgError("name", "cannot be empty")
+	}
+
+	object, resp, err := find(ctx, name)
+	if err != nil {
+		return resp, err
+	}
+	if object == nil || id(object).IsZero() {
+		return resp, notFoundByName(kind, name)
+	}
+
+	return del(ctx, id(object).Int())
+}
@@ -2,6 +2,7 @@ package jamfpro
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"strconv"
 )
@@ -15,6 +16,11 @@ type DepartmentsService interface {
 	Create(context.Context, *DepartmentCreateRequest) (*Department, *Response, error)
 	Update(context.Context, int, *DepartmentUpdateRequest) (*Department, *Response, error)
 	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+	GetOrCreate(context.Context, string) (*Department, *Response, error)
+	ListHistory(context.Context, int, *ListOptions) ([]HistoryEntry, *Response, error)
+	AddHistoryNote(context.Context, int, string) (*HistoryNoteResponse, *Response, error)
+	ExportCSV(context.Context, io.Writer, *ExportOptions) (*Response, error)
 }
 
 // DepartmentsServiceOp handles communication with the categories-related
@@ -27,7 +33,7 @@ var _ DepartmentsService = &DepartmentsServiceOp{}
 
 // Department represents a Jamf Pro Department
 type Department struct {
-	Id   string `json:"id,omitempty"`
+	Id   ID     `json:"id,omitempty"`
 	Name string `json:"name"`
 	Href string `json:"href,omitempty"`
 }
@@ -45,19 +51,19 @@ type DepartmentCreateRequest struct {
 
 // DepartmentCreateResponse represents an API response to creating a department
 type DepartmentCreateResponse struct {
-	Id   string `json:"id"`
+	Id   ID     `json:"id"`
 	Href string `json:"href"`
 }
 
 // DepartmentUpdateRequest represents an API response to creating a department.
 type DepartmentUpdateRequest struct {
-	Id   int    `json:"id,omitempty"`
+	Id   ID     `json:"id,omitempty"`
 	Name string `json:"name"`
 }
 
 // DepartmentUpdateResponse represents an API response to updating a department.
 type DepartmentUpdateResponse struct {
-	Id   string `json:"id"`
+	Id   ID     `json:"id"`
 	Name string `json:"name"`
 }
 
@@ -83,31 +89,22 @@ func (d *DepartmentsServiceOp) GetByID(ctx context.Context, i int) (*Department,
 	return &department, resp, err
 }
 
+// GetByName returns the department called name, looking it up with a filter rather than listing every department.
+// The error matches ErrNotFound if there is none.
 func (d *DepartmentsServiceOp) GetByName(ctx context.Context, name string) (*Department, *Response, error) {
-	departments, _, err := d.list(ctx)
-	var id string
-	if err != nil {
-		return nil, nil, err
-	}
-
-	for i := range departments {
-		if departments[i].Name == name {
-			id = departments[i].Id
-			break
-		}
+	if name == "" {
+		return nil, nil, NewArgError("name", "cannot be empty")
 	}
-	intId, err := strconv.ParseInt(id, 10, 64)
 
-	if err != nil {
-		return nil, nil, err
-	}
-
-	department, resp, err := d.GetByID(ctx, int(intId))
+	department, resp, err := d.findByName(ctx, name)
 	if err != nil {
 		return nil, resp, err
 	}
+	if department == nil || department.Id.IsZero() {
+		return nil, resp, notFoundByName("department", name)
+	}
 
-	return department, resp, err
+	return d.GetByID(ctx, department.Id.Int())
 }
 
 func (d *DepartmentsServiceOp) Create(ctx context.Context, request *DepartmentCreateRequest) (*Department, *Response, error) {
@@ -126,12 +123,7 @@ func (d *DepartmentsServiceOp) Create(ctx context.Context, request *DepartmentCr
 		return nil, resp, err
 	}
 
-	if departmentCreation.Id == "" {
-		return nil, resp, err
-	}
-
-	department := d.createDepartmentFromCreationResponse(*departmentCreation, *request)
-	return &department, resp, err
+	return getCreated(ctx, resp, departmentCreation.Id, d.GetByID)
 }
 
 func (d *DepartmentsServiceOp) Update(ctx context.Context, i int, request *DepartmentUpdateRequest) (*Department, *Response, error) {
@@ -152,8 +144,7 @@ func (d *DepartmentsServiceOp) Update(ctx context.Context, i int, request *Depar
 		return nil, resp, err
 	}
 
-	building := d.createDepartmentFromUpdateResponse(*departmentUpdate, *request)
-	return &building, resp, err
+	return getUpdated(ctx, resp, departmentUpdate.Id, d.GetByID, nil)
 }
 
 func (d *DepartmentsServiceOp) Delete(ctx context.Context, i int) (*Response, error) {
@@ -172,34 +163,84 @@ func (d *DepartmentsServiceOp) Delete(ctx context.Context, i int) (*Response, er
 	return resp, err
 }
 
+// DeleteByName deletes the department called name. The error matches ErrNotFound if there is none.
+func (d *DepartmentsServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "department", name, d.findByName, func(department *Department) ID { return department.Id }, d.Delete)
+}
+
+// list returns every department, fetching all pages.
 func (d *DepartmentsServiceOp) list(ctx context.Context) ([]Department, *Response, error) {
-	path := departmentsBasePath
-	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	return listAll[Department](ctx, d.client, departmentsBasePath)
+}
+
+// GetOrCreate returns the department called name, creating it if it does not exist yet. If another client creates the
+// department at the same time, the resulting conflict is resolved by fetching the department again.
+func (d *DepartmentsServiceOp) GetOrCreate(ctx context.Context, name string) (*Department, *Response, error) {
+	if name == "" {
+		return nil, nil, NewArgError("name", "cannot be empty")
+	}
+
+	existing, resp, err := d.findByName(ctx, name)
+	if err != nil || existing != nil {
+		return existing, resp, err
+	}
+
+	created, resp, err := d.Create(ctx, &DepartmentCreateRequest{Name: name})
+	if err != nil && isConflict(resp) {
+		if existing, findResp, findErr := d.findByName(ctx, name); findErr == nil && existing != nil {
+			return existing, findResp, nil
+		}
+	}
+
+	return created, resp, err
+}
+
+// findByName returns the department called name, or nil if there is none.
+func (d *DepartmentsServiceOp) findByName(ctx context.Context, name string) (*Department, *Response, error) {
+	path, err := addOptions(departmentsBasePath, &ListOptions{Filter: nameFilter(name)})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var departmentResponse DepartmentListResponse
-	resp, err := d.client.Do(ctx, req, &departmentResponse)
+	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
 	if err != nil {
+		return nil, nil, err
+	}
+
+	var listResponse DepartmentListResponse
+	resp, err := d.client.Do(ctx, req, &listResponse)
+	if err != nil || listResponse.Departments == nil {
 		return nil, resp, err
 	}
 
-	return *departmentResponse.Departments, resp, err
+	// RSQL treats * as a wildcard, so the filter can match more than the one name asked for.
+	results := *listResponse.Departments
+	for i := range results {
+		if results[i].Name == name {
+			return &results[i], resp, nil
+		}
+	}
 
+	return nil, resp, nil
 }
 
-func (d *DepartmentsServiceOp) createDepartmentFromCreationResponse(response DepartmentCreateResponse, request DepartmentCreateRequest) Department {
-	department := new(Department)
-	department.Id = response.Id
-	department.Href = response.Href
-	department.Name = request.Name
-	return *department
+// ListHistory returns one page of the change history of the department with the given ID.
+func (d *DepartmentsServiceOp) ListHistory(ctx context.Context, id int, opts *ListOptions) ([]HistoryEntry, *Response, error) {
+	if id == 0 {
+		return nil, nil, NewArgError("department ID", "cannot be 0")
+	}
+	return listHistory(ctx, d.client, departmentsBasePath+"/"+strconv.Itoa(id)+"/history", opts)
+}
+
+// AddHistoryNote adds a note to the change history of the department with the given ID.
+func (d *DepartmentsServiceOp) AddHistoryNote(ctx context.Context, id int, note string) (*HistoryNoteResponse, *Response, error) {
+	if id == 0 {
+		return nil, nil, NewArgError("department ID", "cannot be 0")
+	}
+	return addHistoryNote(ctx, d.client, departmentsBasePath+"/"+strconv.Itoa(id)+"/history", note)
 }
 
-func (d *DepartmentsServiceOp) createDepartmentFromUpdateResponse(response DepartmentUpdateResponse, request DepartmentUpdateRequest) Department {
-	department := new(Department)
-	department.Id = response.Id
-	department.Name = request.Name
-	return *department
+// ExportCSV writes the department records selected by opts to w as CSV.
+func (d *DepartmentsServiceOp) ExportCSV(ctx context.Context, w io.Writer, opts *ExportOptions) (*Response, error) {
+	return exportCSV(ctx, d.client, departmentsBasePath+"/export", w, opts)
 }
@@ -0,0 +1,211 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+const usersBasePath = "JSSResource/users"
+
+// DeviceAssignmentsService assigns devices to users. Jamf Pro links a device to the user object whose name is the
+// username of the device's location; the methods keep the rest of the location in line with that user. Computers are
+// updated with the Classic API and mobile devices with the Jamf Pro API, which take different payloads for this.
+type DeviceAssignmentsService interface {
+	AssignComputer(context.Context, int, DeviceUser) (*Response, error)
+	UnassignComputer(context.Context, int) (*Response, error)
+	AssignMobileDevice(context.Context, int, DeviceUser) (*Response, error)
+	UnassignMobileDevice(context.Context, int) (*Response, error)
+}
+
+// DeviceAssignmentsServiceOp handles communication with the device location related
+// methods of the Jamf Pro API.
+type DeviceAssignmentsServiceOp struct {
+	client *Client
+}
+
+var _ DeviceAssignmentsService = &DeviceAssignmentsServiceOp{}
+
+// DeviceUser is the user a device is assigned to. Only Username is required: the real name, email address, phone
+// number and position are taken from the Jamf Pro user object of that name where they are left empty. Department and
+// Building are names, and are left unchanged on the device if empty.
+type DeviceUser struct {
+	Username     string
+	RealName     string
+	EmailAddress string
+	Position     string
+	PhoneNumber  string
+	Department   string
+	Building     string
+	Room         string
+}
+
+// classicUser is the part of a Classic API user object used to fill in a DeviceUser
+type classicUser struct {
+	XMLName      xml.Name `xml:"user"`
+	Id           ID       `xml:"id"`
+	Name         string   `xml:"name"`
+	FullName     string   `xml:"full_name"`
+	Email        string   `xml:"email"`
+	EmailAddress string   `xml:"email_address"`
+	PhoneNumber  string   `xml:"phone_number"`
+	Position     string   `xml:"position"`
+}
+
+// computerLocationUpdate changes only the location of a computer. The user fields are always sent, so that
+// unassigning clears them.
+type computerLocationUpdate struct {
+	XMLName  xml.Name               `xml:"computer"`
+	Location computerLocationFields `xml:"location"`
+}
+
+type computerLocationFields struct {
+	Username     string `xml:"username"`
+	RealName     string `xml:"real_name"`
+	EmailAddress string `xml:"email_address"`
+	Position     string `xml:"position"`
+	PhoneNumber  string `xml:"phone_number"`
+	Department   string `xml:"department,omitempty"`
+	Building     string `xml:"building,omitempty"`
+	Room         string `xml:"room,omitempty"`
+}
+
+// AssignComputer assigns the computer with the given ID to user.
+func (d *DeviceAssignmentsServiceOp) AssignComputer(ctx context.Context, id int, user DeviceUser) (*Response, error) {
+	if user.Username == "" {
+		return nil, NewArgError("Username", "cannot be empty")
+	}
+
+	user, resp, err := d.complete(ctx, user)
+	if err != nil {
+		return resp, err
+	}
+
+	return d.updateComputerLocation(ctx, id, computerLocationFields{
+		Username:     user.Username,
+		RealName:     user.RealName,
+		EmailAddress: user.EmailAddress,
+		Position:     user.Position,
+		PhoneNumber:  user.PhoneNumber,
+		Department:   user.Department,
+		Building:     user.Building,
+		Room:         user.Room,
+	})
+}
+
+// UnassignComputer removes the user from the computer with the given ID. Its department, building and room are kept,
+// as they describe where the computer is rather than who uses it.
+func (d *DeviceAssignmentsServiceOp) UnassignComputer(ctx context.Context, id int) (*Response, error) {
+	return d.updateComputerLocation(ctx, id, computerLocationFields{})
+}
+
+// AssignMobileDevice assigns the mobile device with the given ID to user. The department and building are looked up
+// by name, as the Jamf Pro API takes their IDs.
+func (d *DeviceAssignmentsServiceOp) AssignMobileDevice(ctx context.Context, id int, user DeviceUser) (*Response, error) {
+	if user.Username == "" {
+		return nil, NewArgError("Username", "cannot be empty")
+	}
+
+	user, resp, err := d.complete(ctx, user)
+	if err != nil {
+		return resp, err
+	}
+
+	location := &MobileDeviceInventoryLocationUpdate{
+		Username:     String(user.Username),
+		RealName:     String(user.RealName),
+		EmailAddress: String(user.EmailAddress),
+		Position:     String(user.Position),
+		PhoneNumber:  String(user.PhoneNumber),
+	}
+	if user.Room != "" {
+		location.Room = String(user.Room)
+	}
+	if user.Department != "" {
+		department, resp, err := d.client.Departments.GetByName(ctx, user.Department)
+		if err != nil {
+			return resp, err
+		}
+		location.DepartmentId = &department.Id
+	}
+	if user.Building != "" {
+		building, resp, err := d.client.Buildings.GetByName(ctx, user.Building)
+		if err != nil {
+			return resp, err
+		}
+		location.BuildingId = building.Id
+	}
+
+	_, resp, err = d.client.MobileDevicesInventory.Update(ctx, id, &MobileDeviceInventoryUpdateRequest{Location: location})
+	return resp, err
+}
+
+// UnassignMobileDevice removes the user from the mobile device with the given ID, keeping its department, building
+// and room like UnassignComputer.
+func (d *DeviceAssignmentsServiceOp) UnassignMobileDevice(ctx context.Context, id int) (*Response, error) {
+	_, resp, err := d.client.MobileDevicesInventory.Update(ctx, id, &MobileDeviceInventoryUpdateRequest{
+		Location: &MobileDeviceInventoryLocationUpdate{
+			Username:     String(""),
+			RealName:     String(""),
+			EmailAddress: String(""),
+			Position:     String(""),
+			PhoneNumber:  String(""),
+		},
+	})
+	return resp, err
+}
+
+// complete fills in the empty fields of user from the Jamf Pro user object of the same name, if there is one.
+func (d *DeviceAssignmentsServiceOp) complete(ctx context.Context, user DeviceUser) (DeviceUser, *Response, error) {
+	req, err := d.client.NewRequest(ctx, http.MethodGet, pathJoin(usersBasePath, "name", user.Username), nil, "application/xml")
+	if err != nil {
+		return user, nil, err
+	}
+
+	var found classicUser
+	resp, err := d.client.Do(ctx, req, &found)
+	if errors.Is(err, ErrNotFound) {
+		return user, resp, nil
+	} else if err != nil {
+		return user, resp, err
+	}
+
+	email := found.EmailAddress
+	if email == "" {
+		email = found.Email
+	}
+	for _, field := range []struct {
+		value *string
+		from  string
+	}{
+		{&user.RealName, found.FullName},
+		{&user.EmailAddress, email},
+		{&user.PhoneNumber, found.PhoneNumber},
+		{&user.Position, found.Position},
+	} {
+		if *field.value == "" {
+			*field.value = field.from
+		}
+	}
+	return user, resp, nil
+}
+
+func (d *DeviceAssignmentsServiceOp) updateComputerLocation(ctx context.Context, id int, location computerLocationFields) (*Response, error) {
+	if id == 0 {
+		return nil, NewArgError("computer ID", "cannot be 0")
+	}
+
+	path := computersBasePath + "/id/" + strconv.Itoa(id)
+	req, err := d.client.NewRequest(ctx, http.MethodPut, path, &computerLocationUpdate{Location: location}, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := d.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+	return resp, nil
+}
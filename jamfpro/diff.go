@@ -0,0 +1,202 @@
+package jamfpro
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// FieldChange is a field whose actual value differs from the planned one. Path names the field the way it is
+// accessed from Go, e.g. "Criteria[2].Value" or "General.Site.Name".
+type FieldChange struct {
+	Path    string
+	Planned interface{}
+	Actual  interface{}
+}
+
+func (c FieldChange) String() string {
+	return fmt.Sprintf("%s: %v -> %v", c.Path, c.Planned, c.Actual)
+}
+
+// Changes is the result of a Diff. It is empty when the actual object matches the planned one.
+type Changes []FieldChange
+
+// Has reports whether the field at path, or any field below it, changed.
+func (c Changes) Has(path string) bool {
+	for _, change := range c {
+		if change.Path == path || strings.HasPrefix(change.Path, path+".") || strings.HasPrefix(change.Path, path+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+func (c Changes) String() string {
+	lines := make([]string, len(c))
+	for i, change := range c {
+		lines[i] = change.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Diff compares a planned object with the one Jamf Pro holds and returns every field that differs. planned and
+// actual must be of the same type, and are usually pointers to one of the resource structs of this package.
+//
+// Fields that Jamf Pro assigns rather than the caller are only compared when the planned object sets them: an Id
+// that is zero in planned, Href links, and XMLName are ignored, so a planned object built before creation can be
+// compared with the created one. Slice elements are compared by position; missing or extra elements are reported
+// with a nil Actual or Planned value.
+func Diff(planned, actual interface{}) Changes {
+	var changes Changes
+	diffValues("", reflect.ValueOf(planned), reflect.ValueOf(actual), &changes)
+	return changes
+}
+
+// DiffComputerGroups compares a planned computer group with the one Jamf Pro holds.
+func DiffComputerGroups(planned, actual *ComputerGroup) Changes {
+	return Diff(planned, actual)
+}
+
+// DiffComputers compares a planned computer record with the one Jamf Pro holds.
+func DiffComputers(planned, actual *Computer) Changes {
+	return Diff(planned, actual)
+}
+
+// DiffBuildings compares a planned building with the one Jamf Pro holds.
+func DiffBuildings(planned, actual *Building) Changes {
+	return Diff(planned, actual)
+}
+
+// DiffCategories compares a planned category with the one Jamf Pro holds.
+func DiffCategories(planned, actual *Category) Changes {
+	return Diff(planned, actual)
+}
+
+// DiffDepartments compares a planned department with the one Jamf Pro holds.
+func DiffDepartments(planned, actual *Department) Changes {
+	return Diff(planned, actual)
+}
+
+func diffValues(path string, planned, actual reflect.Value, changes *Changes) {
+	if !planned.IsValid() || !actual.IsValid() {
+		if planned.IsValid() != actual.IsValid() {
+			*changes = append(*changes, FieldChange{Path: path, Planned: interfaceOf(planned), Actual: interfaceOf(actual)})
+		}
+		return
+	}
+	if planned.Type() != actual.Type() {
+		*changes = append(*changes, FieldChange{Path: path, Planned: interfaceOf(planned), Actual: interfaceOf(actual)})
+		return
+	}
+
+	switch planned.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if planned.IsNil() || actual.IsNil() {
+			if planned.IsNil() != actual.IsNil() {
+				*changes = append(*changes, FieldChange{Path: path, Planned: interfaceOf(planned), Actual: interfaceOf(actual)})
+			}
+			return
+		}
+		diffValues(path, planned.Elem(), actual.Elem(), changes)
+
+	case reflect.Struct:
+		diffStructs(path, planned, actual, changes)
+
+	case reflect.Slice, reflect.Array:
+		n := planned.Len()
+		if actual.Len() > n {
+			n = actual.Len()
+		}
+		for i := 0; i < n; i++ {
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= actual.Len():
+				*changes = append(*changes, FieldChange{Path: elemPath, Planned: planned.Index(i).Interface()})
+			case i >= planned.Len():
+				*changes = append(*changes, FieldChange{Path: elemPath, Actual: actual.Index(i).Interface()})
+			default:
+				diffValues(elemPath, planned.Index(i), actual.Index(i), changes)
+			}
+		}
+
+	case reflect.Map:
+		for _, key := range planned.MapKeys() {
+			keyPath := fmt.Sprintf("%s[%v]", path, key.Interface())
+			if v := actual.MapIndex(key); v.IsValid() {
+				diffValues(keyPath, planned.MapIndex(key), v, changes)
+			} else {
+				*changes = append(*changes, FieldChange{Path: keyPath, Planned: planned.MapIndex(key).Interface()})
+			}
+		}
+		for _, key := range actual.MapKeys() {
+			if !planned.MapIndex(key).IsValid() {
+				keyPath := fmt.Sprintf("%s[%v]", path, key.Interface())
+				*changes = append(*changes, FieldChange{Path: keyPath, Actual: actual.MapIndex(key).Interface()})
+			}
+		}
+
+	default:
+		if !reflect.DeepEqual(planned.Interface(), actual.Interface()) {
+			*changes = append(*changes, FieldChange{Path: path, Planned: planned.Interface(), Actual: actual.Interface()})
+		}
+	}
+}
+
+func diffStructs(path string, planned, actual reflect.Value, changes *Changes) {
+	t := planned.Type()
+
+	exported := 0
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		exported++
+
+		switch {
+		case field.Name == "XMLName", field.Name == "Href":
+			continue
+		case field.Name == "Id" && isZeroId(planned.Field(i)):
+			continue
+		}
+
+		fieldPath := field.Name
+		if path != "" {
+			fieldPath = path + "." + field.Name
+		}
+		diffValues(fieldPath, planned.Field(i), actual.Field(i), changes)
+	}
+
+	// Structs with only unexported fields, such as time.Time, are compared as a whole.
+	if exported == 0 && !reflect.DeepEqual(planned.Interface(), actual.Interface()) {
+		*changes = append(*changes, FieldChange{Path: path, Planned: planned.Interface(), Actual: actual.Interface()})
+	}
+}
+
+func isZeroId(v reflect.Value) bool {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return true
+		}
+		v = v.Elem()
+	}
+	if id, ok := v.Interface().(ID); ok {
+		return id.IsZero()
+	}
+	return v.IsZero()
+}
+
+// interfaceOf returns the value to report for v, dereferencing pointers so changes show values rather than
+// addresses.
+func interfaceOf(v reflect.Value) interface{} {
+	for v.IsValid() && v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Interface()
+}
@@ -0,0 +1,179 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+)
+
+const directoryBindingsBasePath = "JSSResource/directorybindings"
+
+type DirectoryBindingsService interface {
+	List(context.Context) ([]DirectoryBindingListItem, *Response, error)
+	GetByID(context.Context, int) (*DirectoryBinding, *Response, error)
+	GetByName(context.Context, string) (*DirectoryBinding, *Response, error)
+	Create(context.Context, *DirectoryBindingRequest) (*DirectoryBinding, *Response, error)
+	Update(context.Context, int, *DirectoryBindingRequest) (*DirectoryBinding, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+}
+
+// DirectoryBindingsServiceOp handles communication with the directory binding-related
+// methods of the Jamf Pro API.
+type DirectoryBindingsServiceOp struct {
+	client *Client
+}
+
+var _ DirectoryBindingsService = &DirectoryBindingsServiceOp{}
+
+// DirectoryBinding represents a Jamf Pro directory binding, e.g. an Active Directory bind used by policies
+type DirectoryBinding struct {
+	XMLName    xml.Name `xml:"directory_binding"`
+	Id         ID       `xml:"id"`
+	Name       string   `xml:"name"`
+	Priority   int      `xml:"priority"`
+	Domain     string   `xml:"domain"`
+	Username   string   `xml:"username"`
+	ComputerOu string   `xml:"computer_ou"`
+	Type       string   `xml:"type"`
+}
+
+// DirectoryBindingListItem is the summary of a directory binding returned when listing all directory bindings
+type DirectoryBindingListItem struct {
+	Id   ID     `xml:"id"`
+	Name string `xml:"name"`
+}
+
+// DirectoryBindingListResponse represents the raw API response to getting all directory bindings
+type DirectoryBindingListResponse struct {
+	XMLName           xml.Name                   `xml:"directory_bindings"`
+	Size              int                        `xml:"size"`
+	DirectoryBindings []DirectoryBindingListItem `xml:"directory_binding"`
+}
+
+// DirectoryBindingRequest represents a request to create or update a directory binding.
+type DirectoryBindingRequest struct {
+	XMLName    xml.Name `xml:"directory_binding"`
+	Name       string   `xml:"name"`
+	Priority   int      `xml:"priority,omitempty"`
+	Domain     string   `xml:"domain"`
+	Username   string   `xml:"username,omitempty"`
+	Password   string   `xml:"password,omitempty"`
+	ComputerOu string   `xml:"computer_ou,omitempty"`
+	Type       string   `xml:"type"`
+}
+
+type DirectoryBindingResponse struct {
+	Id ID `xml:"id"`
+}
+
+func (d *DirectoryBindingsServiceOp) List(ctx context.Context) ([]DirectoryBindingListItem, *Response, error) {
+	return d.list(ctx)
+}
+
+func (d *DirectoryBindingsServiceOp) GetByID(ctx context.Context, id int) (*DirectoryBinding, *Response, error) {
+	path := directoryBindingsBasePath + "/id/" + strconv.Itoa(id)
+	return d.get(ctx, path)
+}
+
+func (d *DirectoryBindingsServiceOp) GetByName(ctx context.Context, name string) (*DirectoryBinding, *Response, error) {
+	path := pathJoin(directoryBindingsBasePath, "name", name)
+	return d.get(ctx, path)
+}
+
+// Create creates a directory binding in Jamf Pro and returns the record as stored by the server.
+func (d *DirectoryBindingsServiceOp) Create(ctx context.Context, request *DirectoryBindingRequest) (*DirectoryBinding, *Response, error) {
+	path := directoryBindingsBasePath + "/id/0"
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	req, err := d.client.NewRequest(ctx, http.MethodPost, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	directoryBindingCreation := new(DirectoryBindingResponse)
+	resp, err := d.client.Do(ctx, req, directoryBindingCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, directoryBindingCreation.Id, d.GetByID)
+}
+
+// Update updates a directory binding in Jamf Pro and returns the record as stored by the server.
+func (d *DirectoryBindingsServiceOp) Update(ctx context.Context, id int, request *DirectoryBindingRequest) (*DirectoryBinding, *Response, error) {
+	path := directoryBindingsBasePath + "/id/" + strconv.Itoa(id)
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if id == 0 {
+		return nil, nil, NewArgError("directory binding ID", "cannot be 0")
+	}
+
+	req, err := d.client.NewRequest(ctx, http.MethodPut, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	directoryBindingUpdate := new(DirectoryBindingResponse)
+	resp, err := d.client.Do(ctx, req, directoryBindingUpdate)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d.GetByID(ctx, id)
+}
+
+func (d *DirectoryBindingsServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := directoryBindingsBasePath + "/id/" + strconv.Itoa(id)
+
+	req, err := d.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := d.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DeleteByName deletes the directory binding called name. The error matches ErrNotFound if there is none.
+func (d *DirectoryBindingsServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "directory binding", name, d.GetByName, func(b *DirectoryBinding) ID { return b.Id }, d.Delete)
+}
+
+func (d *DirectoryBindingsServiceOp) get(ctx context.Context, path string) (*DirectoryBinding, *Response, error) {
+	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var directoryBinding DirectoryBinding
+	resp, err := d.client.Do(ctx, req, &directoryBinding)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &directoryBinding, resp, err
+}
+
+func (d *DirectoryBindingsServiceOp) list(ctx context.Context) ([]DirectoryBindingListItem, *Response, error) {
+	path := directoryBindingsBasePath
+	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var directoryBindingResponse DirectoryBindingListResponse
+	resp, err := d.client.Do(ctx, req, &directoryBindingResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return directoryBindingResponse.DirectoryBindings, resp, err
+}
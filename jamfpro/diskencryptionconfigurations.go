@@ -0,0 +1,183 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+)
+
+const diskEncryptionConfigurationsBasePath = "JSSResource/diskencryptionconfigurations"
+
+type DiskEncryptionConfigurationsService interface {
+	List(context.Context) ([]DiskEncryptionConfigurationListItem, *Response, error)
+	GetByID(context.Context, int) (*DiskEncryptionConfiguration, *Response, error)
+	GetByName(context.Context, string) (*DiskEncryptionConfiguration, *Response, error)
+	Create(context.Context, *DiskEncryptionConfigurationRequest) (*DiskEncryptionConfiguration, *Response, error)
+	Update(context.Context, int, *DiskEncryptionConfigurationRequest) (*DiskEncryptionConfiguration, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+}
+
+// DiskEncryptionConfigurationsServiceOp handles communication with the disk encryption configuration-related
+// methods of the Jamf Pro API.
+type DiskEncryptionConfigurationsServiceOp struct {
+	client *Client
+}
+
+var _ DiskEncryptionConfigurationsService = &DiskEncryptionConfigurationsServiceOp{}
+
+// DiskEncryptionConfiguration represents a Jamf Pro disk encryption (FileVault) configuration
+type DiskEncryptionConfiguration struct {
+	XMLName                  xml.Name                                `xml:"disk_encryption_configuration"`
+	Id                       ID                                      `xml:"id"`
+	Name                     string                                  `xml:"name"`
+	KeyType                  string                                  `xml:"key_type"`
+	FileVaultEnabledUsers    string                                  `xml:"file_vault_enabled_users"`
+	InstitutionalRecoveryKey *DiskEncryptionInstitutionalRecoveryKey `xml:"institutional_recovery_key"`
+}
+
+// DiskEncryptionInstitutionalRecoveryKey describes the certificate used as the institutional (shared) FileVault
+// recovery key.
+type DiskEncryptionInstitutionalRecoveryKey struct {
+	Key             string `xml:"key,omitempty"`
+	CertificateType string `xml:"certificate_type,omitempty"`
+	Password        string `xml:"password,omitempty"`
+	Data            string `xml:"data,omitempty"`
+}
+
+// DiskEncryptionConfigurationListItem is the summary of a disk encryption configuration returned when listing all disk encryption configurations
+type DiskEncryptionConfigurationListItem struct {
+	Id   ID     `xml:"id"`
+	Name string `xml:"name"`
+}
+
+// DiskEncryptionConfigurationListResponse represents the raw API response to getting all disk encryption configurations
+type DiskEncryptionConfigurationListResponse struct {
+	XMLName                      xml.Name                              `xml:"disk_encryption_configurations"`
+	Size                         int                                   `xml:"size"`
+	DiskEncryptionConfigurations []DiskEncryptionConfigurationListItem `xml:"disk_encryption_configuration"`
+}
+
+// DiskEncryptionConfigurationRequest represents a request to create or update a disk encryption configuration.
+type DiskEncryptionConfigurationRequest struct {
+	XMLName                  xml.Name                                `xml:"disk_encryption_configuration"`
+	Name                     string                                  `xml:"name"`
+	KeyType                  string                                  `xml:"key_type"`
+	FileVaultEnabledUsers    string                                  `xml:"file_vault_enabled_users"`
+	InstitutionalRecoveryKey *DiskEncryptionInstitutionalRecoveryKey `xml:"institutional_recovery_key,omitempty"`
+}
+
+type DiskEncryptionConfigurationResponse struct {
+	Id ID `xml:"id"`
+}
+
+func (d *DiskEncryptionConfigurationsServiceOp) List(ctx context.Context) ([]DiskEncryptionConfigurationListItem, *Response, error) {
+	return d.list(ctx)
+}
+
+func (d *DiskEncryptionConfigurationsServiceOp) GetByID(ctx context.Context, id int) (*DiskEncryptionConfiguration, *Response, error) {
+	path := diskEncryptionConfigurationsBasePath + "/id/" + strconv.Itoa(id)
+	return d.get(ctx, path)
+}
+
+func (d *DiskEncryptionConfigurationsServiceOp) GetByName(ctx context.Context, name string) (*DiskEncryptionConfiguration, *Response, error) {
+	path := pathJoin(diskEncryptionConfigurationsBasePath, "name", name)
+	return d.get(ctx, path)
+}
+
+// Create creates a disk encryption configuration in Jamf Pro and returns the record as stored by the server.
+func (d *DiskEncryptionConfigurationsServiceOp) Create(ctx context.Context, request *DiskEncryptionConfigurationRequest) (*DiskEncryptionConfiguration, *Response, error) {
+	path := diskEncryptionConfigurationsBasePath + "/id/0"
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	req, err := d.client.NewRequest(ctx, http.MethodPost, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	diskEncryptionConfigurationCreation := new(DiskEncryptionConfigurationResponse)
+	resp, err := d.client.Do(ctx, req, diskEncryptionConfigurationCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, diskEncryptionConfigurationCreation.Id, d.GetByID)
+}
+
+// Update updates a disk encryption configuration in Jamf Pro and returns the record as stored by the server.
+func (d *DiskEncryptionConfigurationsServiceOp) Update(ctx context.Context, id int, request *DiskEncryptionConfigurationRequest) (*DiskEncryptionConfiguration, *Response, error) {
+	path := diskEncryptionConfigurationsBasePath + "/id/" + strconv.Itoa(id)
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if id == 0 {
+		return nil, nil, NewArgError("disk encryption configuration ID", "cannot be 0")
+	}
+
+	req, err := d.client.NewRequest(ctx, http.MethodPut, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	diskEncryptionConfigurationUpdate := new(DiskEncryptionConfigurationResponse)
+	resp, err := d.client.Do(ctx, req, diskEncryptionConfigurationUpdate)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d.GetByID(ctx, id)
+}
+
+func (d *DiskEncryptionConfigurationsServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := diskEncryptionConfigurationsBasePath + "/id/" + strconv.Itoa(id)
+
+	req, err := d.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := d.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DeleteByName deletes the disk encryption configuration called name. The error matches ErrNotFound if there is none.
+func (d *DiskEncryptionConfigurationsServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "disk encryption configuration", name, d.GetByName, func(c *DiskEncryptionConfiguration) ID { return c.Id }, d.Delete)
+}
+
+func (d *DiskEncryptionConfigurationsServiceOp) get(ctx context.Context, path string) (*DiskEncryptionConfiguration, *Response, error) {
+	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var diskEncryptionConfiguration DiskEncryptionConfiguration
+	resp, err := d.client.Do(ctx, req, &diskEncryptionConfiguration)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &diskEncryptionConfiguration, resp, err
+}
+
+func (d *DiskEncryptionConfigurationsServiceOp) list(ctx context.Context) ([]DiskEncryptionConfigurationListItem, *Response, error) {
+	path := diskEncryptionConfigurationsBasePath
+	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var diskEncryptionConfigurationResponse DiskEncryptionConfigurationListResponse
+	resp, err := d.client.Do(ctx, req, &diskEncryptionConfigurationResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return diskEncryptionConfigurationResponse.DiskEncryptionConfigurations, resp, err
+}
@@ -0,0 +1,173 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+)
+
+const dockItemsBasePath = "JSSResource/dockitems"
+
+type DockItemsService interface {
+	List(context.Context) ([]DockItemListItem, *Response, error)
+	GetByID(context.Context, int) (*DockItem, *Response, error)
+	GetByName(context.Context, string) (*DockItem, *Response, error)
+	Create(context.Context, *DockItemRequest) (*DockItem, *Response, error)
+	Update(context.Context, int, *DockItemRequest) (*DockItem, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+}
+
+// DockItemsServiceOp handles communication with the dock item-related
+// methods of the Jamf Pro API.
+type DockItemsServiceOp struct {
+	client *Client
+}
+
+var _ DockItemsService = &DockItemsServiceOp{}
+
+// DockItem represents a Jamf Pro dock item
+type DockItem struct {
+	XMLName  xml.Name `xml:"dock_item"`
+	Id       ID       `xml:"id"`
+	Name     string   `xml:"name"`
+	Type     string   `xml:"type"`
+	Path     string   `xml:"path"`
+	Contents string   `xml:"contents"`
+}
+
+// DockItemListItem is the summary of a dock item returned when listing all dock items
+type DockItemListItem struct {
+	Id   ID     `xml:"id"`
+	Name string `xml:"name"`
+}
+
+// DockItemListResponse represents the raw API response to getting all dock items
+type DockItemListResponse struct {
+	XMLName   xml.Name           `xml:"dock_items"`
+	Size      int                `xml:"size"`
+	DockItems []DockItemListItem `xml:"dock_item"`
+}
+
+// DockItemRequest represents a request to create or update a dock item.
+type DockItemRequest struct {
+	XMLName xml.Name `xml:"dock_item"`
+	Name    string   `xml:"name"`
+	Type    string   `xml:"type"`
+	Path    string   `xml:"path"`
+}
+
+type DockItemResponse struct {
+	Id ID `xml:"id"`
+}
+
+func (d *DockItemsServiceOp) List(ctx context.Context) ([]DockItemListItem, *Response, error) {
+	return d.list(ctx)
+}
+
+func (d *DockItemsServiceOp) GetByID(ctx context.Context, id int) (*DockItem, *Response, error) {
+	path := dockItemsBasePath + "/id/" + strconv.Itoa(id)
+	return d.get(ctx, path)
+}
+
+func (d *DockItemsServiceOp) GetByName(ctx context.Context, name string) (*DockItem, *Response, error) {
+	path := pathJoin(dockItemsBasePath, "name", name)
+	return d.get(ctx, path)
+}
+
+// Create creates a dock item in Jamf Pro and returns the record as stored by the server.
+func (d *DockItemsServiceOp) Create(ctx context.Context, request *DockItemRequest) (*DockItem, *Response, error) {
+	path := dockItemsBasePath + "/id/0"
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	req, err := d.client.NewRequest(ctx, http.MethodPost, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dockItemCreation := new(DockItemResponse)
+	resp, err := d.client.Do(ctx, req, dockItemCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, dockItemCreation.Id, d.GetByID)
+}
+
+// Update updates a dock item in Jamf Pro and returns the record as stored by the server.
+func (d *DockItemsServiceOp) Update(ctx context.Context, id int, request *DockItemRequest) (*DockItem, *Response, error) {
+	path := dockItemsBasePath + "/id/" + strconv.Itoa(id)
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if id == 0 {
+		return nil, nil, NewArgError("dock item ID", "cannot be 0")
+	}
+
+	req, err := d.client.NewRequest(ctx, http.MethodPut, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dockItemUpdate := new(DockItemResponse)
+	resp, err := d.client.Do(ctx, req, dockItemUpdate)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d.GetByID(ctx, id)
+}
+
+func (d *DockItemsServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := dockItemsBasePath + "/id/" + strconv.Itoa(id)
+
+	req, err := d.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := d.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DeleteByName deletes the dock item called name. The error matches ErrNotFound if there is none.
+func (d *DockItemsServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "dock item", name, d.GetByName, func(i *DockItem) ID { return i.Id }, d.Delete)
+}
+
+func (d *DockItemsServiceOp) get(ctx context.Context, path string) (*DockItem, *Response, error) {
+	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var dockItem DockItem
+	resp, err := d.client.Do(ctx, req, &dockItem)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &dockItem, resp, err
+}
+
+func (d *DockItemsServiceOp) list(ctx context.Context) ([]DockItemListItem, *Response, error) {
+	path := dockItemsBasePath
+	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var dockItemResponse DockItemListResponse
+	resp, err := d.client.Do(ctx, req, &dockItemResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return dockItemResponse.DockItems, resp, err
+}
@@ -0,0 +1,193 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+)
+
+const ebooksBasePath = "JSSResource/ebooks"
+
+type EbooksService interface {
+	List(context.Context) ([]EbookListItem, *Response, error)
+	GetByID(context.Context, int) (*Ebook, *Response, error)
+	GetByName(context.Context, string) (*Ebook, *Response, error)
+	Create(context.Context, *EbookRequest) (*Ebook, *Response, error)
+	Update(context.Context, int, *EbookRequest) (*Ebook, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+}
+
+// EbooksServiceOp handles communication with the eBook-related
+// methods of the Jamf Pro API.
+type EbooksServiceOp struct {
+	client *Client
+}
+
+var _ EbooksService = &EbooksServiceOp{}
+
+// Ebook represents a Jamf Pro eBook
+type Ebook struct {
+	XMLName     xml.Name         `xml:"ebook"`
+	General     EbookGeneral     `xml:"general"`
+	Scope       Scope            `xml:"scope"`
+	SelfService EbookSelfService `xml:"self_service"`
+}
+
+type EbookGeneral struct {
+	Id              ID               `xml:"id,omitempty"`
+	Name            string           `xml:"name"`
+	Author          string           `xml:"author,omitempty"`
+	Version         string           `xml:"version,omitempty"`
+	Free            bool             `xml:"free"`
+	Url             string           `xml:"url,omitempty"`
+	DeploymentType  string           `xml:"deployment_type,omitempty"`
+	FileType        string           `xml:"file_type,omitempty"`
+	DeployAsManaged bool             `xml:"deploy_as_managed"`
+	Category        ClassicReference `xml:"category"`
+	Site            ClassicReference `xml:"site"`
+}
+
+type EbookSelfService struct {
+	SelfServiceDisplayName      string `xml:"self_service_display_name,omitempty"`
+	InstallButtonText           string `xml:"install_button_text,omitempty"`
+	SelfServiceDescription      string `xml:"self_service_description,omitempty"`
+	ForceUsersToViewDescription bool   `xml:"force_users_to_view_description"`
+	FeatureOnMainPage           bool   `xml:"feature_on_main_page"`
+}
+
+// EbookListItem is the summary of an eBook returned when listing all eBooks
+type EbookListItem struct {
+	Id   ID     `xml:"id"`
+	Name string `xml:"name"`
+}
+
+// EbookListResponse represents the raw API response to getting all eBooks
+type EbookListResponse struct {
+	XMLName xml.Name        `xml:"ebooks"`
+	Size    int             `xml:"size"`
+	Ebooks  []EbookListItem `xml:"ebook"`
+}
+
+// EbookRequest represents a request to create or update an eBook.
+type EbookRequest struct {
+	XMLName     xml.Name          `xml:"ebook"`
+	General     EbookGeneral      `xml:"general"`
+	Scope       *Scope            `xml:"scope,omitempty"`
+	SelfService *EbookSelfService `xml:"self_service,omitempty"`
+}
+
+type EbookResponse struct {
+	Id ID `xml:"id"`
+}
+
+func (e *EbooksServiceOp) List(ctx context.Context) ([]EbookListItem, *Response, error) {
+	return e.list(ctx)
+}
+
+func (e *EbooksServiceOp) GetByID(ctx context.Context, id int) (*Ebook, *Response, error) {
+	path := ebooksBasePath + "/id/" + strconv.Itoa(id)
+	return e.get(ctx, path)
+}
+
+func (e *EbooksServiceOp) GetByName(ctx context.Context, name string) (*Ebook, *Response, error) {
+	path := pathJoin(ebooksBasePath, "name", name)
+	return e.get(ctx, path)
+}
+
+// Create creates an eBook in Jamf Pro and returns the record as stored by the server.
+func (e *EbooksServiceOp) Create(ctx context.Context, request *EbookRequest) (*Ebook, *Response, error) {
+	path := ebooksBasePath + "/id/0"
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	req, err := e.client.NewRequest(ctx, http.MethodPost, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ebookCreation := new(EbookResponse)
+	resp, err := e.client.Do(ctx, req, ebookCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, ebookCreation.Id, e.GetByID)
+}
+
+// Update updates an eBook in Jamf Pro and returns the record as stored by the server.
+func (e *EbooksServiceOp) Update(ctx context.Context, id int, request *EbookRequest) (*Ebook, *Response, error) {
+	path := ebooksBasePath + "/id/" + strconv.Itoa(id)
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if id == 0 {
+		return nil, nil, NewArgError("eBook ID", "cannot be 0")
+	}
+
+	req, err := e.client.NewRequest(ctx, http.MethodPut, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ebookUpdate := new(EbookResponse)
+	resp, err := e.client.Do(ctx, req, ebookUpdate)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return e.GetByID(ctx, id)
+}
+
+func (e *EbooksServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := ebooksBasePath + "/id/" + strconv.Itoa(id)
+
+	req, err := e.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := e.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DeleteByName deletes the ebook called name. The error matches ErrNotFound if there is none.
+func (e *EbooksServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "ebook", name, e.GetByName, func(ebook *Ebook) ID { return ebook.General.Id }, e.Delete)
+}
+
+func (e *EbooksServiceOp) get(ctx context.Context, path string) (*Ebook, *Response, error) {
+	req, err := e.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ebook Ebook
+	resp, err := e.client.Do(ctx, req, &ebook)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &ebook, resp, err
+}
+
+func (e *EbooksServiceOp) list(ctx context.Context) ([]EbookListItem, *Response, error) {
+	path := ebooksBasePath
+	req, err := e.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ebookResponse EbookListResponse
+	resp, err := e.client.Do(ctx, req, &ebookResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ebookResponse.Ebooks, resp, err
+}
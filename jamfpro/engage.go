@@ -0,0 +1,72 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+)
+
+const engageBasePath = "uapi/v2/engage"
+
+type EngageSettingsService interface {
+	Get(context.Context) (*EngageSettings, *Response, error)
+	Update(context.Context, *EngageSettings) (*EngageSettings, *Response, error)
+	ListHistory(context.Context, *ListOptions) ([]HistoryEntry, *Response, error)
+	AddHistoryNote(context.Context, string) (*HistoryNoteResponse, *Response, error)
+}
+
+// EngageSettingsServiceOp handles communication with the Jamf Pro engage settings
+// methods of the Jamf Pro API.
+type EngageSettingsServiceOp struct {
+	client *Client
+}
+
+var _ EngageSettingsService = &EngageSettingsServiceOp{}
+
+// EngageSettings represents the Jamf Pro engage settings
+type EngageSettings struct {
+	IsEnabled bool `json:"isEnabled"`
+}
+
+func (e *EngageSettingsServiceOp) Get(ctx context.Context) (*EngageSettings, *Response, error) {
+	req, err := e.client.NewRequest(ctx, http.MethodGet, engageBasePath, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var settings EngageSettings
+	resp, err := e.client.Do(ctx, req, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &settings, resp, err
+}
+
+func (e *EngageSettingsServiceOp) Update(ctx context.Context, settings *EngageSettings) (*EngageSettings, *Response, error) {
+	if settings == nil {
+		return nil, nil, NewArgError("settings", "cannot be nil")
+	}
+
+	req, err := e.client.NewRequest(ctx, http.MethodPut, engageBasePath, settings, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated EngageSettings
+	resp, err := e.client.Do(ctx, req, &updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &updated, resp, err
+}
+
+// ListHistory returns the change history of the engage settings.
+func (e *EngageSettingsServiceOp) ListHistory(ctx context.Context, opts *ListOptions) ([]HistoryEntry, *Response, error) {
+	return listHistory(ctx, e.client, engageBasePath+"/history", opts)
+}
+
+// AddHistoryNote adds a note to the change history of the engage settings.
+func (e *EngageSettingsServiceOp) AddHistoryNote(ctx context.Context, note string) (*HistoryNoteResponse, *Response, error) {
+	return addHistoryNote(ctx, e.client, engageBasePath+"/history", note)
+}
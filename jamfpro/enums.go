@@ -0,0 +1,28 @@
+package jamfpro
+
+import (
+	"strings"
+)
+
+// unmarshalEnum returns the value of known text spells, ignoring case, as the API is not consistent about it. Values
+// it does not know, e.g. ones added in a newer version of Jamf Pro, are returned as they are, so that a record read
+// from the server can be written back unchanged. Values are checked where requests are built and validated instead,
+// e.g. by ValidateCriteria.
+func unmarshalEnum[T ~string](text []byte, known []T) T {
+	for _, value := range known {
+		if strings.EqualFold(string(value), string(text)) {
+			return value
+		}
+	}
+	return T(text)
+}
+
+// isKnown reports whether value is one of known.
+func isKnown[T ~string](value T, known []T) bool {
+	for _, k := range known {
+		if value == k {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestEnumsKeepValuesFromTheServer(t *testing.T) {
+	tests := []struct {
+		name  string
+		xml   string
+		value func(any) string
+		new   func() any
+		want  string
+	}{
+		{
+			name: "search type",
+			xml:  "<criterion><name>Model</name><priority>0</priority><and_or>AND</and_or><search_type>starts with</search_type><value>Mac</value><opening_paren>false</opening_paren><closing_paren>false</closing_paren></criterion>",
+			new:  func() any { return new(ComputerGroupCriteria) },
+			value: func(v any) string {
+				c := v.(*ComputerGroupCriteria)
+				return string(c.AndOr) + "|" + string(c.SearchType)
+			},
+			want: "and|starts with",
+		},
+		{
+			name:  "policy frequency",
+			xml:   "<general><name>Update Inventory</name><frequency>Once every two weeks</frequency></general>",
+			new:   func() any { return new(PolicyGeneral) },
+			value: func(v any) string { return string(v.(*PolicyGeneral).Frequency) },
+			want:  "Once every two weeks",
+		},
+		{
+			name:  "access level",
+			xml:   "<account><name>auditor</name><access_level>Enterprise Access</access_level></account>",
+			new:   func() any { return new(UserAccount) },
+			value: func(v any) string { return string(v.(*UserAccount).AccessLevel) },
+			want:  "Enterprise Access",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded := tt.new()
+			if err := xml.Unmarshal([]byte(tt.xml), decoded); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if got := tt.value(decoded); got != tt.want {
+				t.Errorf("decoded %q, want %q", got, tt.want)
+			}
+
+			encoded, err := xml.Marshal(decoded)
+			if err != nil {
+				t.Fatalf("Marshal refuses the value read from the server: %v", err)
+			}
+			readBack := tt.new()
+			if err := xml.Unmarshal(encoded, readBack); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if got := tt.value(readBack); got != tt.want {
+				t.Errorf("written back as %q, want %q", got, tt.want)
+			}
+			if _, err := json.Marshal(decoded); err != nil {
+				t.Errorf("json.Marshal refuses the value read from the server: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateCriteriaRejectsUnknownValues(t *testing.T) {
+	valid := ComputerGroupCriteria{Name: "Model", AndOr: CriterionAnd, SearchType: SearchTypeLike, Value: "Mac"}
+
+	unknownSearchType := valid
+	unknownSearchType.SearchType = "lik"
+	unknownOperator := valid
+	unknownOperator.AndOr = "nor"
+
+	if err := ValidateCriteria([]ComputerGroupCriteria{valid}); err != nil {
+		t.Errorf("ValidateCriteria of a valid criterion: %v", err)
+	}
+	for _, criterion := range []ComputerGroupCriteria{unknownSearchType, unknownOperator} {
+		var argErr *ArgError
+		if err := ValidateCriteria([]ComputerGroupCriteria{criterion}); !errors.As(err, &argErr) {
+			t.Errorf("ValidateCriteria(%+v) = %v, want an ArgError", criterion, err)
+		}
+	}
+
+	if _, err := NewCriteriaBuilder().Where("Model", "lik", "Mac").Build(); err == nil || !strings.Contains(err.Error(), `"lik"`) {
+		t.Errorf("Build with an unknown search type = %v, want an error naming it", err)
+	}
+}
+
+func TestPolicyCreateRejectsUnknownFrequency(t *testing.T) {
+	client, _ := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("a policy with an unknown frequency was sent: %s %s", r.Method, r.URL.Path)
+	}))
+
+	request := &PolicyRequest{General: PolicyGeneral{Name: "Update Inventory", Frequency: "Once per compter"}}
+	var argErr *ArgError
+	if _, _, err := client.Policies.Create(context.Background(), request); !errors.As(err, &argErr) {
+		t.Errorf("Create = %v, want an ArgError", err)
+	}
+}
@@ -1,6 +1,15 @@
 package jamfpro
 
-import "fmt"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"regexp"
+	"strings"
+)
 
 // ArgError is an error that represents an error with an input to jamfpro-api. It
 // identifies the argument and the cause (if possible).
@@ -22,3 +31,178 @@ func NewArgError(arg, reason string) *ArgError {
 func (e *ArgError) Error() string {
 	return fmt.Sprintf("%s is invalid because %s", e.arg, e.reason)
 }
+
+// isConflict reports whether a request failed because it clashed with the current state of the resource, e.g.
+// creating an object whose name is already taken.
+func isConflict(resp *Response) bool {
+	return resp != nil && resp.Response != nil && resp.StatusCode == http.StatusConflict
+}
+
+// ErrConflict matches, with errors.Is, the error returned when a change was rejected because the resource is not in
+// the state the request expected: it was changed since the ETag or version lock sent with the request was read, or
+// the change clashes with another object, e.g. because its name is already taken.
+var ErrConflict = errors.New("jamfpro: the resource was changed concurrently or conflicts with another")
+
+// ErrNotFound matches, with errors.Is, the error returned when the object asked for does not exist: a 404 Not Found
+// response, or a lookup by name that matched nothing.
+var ErrNotFound = errors.New("jamfpro: the resource does not exist")
+
+// ErrMissingID is returned by Create methods when Jamf Pro accepted the object but did not say which ID it was given,
+// so the object as stored cannot be fetched.
+var ErrMissingID = errors.New("jamfpro: the response to creating the object has no ID")
+
+// Is makes errors.Is match ErrConflict for 409 Conflict and 412 Precondition Failed responses, and ErrNotFound for
+// 404 Not Found responses.
+func (r *ErrorResponse) Is(target error) bool {
+	if r.Response == nil {
+		return false
+	}
+	switch target {
+	case ErrConflict:
+		return r.Response.StatusCode == http.StatusConflict || r.Response.StatusCode == http.StatusPreconditionFailed
+	case ErrNotFound:
+		return r.Response.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+// ErrInvalidClient matches, with errors.Is, an AuthError for a client ID or secret that Jamf Pro does not accept.
+var ErrInvalidClient = errors.New("jamfpro: the API client ID or secret is invalid")
+
+// ErrInvalidScope matches, with errors.Is, an AuthError for a token request whose API client lacks the scope asked for,
+// e.g. because its API roles were removed.
+var ErrInvalidScope = errors.New("jamfpro: the API client is not allowed the scope requested")
+
+// AuthError is returned when Jamf Pro does not issue a bearer token, either because it refused the request or
+// because its response could not be used.
+type AuthError struct {
+	// StatusCode is the HTTP status of the token response.
+	StatusCode int
+
+	// Body is the start of the token response, for diagnosing responses that are not OAuth errors.
+	Body string
+
+	// Code and Description are the OAuth error and error_description of the response, e.g. invalid_client, if it
+	// has them.
+	Code        string
+	Description string
+
+	// Err is why a successful response could not be used, e.g. a decoding error.
+	Err error
+}
+
+func (e *AuthError) Error() string {
+	switch {
+	case e.Err != nil:
+		return fmt.Sprintf("jamfpro: unusable token response (%d): %v", e.StatusCode, e.Err)
+	case e.Code != "" && e.Description != "":
+		return fmt.Sprintf("jamfpro: token request refused (%d): %s: %s", e.StatusCode, e.Code, e.Description)
+	case e.Code != "":
+		return fmt.Sprintf("jamfpro: token request refused (%d): %s", e.StatusCode, e.Code)
+	}
+	return fmt.Sprintf("jamfpro: token request refused (%d): %s", e.StatusCode, e.Body)
+}
+
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+// Is makes errors.Is match ErrInvalidClient and ErrInvalidScope for the OAuth errors of the same name.
+func (e *AuthError) Is(target error) bool {
+	switch target {
+	case ErrInvalidClient:
+		return e.Code == "invalid_client"
+	case ErrInvalidScope:
+		return e.Code == "invalid_scope"
+	}
+	return false
+}
+
+// newAuthError builds the AuthError for a token response that was not a token.
+func newAuthError(statusCode int, body []byte, err error) *AuthError {
+	const maxBody = 1 << 10
+	authErr := &AuthError{StatusCode: statusCode, Err: err}
+	if len(body) > maxBody {
+		authErr.Body = strings.TrimSpace(string(body[:maxBody])) + "..."
+	} else {
+		authErr.Body = strings.TrimSpace(string(body))
+	}
+	var oauthErr struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if json.Unmarshal(body, &oauthErr) == nil {
+		authErr.Code = oauthErr.Error
+		authErr.Description = oauthErr.ErrorDescription
+	}
+	return authErr
+}
+
+// notFoundByName returns the error for a lookup of the kind of object called name that matched nothing.
+func notFoundByName(kind, name string) error {
+	return fmt.Errorf("%w: no %s is called %q", ErrNotFound, kind, name)
+}
+
+// existence turns the error of a lookup into whether the object looked up exists. An error matching ErrNotFound
+// means it does not, and any other error is returned as is.
+func existence(err error) (bool, error) {
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	return false, err
+}
+
+type ifMatchKey struct{}
+
+// ContextWithIfMatch returns a copy of ctx making the changes requested with it conditional on the resource still
+// having the entity tag etag, as returned in Response.ETag. If the resource has been changed since, the request fails
+// with an error matching ErrConflict, rather than overwriting the other change.
+func ContextWithIfMatch(ctx context.Context, etag string) context.Context {
+	return context.WithValue(ctx, ifMatchKey{}, etag)
+}
+
+var (
+	classicErrorParagraph = regexp.MustCompile(`(?is)<p[^>]*>(.*?)</p>`)
+	htmlTag               = regexp.MustCompile(`(?s)<[^>]*>`)
+)
+
+// parseClassicError extracts the error code and message from the HTML page the Classic API returns for an error,
+// which has the code and message in its first two paragraphs:
+//
+//	<p>Conflict</p>
+//	<p>Error: Duplicate name</p>
+//	<p>You can get technical details <a href="...">here</a>.</p>
+func parseClassicError(body []byte) (code, message string, ok bool) {
+	paragraphs := classicErrorParagraph.FindAllSubmatch(body, -1)
+	if len(paragraphs) == 0 {
+		return "", "", false
+	}
+
+	text := make([]string, 0, len(paragraphs))
+	for _, paragraph := range paragraphs {
+		plain := html.UnescapeString(htmlTag.ReplaceAllString(string(paragraph[1]), ""))
+		if plain = strings.Join(strings.Fields(plain), " "); plain != "" {
+			text = append(text, plain)
+		}
+	}
+	if len(text) == 0 {
+		return "", "", false
+	}
+
+	code = text[0]
+	for _, paragraph := range text[1:] {
+		if strings.HasPrefix(paragraph, "Error") {
+			message = strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(paragraph, "Error"), ":"))
+			return code, message, true
+		}
+	}
+	if len(text) > 1 {
+		message = text[1]
+	} else {
+		message = code
+	}
+	return code, message, true
+}
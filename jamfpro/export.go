@@ -0,0 +1,65 @@
+package jamfpro
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// ExportOptions selects the objects and columns included in a CSV export of a Jamf Pro API resource. Filter and
+// Sort in ListOptions select and order the objects. Fields lists the fields to export, and Labels the matching
+// column headers; both default to every field.
+type ExportOptions struct {
+	ListOptions
+	Fields []string
+	Labels []string
+}
+
+// EncodeQuery adds the list options, and the export-fields and export-labels parameters.
+func (o *ExportOptions) EncodeQuery(q *QueryParams) {
+	if o == nil {
+		return
+	}
+	o.ListOptions.EncodeQuery(q)
+	q.Strings("export-fields", o.Fields).Strings("export-labels", o.Labels)
+}
+
+// ObjectHistory is implemented by the services of Jamf Pro API objects that keep a change history, so tooling can
+// read and annotate the history of any of them.
+type ObjectHistory interface {
+	ListHistory(context.Context, int, *ListOptions) ([]HistoryEntry, *Response, error)
+	AddHistoryNote(context.Context, int, string) (*HistoryNoteResponse, *Response, error)
+}
+
+// CSVExporter is implemented by the services of Jamf Pro API resources that can be exported as CSV.
+type CSVExporter interface {
+	ExportCSV(context.Context, io.Writer, *ExportOptions) (*Response, error)
+}
+
+var (
+	_ ObjectHistory = &BuildingsServiceOp{}
+	_ ObjectHistory = &CategoriesServiceOp{}
+	_ ObjectHistory = &DepartmentsServiceOp{}
+	_ CSVExporter   = &BuildingsServiceOp{}
+	_ CSVExporter   = &DepartmentsServiceOp{}
+)
+
+// exportCSV writes the CSV export at path, the export endpoint of a resource, to w. opts holds its query parameters.
+func exportCSV(ctx context.Context, client *Client, path string, w io.Writer, opts QueryEncoder) (*Response, error) {
+	if w == nil {
+		return nil, NewArgError("writer", "cannot be nil")
+	}
+
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withRead(ctx)
+	req, err := client.NewRequest(ctx, http.MethodPost, path, nil, "application/json", WithHeader("Accept", "text/csv"))
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, w)
+}
@@ -0,0 +1,99 @@
+package jamfpro
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+const fileUploadsBasePath = "JSSResource/fileuploads"
+
+// FileUploadResource names a kind of record files can be attached to through the Classic API at
+// /JSSResource/fileuploads/<resource>/id/<id>.
+type FileUploadResource string
+
+const (
+	FileUploadComputers          FileUploadResource = "computers"
+	FileUploadMobileDevices      FileUploadResource = "mobiledevices"
+	FileUploadEnrollmentProfiles FileUploadResource = "enrollmentprofiles"
+	FileUploadPeripherals        FileUploadResource = "peripherals"
+)
+
+// FileUploadsService attaches files to records. The Classic API only accepts uploads, so attachments are read back
+// through the Jamf Pro API, which exposes them for computers only.
+type FileUploadsService interface {
+	Upload(context.Context, FileUploadResource, int, string, io.Reader) (*Response, error)
+	DownloadComputerAttachment(context.Context, int, int, io.Writer) (*Response, error)
+	DeleteComputerAttachment(context.Context, int, int) (*Response, error)
+}
+
+// FileUploadsServiceOp handles communication with the file upload related
+// methods of the Classic API and the computer attachment methods of the Jamf Pro API.
+type FileUploadsServiceOp struct {
+	client *Client
+}
+
+var _ FileUploadsService = &FileUploadsServiceOp{}
+
+// Upload attaches content to the record of the given resource and ID under fileName. content is streamed in a
+// multipart request, and closed afterwards if it is an io.Closer.
+func (f *FileUploadsServiceOp) Upload(ctx context.Context, resource FileUploadResource, id int, fileName string, content io.Reader) (*Response, error) {
+	if resource == "" {
+		return nil, NewArgError("resource", "cannot be empty")
+	} else if id == 0 {
+		return nil, NewArgError("id", "cannot be 0")
+	} else if fileName == "" {
+		return nil, NewArgError("fileName", "cannot be empty")
+	} else if content == nil {
+		return nil, NewArgError("content", "cannot be nil")
+	}
+
+	path := fileUploadsBasePath + "/" + string(resource) + "/id/" + strconv.Itoa(id)
+
+	req, err := f.client.newUploadRequest(ctx, http.MethodPost, path, "name", fileName, content)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := f.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DownloadComputerAttachment writes the content of an attachment of a computer to w. The IDs of the attachments of a
+// computer are listed in the Attachments section of its inventory detail.
+func (f *FileUploadsServiceOp) DownloadComputerAttachment(ctx context.Context, computerID, attachmentID int, w io.Writer) (*Response, error) {
+	if w == nil {
+		return nil, NewArgError("writer", "cannot be nil")
+	}
+
+	path := computersInventoryBasePath + "/" + strconv.Itoa(computerID) + "/attachments/" + strconv.Itoa(attachmentID)
+
+	req, err := f.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json", WithHeader("Accept", "application/octet-stream"))
+	if err != nil {
+		return nil, err
+	}
+
+	return f.client.Do(ctx, req, w)
+}
+
+// DeleteComputerAttachment removes an attachment from a computer.
+func (f *FileUploadsServiceOp) DeleteComputerAttachment(ctx context.Context, computerID, attachmentID int) (*Response, error) {
+	path := computersInventoryBasePath + "/" + strconv.Itoa(computerID) + "/attachments/" + strconv.Itoa(attachmentID)
+
+	req, err := f.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := f.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
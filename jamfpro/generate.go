@@ -0,0 +1,14 @@
+package jamfpro
+
+// Services for resources without a hand-written implementation are generated from the Jamf Pro OpenAPI schema.
+// Add the resource to internal/gen/resources.json, then run go generate with JAMFPRO_OPENAPI_SPEC set to the
+// path or URL of the schema (https://<instance>/api/schema).
+//
+// Get* accessors for pointer fields are regenerated afterwards, so they cover generated structs too.
+//
+// The privilege constants are generated from internal/privileges/privileges.json. Set JAMFPRO_URL, along with
+// JAMFPRO_CLIENT_ID and JAMFPRO_CLIENT_SECRET, to refresh that list from an instance first.
+//
+//go:generate go run ../internal/gen -spec ${JAMFPRO_OPENAPI_SPEC} -config ../internal/gen/resources.json -out zz_generated.go
+//go:generate go run ../internal/accessors -dir . -out accessors.go
+//go:generate go run ../internal/privileges -in ../internal/privileges/privileges.json -out privilegenames.go -instance=${JAMFPRO_URL}
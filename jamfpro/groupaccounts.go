@@ -0,0 +1,266 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+)
+
+const groupAccountsBasePath = accountsBasePath + "/groupid"
+
+// Privilege sets a Jamf Pro account group can be given. Groups with PrivilegeSetCustom get the privileges listed in
+// their AccountPrivileges.
+const (
+	PrivilegeSetAdministrator  = "Administrator"
+	PrivilegeSetAuditor        = "Auditor"
+	PrivilegeSetEnrollmentOnly = "Enrollment Only"
+	PrivilegeSetCustom         = "Custom"
+)
+
+type GroupAccountsService interface {
+	List(context.Context) ([]GroupAccountListItem, *Response, error)
+	GetByID(context.Context, int) (*GroupAccount, *Response, error)
+	GetByName(context.Context, string) (*GroupAccount, *Response, error)
+	Create(context.Context, *GroupAccountRequest) (*GroupAccount, *Response, error)
+	Update(context.Context, int, *GroupAccountRequest) (*GroupAccount, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+	AddPrivileges(context.Context, int, AccountPrivileges) (*GroupAccount, *Response, error)
+	RemovePrivileges(context.Context, int, AccountPrivileges) (*GroupAccount, *Response, error)
+}
+
+// GroupAccountsServiceOp handles communication with the account group-related
+// methods of the Jamf Pro API.
+type GroupAccountsServiceOp struct {
+	client *Client
+}
+
+var _ GroupAccountsService = &GroupAccountsServiceOp{}
+
+// GroupAccount represents a Jamf Pro account group, whose members share its access level and privileges. A group
+// linked to an LDAP server grants them to the members of the directory group of the same name.
+type GroupAccount struct {
+	XMLName      xml.Name           `xml:"group"`
+	Id           ID                 `xml:"id"`
+	Name         string             `xml:"name"`
+	AccessLevel  AccessLevel        `xml:"access_level"`
+	PrivilegeSet string             `xml:"privilege_set"`
+	Site         *ClassicReference  `xml:"site"`
+	LdapServer   *ClassicReference  `xml:"ldap_server"`
+	Privileges   AccountPrivileges  `xml:"privileges"`
+	Members      []ClassicReference `xml:"members>user"`
+}
+
+// IsDirectoryGroup reports whether the group is linked to a group of an LDAP server.
+func (g *GroupAccount) IsDirectoryGroup() bool {
+	return g.LdapServer != nil && g.LdapServer.Id.Int() > 0
+}
+
+// AccountPrivileges are the privileges of a Jamf Pro account or account group, by kind, named as in privilegenames.go.
+type AccountPrivileges struct {
+	JssObjects  []string `xml:"jss_objects>privilege"`
+	JssSettings []string `xml:"jss_settings>privilege"`
+	JssActions  []string `xml:"jss_actions>privilege"`
+	CasperAdmin []string `xml:"casper_admin>privilege"`
+}
+
+// GroupAccountListItem is the summary of an account group returned when listing all accounts
+type GroupAccountListItem struct {
+	Id   ID     `xml:"id"`
+	Name string `xml:"name"`
+}
+
+// GroupAccountListResponse represents the raw API response to getting all accounts, of which only the groups are kept
+type GroupAccountListResponse struct {
+	XMLName xml.Name               `xml:"accounts"`
+	Groups  []GroupAccountListItem `xml:"groups>group"`
+}
+
+// GroupAccountRequest represents a request to create or update an account group. Set LdapServer to link the group to
+// the group of the same name on that LDAP server. Privileges are only sent when set, and replace those of the group.
+type GroupAccountRequest struct {
+	XMLName      xml.Name           `xml:"group"`
+	Name         string             `xml:"name,omitempty"`
+	AccessLevel  AccessLevel        `xml:"access_level,omitempty"`
+	PrivilegeSet string             `xml:"privilege_set,omitempty"`
+	Site         *ClassicReference  `xml:"site,omitempty"`
+	LdapServer   *ClassicReference  `xml:"ldap_server,omitempty"`
+	Privileges   *AccountPrivileges `xml:"privileges,omitempty"`
+}
+
+type GroupAccountResponse struct {
+	Id ID `xml:"id"`
+}
+
+func (g *GroupAccountsServiceOp) List(ctx context.Context) ([]GroupAccountListItem, *Response, error) {
+	req, err := g.client.NewRequest(ctx, http.MethodGet, accountsBasePath, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var listResponse GroupAccountListResponse
+	resp, err := g.client.Do(ctx, req, &listResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return listResponse.Groups, resp, err
+}
+
+func (g *GroupAccountsServiceOp) GetByID(ctx context.Context, id int) (*GroupAccount, *Response, error) {
+	path := groupAccountsBasePath + "/" + strconv.Itoa(id)
+	return g.get(ctx, path)
+}
+
+func (g *GroupAccountsServiceOp) GetByName(ctx context.Context, name string) (*GroupAccount, *Response, error) {
+	path := pathJoin(accountsBasePath, "groupname", name)
+	return g.get(ctx, path)
+}
+
+// Create creates an account group in Jamf Pro and returns the record as stored by the server.
+func (g *GroupAccountsServiceOp) Create(ctx context.Context, request *GroupAccountRequest) (*GroupAccount, *Response, error) {
+	path := groupAccountsBasePath + "/0"
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	} else if request.Name == "" {
+		return nil, nil, NewArgError("name", "cannot be empty")
+	}
+
+	req, err := g.client.NewRequest(ctx, http.MethodPost, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groupCreation := new(GroupAccountResponse)
+	resp, err := g.client.Do(ctx, req, groupCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, groupCreation.Id, g.GetByID)
+}
+
+// Update updates an account group in Jamf Pro and returns the record as stored by the server. Fields left empty in
+// request are kept.
+func (g *GroupAccountsServiceOp) Update(ctx context.Context, id int, request *GroupAccountRequest) (*GroupAccount, *Response, error) {
+	path := groupAccountsBasePath + "/" + strconv.Itoa(id)
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if id == 0 {
+		return nil, nil, NewArgError("account group ID", "cannot be 0")
+	}
+
+	req, err := g.client.NewRequest(ctx, http.MethodPut, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groupUpdate := new(GroupAccountResponse)
+	resp, err := g.client.Do(ctx, req, groupUpdate)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return g.GetByID(ctx, id)
+}
+
+func (g *GroupAccountsServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := groupAccountsBasePath + "/" + strconv.Itoa(id)
+
+	req, err := g.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := g.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DeleteByName deletes the account group called name. The error matches ErrNotFound if there is none.
+func (g *GroupAccountsServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "account group", name, g.GetByName, func(group *GroupAccount) ID { return group.Id }, g.Delete)
+}
+
+// AddPrivileges grants the group the given privileges on top of those it has, switching it to the custom privilege
+// set, and returns the group as stored by the server.
+func (g *GroupAccountsServiceOp) AddPrivileges(ctx context.Context, id int, privileges AccountPrivileges) (*GroupAccount, *Response, error) {
+	return g.updatePrivileges(ctx, id, unionPrivileges, privileges)
+}
+
+// RemovePrivileges revokes the given privileges from the group, switching it to the custom privilege set, and returns
+// the group as stored by the server. Privileges the group does not have are ignored.
+func (g *GroupAccountsServiceOp) RemovePrivileges(ctx context.Context, id int, privileges AccountPrivileges) (*GroupAccount, *Response, error) {
+	return g.updatePrivileges(ctx, id, subtractPrivileges, privileges)
+}
+
+// updatePrivileges fetches the group, combines each kind of its privileges with those of changed and sends the result.
+func (g *GroupAccountsServiceOp) updatePrivileges(ctx context.Context, id int, combine func(current, changed []string) []string, changed AccountPrivileges) (*GroupAccount, *Response, error) {
+	if id == 0 {
+		return nil, nil, NewArgError("account group ID", "cannot be 0")
+	}
+
+	group, resp, err := g.GetByID(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	privileges := AccountPrivileges{
+		JssObjects:  combine(group.Privileges.JssObjects, changed.JssObjects),
+		JssSettings: combine(group.Privileges.JssSettings, changed.JssSettings),
+		JssActions:  combine(group.Privileges.JssActions, changed.JssActions),
+		CasperAdmin: combine(group.Privileges.CasperAdmin, changed.CasperAdmin),
+	}
+
+	return g.Update(ctx, id, &GroupAccountRequest{PrivilegeSet: PrivilegeSetCustom, Privileges: &privileges})
+}
+
+func (g *GroupAccountsServiceOp) get(ctx context.Context, path string) (*GroupAccount, *Response, error) {
+	req, err := g.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var group GroupAccount
+	resp, err := g.client.Do(ctx, req, &group)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &group, resp, err
+}
+
+// unionPrivileges returns current followed by the privileges of added it does not contain yet.
+func unionPrivileges(current, added []string) []string {
+	has := make(map[string]bool, len(current))
+	result := append([]string(nil), current...)
+	for _, privilege := range current {
+		has[privilege] = true
+	}
+	for _, privilege := range added {
+		if !has[privilege] {
+			has[privilege] = true
+			result = append(result, privilege)
+		}
+	}
+	return result
+}
+
+// subtractPrivileges returns the privileges of current that are not in removed.
+func subtractPrivileges(current, removed []string) []string {
+	drop := make(map[string]bool, len(removed))
+	for _, privilege := range removed {
+		drop[privilege] = true
+	}
+	var result []string
+	for _, privilege := range current {
+		if !drop[privilege] {
+			result = append(result, privilege)
+		}
+	}
+	return result
+}
@@ -0,0 +1,181 @@
+package jamfpro
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// computerGroupCriterion is the criterion name smart groups use to reference other computer groups
+const computerGroupCriterion = "Computer Group"
+
+// GroupDependencyError reports the problems ResolveGroupDependencies found in the groups referenced by smart
+// groups. Jamf Pro accepts such groups, but fails to evaluate them later with an error that does not name the cause.
+type GroupDependencyError struct {
+	// Missing maps the name of each group that does not exist to the groups referencing it.
+	Missing map[string][]string
+
+	// Cycles lists each chain of groups referencing one another, starting and ending with the same group.
+	Cycles [][]string
+}
+
+func (e *GroupDependencyError) Error() string {
+	missing := make([]string, 0, len(e.Missing))
+	for name := range e.Missing {
+		missing = append(missing, name)
+	}
+	sort.Strings(missing)
+
+	var problems []string
+	for _, name := range missing {
+		problems = append(problems, fmt.Sprintf("%q does not exist but is referenced by %s", name, quoteNames(e.Missing[name], ", ")))
+	}
+	for _, cycle := range e.Cycles {
+		problems = append(problems, "groups reference each other: "+quoteNames(cycle, " -> "))
+	}
+	return "jamfpro: invalid computer group references: " + strings.Join(problems, "; ")
+}
+
+// GroupDependencies is the graph of the computer groups referenced through "member of" and "not member of" criteria.
+type GroupDependencies struct {
+	// References maps the name of each group in the graph to the names of the groups its criteria reference.
+	References map[string][]string
+
+	// CreationOrder lists the planned groups so that every group comes after the planned groups it references.
+	CreationOrder []string
+}
+
+// ResolveGroupDependencies follows the groups referenced by the criteria of the planned groups, through the planned
+// groups themselves and the smart groups Jamf Pro already holds, and checks that every referenced group exists and
+// that no groups reference each other in a cycle. Planned groups take precedence over existing groups of the same
+// name, so a batch of groups can be checked before any of them is created or updated.
+//
+// The problems found are returned as a *GroupDependencyError; the returned dependencies are valid either way.
+func ResolveGroupDependencies(ctx context.Context, groups ComputerGroupsService, planned ...*ComputerGroupRequest) (*GroupDependencies, error) {
+	existing, _, err := groups.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existingIds := make(map[string]int, len(existing))
+	for _, group := range existing {
+		existingIds[group.Name] = group.Id.Int()
+	}
+
+	criteria := make(map[string][]ComputerGroupCriteria, len(planned))
+	var plannedNames []string
+	for _, group := range planned {
+		if group == nil {
+			return nil, NewArgError("planned", "cannot contain nil groups")
+		}
+		criteria[group.Name] = group.Criteria
+		plannedNames = append(plannedNames, group.Name)
+	}
+
+	dependencies := &GroupDependencies{References: make(map[string][]string)}
+	dependencyErr := &GroupDependencyError{Missing: make(map[string][]string)}
+
+	// Walk the graph breadth first, fetching the criteria of the existing groups that are reached.
+	queue := append([]string(nil), plannedNames...)
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if _, ok := dependencies.References[name]; ok {
+			continue
+		}
+
+		groupCriteria, ok := criteria[name]
+		if !ok {
+			group, _, err := groups.GetByID(ctx, existingIds[name])
+			if err != nil {
+				return nil, err
+			}
+			groupCriteria = group.Criteria
+		}
+
+		references := groupReferences(groupCriteria)
+		dependencies.References[name] = references
+		for _, reference := range references {
+			_, isPlanned := criteria[reference]
+			if _, exists := existingIds[reference]; !isPlanned && !exists {
+				dependencyErr.Missing[reference] = append(dependencyErr.Missing[reference], name)
+				continue
+			}
+			queue = append(queue, reference)
+		}
+	}
+
+	// Depth first search for cycles, which also yields the planned groups in an order they can be created in.
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var stack []string
+	var visit func(string)
+	visit = func(name string) {
+		switch state[name] {
+		case visiting:
+			for i := range stack {
+				if stack[i] == name {
+					cycle := append(append([]string(nil), stack[i:]...), name)
+					dependencyErr.Cycles = append(dependencyErr.Cycles, cycle)
+					break
+				}
+			}
+			return
+		case visited:
+			return
+		}
+
+		state[name] = visiting
+		stack = append(stack, name)
+		for _, reference := range dependencies.References[name] {
+			visit(reference)
+		}
+		stack = stack[:len(stack)-1]
+		state[name] = visited
+
+		if _, ok := criteria[name]; ok {
+			dependencies.CreationOrder = append(dependencies.CreationOrder, name)
+		}
+	}
+	for _, name := range plannedNames {
+		visit(name)
+	}
+
+	if len(dependencyErr.Missing) > 0 || len(dependencyErr.Cycles) > 0 {
+		return dependencies, dependencyErr
+	}
+	return dependencies, nil
+}
+
+// groupReferences returns the names of the groups referenced by criteria, in order and without duplicates.
+func groupReferences(criteria []ComputerGroupCriteria) []string {
+	seen := make(map[string]bool)
+	var references []string
+	for _, criterion := range criteria {
+		if criterion.Name != computerGroupCriterion {
+			continue
+		}
+		switch SearchType(criterion.SearchType) {
+		case SearchTypeMemberOf, SearchTypeNotMemberOf:
+		default:
+			continue
+		}
+		if !seen[criterion.Value] {
+			seen[criterion.Value] = true
+			references = append(references, criterion.Value)
+		}
+	}
+	return references
+}
+
+func quoteNames(names []string, sep string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = fmt.Sprintf("%q", name)
+	}
+	return strings.Join(quoted, sep)
+}
@@ -0,0 +1,214 @@
+package jamfpro
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const defaultWatcherBuffer = 16
+
+// SmartGroupMembershipEvent reports the computers that joined or left a smart computer group.
+type SmartGroupMembershipEvent struct {
+	GroupId            ID
+	GroupName          string
+	AddedComputerIds   []ID
+	RemovedComputerIds []ID
+	Time               time.Time
+}
+
+// SmartGroupMembershipWatcherOptions configures NewSmartGroupMembershipWatcher.
+type SmartGroupMembershipWatcherOptions struct {
+	// Name is the name of the webhook registered in Jamf Pro.
+	Name string
+
+	// URL is the address Jamf Pro posts membership changes to, which must reach the watcher's ServeHTTP.
+	URL string
+
+	// GroupId limits the events to a single smart group. All smart computer groups are watched if it is unset.
+	GroupId ID
+
+	// Buffer is how many events are held for the receiver of Events before deliveries wait. It defaults to 16.
+	Buffer int
+}
+
+// SmartGroupMembershipWatcher turns smart computer group membership changes into events on a channel. It registers
+// a webhook with a random password, and, as an http.Handler, accepts the deliveries made with that password:
+//
+//	watcher, err := jamfpro.NewSmartGroupMembershipWatcher(ctx, client.Webhooks, jamfpro.SmartGroupMembershipWatcherOptions{
+//		Name: "remediation",
+//		URL:  "https://hooks.example.com/jamf",
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	defer watcher.Close(context.Background())
+//	go http.ListenAndServe(":8443", watcher)
+//	for event := range watcher.Events() {
+//		remediate(event.AddedComputerIds)
+//	}
+type SmartGroupMembershipWatcher struct {
+	webhooks  WebhooksService
+	webhookId int
+	username  string
+	password  string
+	events    chan SmartGroupMembershipEvent
+
+	mu        sync.RWMutex
+	closed    bool
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// smartGroupMembershipDelivery is the JSON body of a smart group membership change webhook
+type smartGroupMembershipDelivery struct {
+	Webhook struct {
+		Id             int    `json:"id"`
+		WebhookEvent   string `json:"webhookEvent"`
+		EventTimestamp int64  `json:"eventTimestamp"`
+	} `json:"webhook"`
+	Event struct {
+		JssId                  ID     `json:"jssid"`
+		Name                   string `json:"name"`
+		SmartGroup             bool   `json:"smartGroup"`
+		GroupAddedDevicesIds   []ID   `json:"groupAddedDevicesIds"`
+		GroupRemovedDevicesIds []ID   `json:"groupRemovedDevicesIds"`
+	} `json:"event"`
+}
+
+// NewSmartGroupMembershipWatcher registers a webhook for smart computer group membership changes and returns the
+// watcher serving it. Close removes the webhook again.
+func NewSmartGroupMembershipWatcher(ctx context.Context, webhooks WebhooksService, opts SmartGroupMembershipWatcherOptions) (*SmartGroupMembershipWatcher, error) {
+	if opts.Name == "" {
+		return nil, NewArgError("Name", "cannot be empty")
+	}
+	if opts.URL == "" {
+		return nil, NewArgError("URL", "cannot be empty")
+	}
+	if opts.Buffer <= 0 {
+		opts.Buffer = defaultWatcherBuffer
+	}
+
+	password, err := randomSecret()
+	if err != nil {
+		return nil, err
+	}
+	w := &SmartGroupMembershipWatcher{
+		webhooks: webhooks,
+		username: "jamfpro",
+		password: password,
+		events:   make(chan SmartGroupMembershipEvent, opts.Buffer),
+		done:     make(chan struct{}),
+	}
+
+	webhook, _, err := webhooks.Create(ctx, &Webhook{
+		Name:               opts.Name,
+		Enabled:            true,
+		URL:                opts.URL,
+		ContentType:        "application/json",
+		Event:              WebhookEventSmartGroupComputerMembershipChange,
+		AuthenticationType: WebhookAuthenticationBasic,
+		Username:           w.username,
+		Password:           w.password,
+		SmartGroupId:       opts.GroupId.Int(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	w.webhookId = webhook.Id.Int()
+	return w, nil
+}
+
+// Events returns the channel membership changes are sent on. It is closed by Close.
+func (w *SmartGroupMembershipWatcher) Events() <-chan SmartGroupMembershipEvent {
+	return w.events
+}
+
+// WebhookId returns the ID of the webhook registered for the watcher.
+func (w *SmartGroupMembershipWatcher) WebhookId() int {
+	return w.webhookId
+}
+
+// ServeHTTP accepts a delivery of the watcher's webhook and sends its event to Events. Deliveries without the
+// webhook's credentials, or from other webhooks, are refused. While the buffer of Events is full, ServeHTTP waits
+// until there is room or the delivery is abandoned.
+func (w *SmartGroupMembershipWatcher) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		rw.Header().Set("Allow", http.MethodPost)
+		http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	username, password, ok := r.BasicAuth()
+	if !ok || subtle.ConstantTimeCompare([]byte(username), []byte(w.username)) != 1 ||
+		subtle.ConstantTimeCompare([]byte(password), []byte(w.password)) != 1 {
+		http.Error(rw, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var delivery smartGroupMembershipDelivery
+	if err := json.NewDecoder(http.MaxBytesReader(rw, r.Body, 1<<20)).Decode(&delivery); err != nil {
+		http.Error(rw, "invalid webhook body", http.StatusBadRequest)
+		return
+	}
+	if delivery.Webhook.Id != w.webhookId || delivery.Webhook.WebhookEvent != WebhookEventSmartGroupComputerMembershipChange {
+		http.Error(rw, "unexpected webhook", http.StatusBadRequest)
+		return
+	}
+
+	event := SmartGroupMembershipEvent{
+		GroupId:            delivery.Event.JssId,
+		GroupName:          delivery.Event.Name,
+		AddedComputerIds:   delivery.Event.GroupAddedDevicesIds,
+		RemovedComputerIds: delivery.Event.GroupRemovedDevicesIds,
+		Time:               time.UnixMilli(delivery.Webhook.EventTimestamp),
+	}
+
+	// The read lock keeps Close from closing the channel while the event is being sent.
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		http.Error(rw, "watcher closed", http.StatusServiceUnavailable)
+		return
+	}
+	select {
+	case w.events <- event:
+		rw.WriteHeader(http.StatusNoContent)
+	case <-r.Context().Done():
+	case <-w.done:
+		http.Error(rw, "watcher closed", http.StatusServiceUnavailable)
+	}
+}
+
+// Close deletes the webhook and closes the Events channel. Deliveries arriving afterwards are refused.
+func (w *SmartGroupMembershipWatcher) Close(ctx context.Context) error {
+	first := false
+	w.closeOnce.Do(func() {
+		first = true
+		// Release deliveries waiting for room in the buffer, as they hold the read lock.
+		close(w.done)
+		w.mu.Lock()
+		w.closed = true
+		close(w.events)
+		w.mu.Unlock()
+	})
+	if !first {
+		return nil
+	}
+
+	_, err := w.webhooks.Delete(ctx, w.webhookId)
+	return err
+}
+
+func randomSecret() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
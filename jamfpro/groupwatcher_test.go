@@ -0,0 +1,52 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSmartGroupMembershipWatcherEvents(t *testing.T) {
+	var registered Webhook
+	client, _ := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		if r.Method == http.MethodPost {
+			if err := xml.NewDecoder(r.Body).Decode(&registered); err != nil {
+				t.Errorf("decoding the webhook: %v", err)
+			}
+		}
+		w.Write([]byte(`<webhook><id>4</id><name>remediation</name></webhook>`))
+	}))
+
+	ctx := context.Background()
+	watcher, err := NewSmartGroupMembershipWatcher(ctx, client.Webhooks, SmartGroupMembershipWatcherOptions{
+		Name:    "remediation",
+		URL:     "https://hooks.example.com/jamf",
+		GroupId: FromInt(9),
+	})
+	if err != nil {
+		t.Fatalf("NewSmartGroupMembershipWatcher: %v", err)
+	}
+	if registered.SmartGroupId != 9 {
+		t.Errorf("registered the webhook for smart group %d, want 9", registered.SmartGroupId)
+	}
+
+	body := `{"webhook":{"id":4,"webhookEvent":"` + WebhookEventSmartGroupComputerMembershipChange + `","eventTimestamp":1700000000000},` +
+		`"event":{"jssid":9,"name":"Outdated","smartGroup":true,"groupAddedDevicesIds":[12,13],"groupRemovedDevicesIds":[7]}}`
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.SetBasicAuth(watcher.username, watcher.password)
+	rec := httptest.NewRecorder()
+	watcher.ServeHTTP(rec, req)
+	if rec.Code >= 300 {
+		t.Fatalf("ServeHTTP answered %d: %s", rec.Code, rec.Body)
+	}
+
+	event := <-watcher.Events()
+	if event.GroupId != FromInt(9) || !reflect.DeepEqual(event.AddedComputerIds, []ID{"12", "13"}) || !reflect.DeepEqual(event.RemovedComputerIds, []ID{"7"}) {
+		t.Errorf("event = %+v, want group 9 gaining computers 12 and 13 and losing 7", event)
+	}
+}
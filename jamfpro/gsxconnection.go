@@ -0,0 +1,84 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+)
+
+const gsxConnectionBasePath = "uapi/v1/gsx-connection"
+
+type GSXConnectionService interface {
+	Get(context.Context) (*GSXConnection, *Response, error)
+	Update(context.Context, *GSXConnection) (*GSXConnection, *Response, error)
+	ListHistory(context.Context, *ListOptions) ([]HistoryEntry, *Response, error)
+	AddHistoryNote(context.Context, string) (*HistoryNoteResponse, *Response, error)
+}
+
+// GSXConnectionServiceOp handles communication with the GSX connection
+// methods of the Jamf Pro API.
+type GSXConnectionServiceOp struct {
+	client *Client
+}
+
+var _ GSXConnectionService = &GSXConnectionServiceOp{}
+
+// GSXConnection represents the connection Jamf Pro uses to look up warranty and purchasing information in Apple's
+// Global Service Exchange. Use ConnectionTests.GSX to check it works.
+type GSXConnection struct {
+	Enabled          bool         `json:"enabled"`
+	Username         string       `json:"username"`
+	ServiceAccountNo string       `json:"serviceAccountNo"`
+	ShipToNo         string       `json:"shipToNo,omitempty"`
+	GsxKeystore      *GSXKeystore `json:"gsxKeystore,omitempty"`
+}
+
+// GSXKeystore describes the certificate Jamf Pro authenticates to GSX with.
+type GSXKeystore struct {
+	Name            string `json:"name"`
+	ExpirationEpoch int64  `json:"expirationEpoch"`
+	ErrorMessage    string `json:"errorMessage,omitempty"`
+}
+
+func (g *GSXConnectionServiceOp) Get(ctx context.Context) (*GSXConnection, *Response, error) {
+	req, err := g.client.NewRequest(ctx, http.MethodGet, gsxConnectionBasePath, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var connection GSXConnection
+	resp, err := g.client.Do(ctx, req, &connection)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &connection, resp, err
+}
+
+func (g *GSXConnectionServiceOp) Update(ctx context.Context, connection *GSXConnection) (*GSXConnection, *Response, error) {
+	if connection == nil {
+		return nil, nil, NewArgError("connection", "cannot be nil")
+	}
+
+	req, err := g.client.NewRequest(ctx, http.MethodPut, gsxConnectionBasePath, connection, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated GSXConnection
+	resp, err := g.client.Do(ctx, req, &updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &updated, resp, err
+}
+
+// ListHistory returns the change history of the GSX connection.
+func (g *GSXConnectionServiceOp) ListHistory(ctx context.Context, opts *ListOptions) ([]HistoryEntry, *Response, error) {
+	return listHistory(ctx, g.client, gsxConnectionBasePath+"/history", opts)
+}
+
+// AddHistoryNote adds a note to the change history of the GSX connection.
+func (g *GSXConnectionServiceOp) AddHistoryNote(ctx context.Context, note string) (*HistoryNoteResponse, *Response, error) {
+	return addHistoryNote(ctx, g.client, gsxConnectionBasePath+"/history", note)
+}
@@ -0,0 +1,157 @@
+package jamfpro
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	healthCheckPath   = "healthCheck.html"
+	startupStatusPath = "uapi/startup-status"
+
+	// startupStepComplete is the startup step Jamf Pro reports once it has finished initialising
+	startupStepComplete = "SERVER_INIT_COMPLETE"
+
+	healthCheckPollInterval = 5 * time.Second
+)
+
+// HealthStatus describes whether a Jamf Pro server is ready to serve API requests
+type HealthStatus struct {
+	Healthy bool
+	// StatusCode is the HTTP status code returned by the health check page
+	StatusCode int
+	// Issues lists the problems reported by the health check page. It is empty for a healthy server.
+	Issues []HealthCheckIssue
+	// Startup is the startup progress reported by the server, if it could be retrieved
+	Startup *StartupStatus
+}
+
+// HealthCheckIssue is a single problem reported by the Jamf Pro health check page, e.g. a database connection error
+type HealthCheckIssue struct {
+	HealthCode  int    `json:"healthCode"`
+	HttpCode    int    `json:"httpCode"`
+	Description string `json:"description"`
+}
+
+// StartupStatus represents the raw API response of the Jamf Pro startup status endpoint
+type StartupStatus struct {
+	Step                    string `json:"step"`
+	StepCode                string `json:"stepCode"`
+	StepParam               string `json:"stepParam"`
+	Percentage              int    `json:"percentage"`
+	Warning                 string `json:"warning"`
+	WarningCode             string `json:"warningCode"`
+	WarningParam            string `json:"warningParam"`
+	Error                   string `json:"error"`
+	ErrorCode               string `json:"errorCode"`
+	SetupAssistantNecessary bool   `json:"setupAssistantNecessary"`
+}
+
+// IsComplete reports whether the server has finished starting up
+func (s *StartupStatus) IsComplete() bool {
+	return s.StepCode == startupStepComplete || s.Percentage == 100
+}
+
+// HealthCheck queries the Jamf Pro health check page and startup status endpoint. Neither requires authentication,
+// so this can be used before a bearer token is available. An unhealthy server is reported through the returned
+// HealthStatus rather than as an error; an error is only returned if the server could not be reached at all.
+func (c *Client) HealthCheck(ctx context.Context) (*HealthStatus, *Response, error) {
+	req, err := c.NewRequest(withoutToken(ctx), http.MethodGet, healthCheckPath, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := &HealthStatus{}
+	body := new(bytes.Buffer)
+	resp, err := c.Do(ctx, req, body)
+	if err != nil {
+		errorResponse, ok := err.(*ErrorResponse)
+		if !ok {
+			return nil, resp, err
+		}
+		status.StatusCode = errorResponse.Response.StatusCode
+		body = bytes.NewBufferString(errorResponse.Message)
+	} else {
+		status.StatusCode = resp.StatusCode
+	}
+
+	if body.Len() > 0 {
+		if err := json.Unmarshal(body.Bytes(), &status.Issues); err != nil {
+			status.Issues = []HealthCheckIssue{{HttpCode: status.StatusCode, Description: body.String()}}
+		}
+	}
+
+	startup, _, err := c.StartupStatus(ctx)
+	if err == nil {
+		status.Startup = startup
+	}
+
+	status.Healthy = status.StatusCode == http.StatusOK && len(status.Issues) == 0 &&
+		(status.Startup == nil || status.Startup.IsComplete())
+	return status, resp, nil
+}
+
+// StartupStatus returns the startup progress of the Jamf Pro server.
+func (c *Client) StartupStatus(ctx context.Context) (*StartupStatus, *Response, error) {
+	req, err := c.NewRequest(withoutToken(ctx), http.MethodGet, startupStatusPath, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var startupStatus StartupStatus
+	resp, err := c.Do(ctx, req, &startupStatus)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &startupStatus, resp, err
+}
+
+// WaitUntilReady polls HealthCheck until the server reports healthy, the timeout elapses or ctx is cancelled.
+// Connection errors are treated as the server not being ready yet. If the client has not been able to obtain a bearer
+// token so far (e.g. because NewClient was called while the server was still starting), one is requested once the
+// server is healthy.
+func (c *Client) WaitUntilReady(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(healthCheckPollInterval)
+	defer ticker.Stop()
+
+	var lastErr error
+	for {
+		status, _, err := c.HealthCheck(ctx)
+		if err == nil && status.Healthy {
+			if !c.hasToken() {
+				return errors.Wrap(c.refreshAuthToken(ctx), "Error getting bearer auth token")
+			}
+			return nil
+		} else if err != nil {
+			lastErr = err
+		} else {
+			lastErr = describeHealthStatus(status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("jamf pro server %s not ready after %s: %v", c.instanceUrl, timeout, lastErr)
+		case <-ticker.C:
+		}
+	}
+}
+
+func describeHealthStatus(status *HealthStatus) error {
+	if len(status.Issues) > 0 {
+		return fmt.Errorf("health check returned %d: %s", status.StatusCode, status.Issues[0].Description)
+	}
+	if status.Startup != nil && !status.Startup.IsComplete() {
+		return fmt.Errorf("server is starting up (%s, %d%%)", status.Startup.StepCode, status.Startup.Percentage)
+	}
+	return fmt.Errorf("health check returned %d", status.StatusCode)
+}
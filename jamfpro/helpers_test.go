@@ -0,0 +1,71 @@
+package jamfpro
+
+import (
+	"compress/gzip"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newTestClient starts a server that issues bearer tokens and passes every other request to handler, and returns a
+// client for it. The server is closed when the test ends.
+func newTestClient(tb testing.TB, handler http.Handler, opts ...ClientOption) (*Client, *httptest.Server) {
+	tb.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == uriOAuthToken {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"access_token":"token","expires_in":3600}`)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	tb.Cleanup(server.Close)
+
+	client, err := NewClient("client-id", "client-secret", server.URL, "", opts...)
+	if err != nil {
+		tb.Fatalf("NewClient: %v", err)
+	}
+	return client, server
+}
+
+// computerListJSON returns a Classic API list of n computers.
+func computerListJSON(n int) string {
+	var b strings.Builder
+	b.WriteString(`{"computers":[`)
+	for i := 1; i <= n; i++ {
+		if i > 1 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, `{"id":%d,"name":"MacBook-%05d"}`, i, i)
+	}
+	b.WriteString(`]}`)
+	return b.String()
+}
+
+// writeBody writes body with the given content type, gzip compressed if the request accepts it.
+func writeBody(w http.ResponseWriter, r *http.Request, contentType, body string) {
+	w.Header().Set("Content-Type", contentType)
+	if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+		fmt.Fprint(w, body)
+		return
+	}
+
+	w.Header().Set("Content-Encoding", "gzip")
+	zw := gzip.NewWriter(w)
+	fmt.Fprint(zw, body)
+	zw.Close()
+}
+
+// countingWriter is a http.ResponseWriter counting the bytes of the body written to it.
+type countingWriter struct {
+	http.ResponseWriter
+	n *int64
+}
+
+func (w countingWriter) Write(p []byte) (int, error) {
+	*w.n += int64(len(p))
+	return w.ResponseWriter.Write(p)
+}
@@ -0,0 +1,77 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+)
+
+// HistoryEntry is a single entry in the change history the Jamf Pro API keeps for many objects and settings
+type HistoryEntry struct {
+	Id       ID     `json:"id"`
+	Username string `json:"username"`
+	Date     string `json:"date"`
+	Note     string `json:"note"`
+	Details  string `json:"details"`
+}
+
+// HistoryListResponse represents the raw API response to getting the history of an object
+type HistoryListResponse struct {
+	TotalCount *int64          `json:"totalCount"`
+	Entries    *[]HistoryEntry `json:"results"`
+}
+
+// HistoryNoteRequest represents a request to add a note to the history of an object.
+type HistoryNoteRequest struct {
+	Note string `json:"note"`
+}
+
+// HistoryNoteResponse represents an API response to adding a history note
+type HistoryNoteResponse struct {
+	Id   ID     `json:"id"`
+	Href string `json:"href"`
+}
+
+// listHistory fetches the history entries found at path, the history endpoint of an object or setting
+func listHistory(ctx context.Context, client *Client, path string, opts *ListOptions) ([]HistoryEntry, *Response, error) {
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var historyResponse HistoryListResponse
+	resp, err := client.Do(ctx, req, &historyResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if historyResponse.Entries == nil {
+		return nil, resp, err
+	}
+
+	return *historyResponse.Entries, resp, err
+}
+
+// addHistoryNote adds a note to the history endpoint at path
+func addHistoryNote(ctx context.Context, client *Client, path string, note string) (*HistoryNoteResponse, *Response, error) {
+	if note == "" {
+		return nil, nil, NewArgError("note", "cannot be empty")
+	}
+
+	req, err := client.NewRequest(ctx, http.MethodPost, path, &HistoryNoteRequest{Note: note}, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var noteResponse HistoryNoteResponse
+	resp, err := client.Do(ctx, req, &noteResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &noteResponse, resp, err
+}
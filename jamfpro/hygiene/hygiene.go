@@ -0,0 +1,144 @@
+// Package hygiene finds buildings, departments and categories whose names only differ in case or whitespace, which
+// accumulate when several admins and integrations create them, and merges each set of duplicates into one.
+//
+//	duplicates, err := hygiene.FindDuplicates(ctx, client)
+//	if err != nil {
+//		return err
+//	}
+//	for _, d := range duplicates {
+//		log.Printf("%s %q: keeping %d, removing %v", d.Kind, d.Keep.Name, d.Keep.Id.Int(), d.Remove())
+//		report, err := hygiene.Merge(ctx, client, d, bulk.Options{Concurrency: 4, MaxRetries: 2})
+//		if err != nil {
+//			log.Printf("merging %q: %v", d.Keep.Name, err)
+//		}
+//		log.Printf("re-pointed %d objects, deleted %d duplicates", report.Moves.Succeeded, len(report.Deleted))
+//	}
+package hygiene
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/jc0b/go-jamfpro-api/jamfpro"
+)
+
+// Kind is a kind of object checked for duplicates
+type Kind string
+
+const (
+	Buildings   Kind = "building"
+	Departments Kind = "department"
+	Categories  Kind = "category"
+)
+
+// Object is a building, department or category.
+type Object struct {
+	Id   jamfpro.ID
+	Name string
+}
+
+// Duplicates is a set of objects of the same kind whose names normalize to the same Key.
+type Duplicates struct {
+	Kind Kind
+	Key  string
+
+	// Objects are the duplicates, ordered by ID.
+	Objects []Object
+
+	// Keep is the object the others are merged into. FindDuplicates picks the oldest, the one with the lowest ID,
+	// but it can be set to any of Objects before calling Merge.
+	Keep Object
+}
+
+// Remove returns the objects that Merge deletes: every object but Keep.
+func (d Duplicates) Remove() []Object {
+	var remove []Object
+	for _, object := range d.Objects {
+		if object.Id != d.Keep.Id {
+			remove = append(remove, object)
+		}
+	}
+	return remove
+}
+
+// Normalize returns the key names are compared by: the name in lower case, with leading and trailing whitespace
+// removed and inner runs of whitespace collapsed to a single space.
+func Normalize(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// FindDuplicates lists the objects of the given kinds, or of all kinds if none are given, and returns the sets of
+// objects whose names are the same once normalized. Objects with unique names are not returned.
+func FindDuplicates(ctx context.Context, client *jamfpro.Client, kinds ...Kind) ([]Duplicates, error) {
+	if client == nil {
+		return nil, jamfpro.NewArgError("client", "cannot be nil")
+	}
+	if len(kinds) == 0 {
+		kinds = []Kind{Buildings, Departments, Categories}
+	}
+
+	var duplicates []Duplicates
+	for _, kind := range kinds {
+		objects, err := list(ctx, client, kind)
+		if err != nil {
+			return nil, err
+		}
+		duplicates = append(duplicates, group(kind, objects)...)
+	}
+	return duplicates, nil
+}
+
+// list returns every object of kind.
+func list(ctx context.Context, client *jamfpro.Client, kind Kind) ([]Object, error) {
+	var objects []Object
+	switch kind {
+	case Buildings:
+		buildings, _, err := client.Buildings.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range buildings {
+			objects = append(objects, Object{Id: buildings[i].GetId(), Name: buildings[i].GetName()})
+		}
+	case Departments:
+		departments, _, err := client.Departments.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, department := range departments {
+			objects = append(objects, Object{Id: department.Id, Name: department.Name})
+		}
+	case Categories:
+		categories, _, err := client.Categories.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, category := range categories {
+			objects = append(objects, Object{Id: category.Id, Name: category.Name})
+		}
+	default:
+		return nil, jamfpro.NewArgError("kind", "must be one of building, department or category, not "+string(kind))
+	}
+	return objects, nil
+}
+
+// group returns the sets of objects sharing a normalized name, ordered by key.
+func group(kind Kind, objects []Object) []Duplicates {
+	byKey := make(map[string][]Object)
+	for _, object := range objects {
+		key := Normalize(object.Name)
+		byKey[key] = append(byKey[key], object)
+	}
+
+	var duplicates []Duplicates
+	for key, objects := range byKey {
+		if len(objects) < 2 {
+			continue
+		}
+		sort.Slice(objects, func(i, j int) bool { return objects[i].Id.Int() < objects[j].Id.Int() })
+		duplicates = append(duplicates, Duplicates{Kind: kind, Key: key, Objects: objects, Keep: objects[0]})
+	}
+	sort.Slice(duplicates, func(i, j int) bool { return duplicates[i].Key < duplicates[j].Key })
+	return duplicates
+}
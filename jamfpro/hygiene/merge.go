@@ -0,0 +1,340 @@
+package hygiene
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/jc0b/go-jamfpro-api/jamfpro"
+	"github.com/jc0b/go-jamfpro-api/jamfpro/bulk"
+)
+
+// pageSize is the number of inventory records fetched per request while looking for objects to re-point.
+const pageSize = 100
+
+// Kinds of objects that refer to buildings, departments or categories, as used in Move.Kind.
+const (
+	MoveComputer                         = "computer"
+	MoveMobileDevice                     = "mobile device"
+	MoveScript                           = "script"
+	MovePolicy                           = "policy"
+	MoveMacOSConfigurationProfile        = "macOS configuration profile"
+	MoveMobileDeviceConfigurationProfile = "mobile device configuration profile"
+)
+
+// Move re-points one object from a duplicate to the object being kept.
+type Move struct {
+	Kind string
+	Id   jamfpro.ID
+
+	// From is the ID of the duplicate the object refers to now.
+	From jamfpro.ID
+}
+
+// MergeReport is the outcome of a Merge.
+type MergeReport struct {
+	Duplicates Duplicates
+
+	// Moves holds the result of re-pointing each object that referred to a duplicate.
+	Moves *bulk.Report[Move]
+
+	// Deleted are the duplicates that were deleted.
+	Deleted []Object
+}
+
+// Merge merges a set of duplicates into d.Keep. Computers and mobile devices are moved to the kept building or
+// department, and scripts, policies and configuration profiles to the kept category, running the updates with the
+// bulk executor configured by opts. The other duplicates are only deleted once nothing refers to them any more: if
+// any update fails, nothing is deleted and the error says how many failed, leaving the merge to be run again.
+//
+// The report is returned along with any error, to show what was changed before it.
+func Merge(ctx context.Context, client *jamfpro.Client, d Duplicates, opts bulk.Options) (*MergeReport, error) {
+	if client == nil {
+		return nil, jamfpro.NewArgError("client", "cannot be nil")
+	}
+	if d.Keep.Id.IsZero() {
+		return nil, jamfpro.NewArgError("Keep", "must be set to the object to merge the duplicates into")
+	}
+	remove := d.Remove()
+	if len(remove) == len(d.Objects) {
+		return nil, jamfpro.NewArgError("Keep", "must be one of the duplicates")
+	}
+
+	report := &MergeReport{Duplicates: d}
+	from := make(map[jamfpro.ID]bool, len(remove))
+	for _, object := range remove {
+		from[object.Id] = true
+	}
+
+	var moves []Move
+	var err error
+	switch d.Kind {
+	case Buildings, Departments:
+		moves, err = deviceMoves(ctx, client, d.Kind, from)
+	case Categories:
+		moves, err = categoryMoves(ctx, client, from, opts)
+	default:
+		return nil, jamfpro.NewArgError("Kind", "must be one of building, department or category, not "+string(d.Kind))
+	}
+	if err != nil {
+		return report, err
+	}
+
+	executor := bulk.NewExecutor[Move](opts)
+	report.Moves = executor.Run(ctx, moves, func(ctx context.Context, move Move) error {
+		return apply(ctx, client, d.Kind, d.Keep.Id, move)
+	})
+	if report.Moves.Failed > 0 {
+		return report, fmt.Errorf("hygiene: %d of %d objects could not be moved to %s %q, so no duplicates were deleted",
+			report.Moves.Failed, len(moves), d.Kind, d.Keep.Name)
+	}
+
+	for _, object := range remove {
+		if err := deleteObject(ctx, client, d.Kind, object.Id); err != nil {
+			return report, fmt.Errorf("hygiene: deleting %s %q (%d): %w", d.Kind, object.Name, object.Id.Int(), err)
+		}
+		report.Deleted = append(report.Deleted, object)
+	}
+	return report, nil
+}
+
+// deviceMoves returns the computers and mobile devices whose building or department is one of from.
+func deviceMoves(ctx context.Context, client *jamfpro.Client, kind Kind, from map[jamfpro.ID]bool) ([]Move, error) {
+	var moves []Move
+
+	for page := 0; ; page++ {
+		computers, _, err := client.Computers.ListInventory(ctx, &jamfpro.ComputerInventoryListOptions{
+			ListOptions: jamfpro.ListOptions{Page: page, PageSize: pageSize, Sort: "id:asc"},
+			Sections:    []string{jamfpro.ComputerInventorySectionUserAndLocation},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, computer := range computers {
+			if computer.UserAndLocation == nil {
+				continue
+			}
+			id := computer.UserAndLocation.BuildingId
+			if kind == Departments {
+				id = computer.UserAndLocation.DepartmentId
+			}
+			if from[id] {
+				moves = append(moves, Move{Kind: MoveComputer, Id: computer.Id, From: id})
+			}
+		}
+		if len(computers) < pageSize {
+			break
+		}
+	}
+
+	for page := 0; ; page++ {
+		devices, _, err := client.MobileDevicesInventory.List(ctx, &jamfpro.MobileDeviceInventoryListOptions{
+			ListOptions: jamfpro.ListOptions{Page: page, PageSize: pageSize, Sort: "mobileDeviceId:asc"},
+			Sections:    []string{jamfpro.MobileDeviceInventorySectionUserAndLocation},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, device := range devices {
+			if device.UserAndLocation == nil {
+				continue
+			}
+			id := device.UserAndLocation.BuildingId
+			if kind == Departments {
+				id = device.UserAndLocation.DepartmentId
+			}
+			if from[id] {
+				moves = append(moves, Move{Kind: MoveMobileDevice, Id: device.MobileDeviceId, From: id})
+			}
+		}
+		if len(devices) < pageSize {
+			break
+		}
+	}
+
+	return moves, nil
+}
+
+// categoryMoves returns the scripts, policies and configuration profiles whose category is one of from. The Classic
+// API does not list the category of policies and profiles, so each of them is fetched, opts.Concurrency at a time.
+func categoryMoves(ctx context.Context, client *jamfpro.Client, from map[jamfpro.ID]bool, opts bulk.Options) ([]Move, error) {
+	var moves []Move
+
+	for page := 0; ; page++ {
+		scripts, _, err := client.Scripts.List(ctx, &jamfpro.ListOptions{Page: page, PageSize: pageSize, Sort: "id:asc"})
+		if err != nil {
+			return nil, err
+		}
+		for _, script := range scripts {
+			if from[script.CategoryId] {
+				moves = append(moves, Move{Kind: MoveScript, Id: script.Id, From: script.CategoryId})
+			}
+		}
+		if len(scripts) < pageSize {
+			break
+		}
+	}
+
+	policies, _, err := client.Policies.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, 0, len(policies))
+	for _, policy := range policies {
+		ids = append(ids, policy.Id.Int())
+	}
+	fetched, err := client.Policies.GetManyByID(ctx, ids, opts.Concurrency)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		if policy := fetched[id]; policy != nil && from[policy.General.Category.Id] {
+			moves = append(moves, Move{Kind: MovePolicy, Id: jamfpro.FromInt(id), From: policy.General.Category.Id})
+		}
+	}
+
+	macOSProfiles, _, err := client.MacOSConfigurationProfiles.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids = ids[:0]
+	for _, profile := range macOSProfiles {
+		ids = append(ids, profile.Id.Int())
+	}
+	profileMoves, err := fetchCategories(ctx, ids, opts, MoveMacOSConfigurationProfile, from, func(ctx context.Context, id int) (jamfpro.ID, error) {
+		profile, _, err := client.MacOSConfigurationProfiles.GetByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return profile.General.Category.Id, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	moves = append(moves, profileMoves...)
+
+	mobileDeviceProfiles, _, err := client.MobileDeviceConfigurationProfiles.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids = ids[:0]
+	for _, profile := range mobileDeviceProfiles {
+		ids = append(ids, profile.Id.Int())
+	}
+	profileMoves, err = fetchCategories(ctx, ids, opts, MoveMobileDeviceConfigurationProfile, from, func(ctx context.Context, id int) (jamfpro.ID, error) {
+		profile, _, err := client.MobileDeviceConfigurationProfiles.GetByID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return profile.General.Category.Id, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(moves, profileMoves...), nil
+}
+
+// fetchCategories looks up the category of each of the objects with the given IDs, and returns moves for those in one
+// of from. It fails if any lookup does, as a merge must not delete a category that is still in use.
+func fetchCategories(ctx context.Context, ids []int, opts bulk.Options, kind string, from map[jamfpro.ID]bool, category func(context.Context, int) (jamfpro.ID, error)) ([]Move, error) {
+	var mu sync.Mutex
+	var moves []Move
+
+	report := bulk.NewExecutor[int](opts).Run(ctx, ids, func(ctx context.Context, id int) error {
+		categoryId, err := category(ctx, id)
+		if err != nil {
+			return err
+		}
+		if from[categoryId] {
+			mu.Lock()
+			moves = append(moves, Move{Kind: kind, Id: jamfpro.FromInt(id), From: categoryId})
+			mu.Unlock()
+		}
+		return nil
+	})
+	if failures := report.Failures(); len(failures) > 0 {
+		return nil, fmt.Errorf("hygiene: fetching %s %d: %w", kind, failures[0].Item, failures[0].Err)
+	}
+	return moves, nil
+}
+
+// classicCategoryUpdate is the part of a Classic API policy or configuration profile that sets its category.
+type classicCategoryUpdate struct {
+	XMLName  xml.Name
+	Category jamfpro.ClassicReference `xml:"general>category"`
+}
+
+// apply re-points the object of move to the object with ID to.
+func apply(ctx context.Context, client *jamfpro.Client, kind Kind, to jamfpro.ID, move Move) error {
+	switch move.Kind {
+	case MoveComputer:
+		update := &jamfpro.ComputerInventoryUserAndLocationUpdate{BuildingId: &to}
+		if kind == Departments {
+			update = &jamfpro.ComputerInventoryUserAndLocationUpdate{DepartmentId: &to}
+		}
+		_, _, err := client.Computers.UpdateInventoryDetail(ctx, move.Id.Int(), &jamfpro.ComputerInventoryUpdateRequest{UserAndLocation: update})
+		return err
+	case MoveMobileDevice:
+		update := &jamfpro.MobileDeviceInventoryLocationUpdate{BuildingId: &to}
+		if kind == Departments {
+			update = &jamfpro.MobileDeviceInventoryLocationUpdate{DepartmentId: &to}
+		}
+		_, _, err := client.MobileDevicesInventory.Update(ctx, move.Id.Int(), &jamfpro.MobileDeviceInventoryUpdateRequest{Location: update})
+		return err
+	case MoveScript:
+		// The Jamf Pro API replaces scripts as a whole, so the update is made to the script as it is now.
+		script, _, err := client.Scripts.GetByID(ctx, move.Id.Int())
+		if err != nil {
+			return err
+		}
+		script.CategoryId = to
+		script.CategoryName = ""
+		_, _, err = client.Scripts.Update(ctx, move.Id.Int(), script)
+		return err
+	case MovePolicy:
+		return updateClassicCategory(ctx, client, "JSSResource/policies", "policy", move.Id, to)
+	case MoveMacOSConfigurationProfile:
+		return updateClassicCategory(ctx, client, "JSSResource/osxconfigurationprofiles", "os_x_configuration_profile", move.Id, to)
+	case MoveMobileDeviceConfigurationProfile:
+		return updateClassicCategory(ctx, client, "JSSResource/mobiledeviceconfigurationprofiles", "configuration_profile", move.Id, to)
+	}
+	return jamfpro.NewArgError("Kind", "cannot move a "+move.Kind)
+}
+
+// updateClassicCategory sets the category of a Classic API object, sending only the category so nothing else about
+// the object is changed.
+func updateClassicCategory(ctx context.Context, client *jamfpro.Client, basePath, element string, id, category jamfpro.ID) error {
+	path := basePath + "/id/" + strconv.Itoa(id.Int())
+	body := &classicCategoryUpdate{XMLName: xml.Name{Local: element}, Category: jamfpro.ClassicReference{Id: category}}
+
+	req, err := client.NewRequest(ctx, http.MethodPut, path, body, "application/xml")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return err
+	}
+	return nil
+}
+
+// deleteObject deletes the building, department or category with ID id.
+func deleteObject(ctx context.Context, client *jamfpro.Client, kind Kind, id jamfpro.ID) error {
+	var err error
+	switch kind {
+	case Buildings:
+		_, err = client.Buildings.Delete(ctx, id.Int())
+	case Departments:
+		_, err = client.Departments.Delete(ctx, id.Int())
+	case Categories:
+		_, err = client.Categories.Delete(ctx, id.Int())
+	}
+	if err != nil && err.Error() != "EOF" {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package jamfpro
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+// ID identifies a Jamf Pro object. The Classic API identifies objects with integers and the Jamf Pro API with
+// strings, so ID holds the identifier as a string and converts to and from the integer form, letting an ID read
+// from one API family be passed straight to the other.
+type ID string
+
+// FromInt returns the ID of a Classic API object.
+func FromInt(id int) ID {
+	return ID(strconv.Itoa(id))
+}
+
+// FromString returns the ID of a Jamf Pro API object.
+func FromString(id string) ID {
+	return ID(id)
+}
+
+// String returns the ID in the form used by the Jamf Pro API.
+func (id ID) String() string {
+	return string(id)
+}
+
+// Int returns the ID in the form used by the Classic API, or 0 if the ID is empty or not numeric.
+func (id ID) Int() int {
+	i, err := strconv.Atoi(string(id))
+	if err != nil {
+		return 0
+	}
+	return i
+}
+
+// IsZero reports whether the ID is unset. The Classic API uses 0 for "no object", so "0" is treated as unset too.
+func (id ID) IsZero() bool {
+	return id == "" || id == "0"
+}
+
+// MarshalJSON encodes the ID as a JSON string, as the Jamf Pro API expects.
+func (id ID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(id))
+}
+
+// UnmarshalJSON accepts both string and numeric IDs, as some Jamf Pro API endpoints still return numbers.
+func (id *ID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*id = ""
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*id = ID(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*id = ID(n.String())
+	return nil
+}
+
+// MarshalText encodes the ID for the Classic API, where an unset reference is written as 0.
+func (id ID) MarshalText() ([]byte, error) {
+	if id == "" {
+		return []byte("0"), nil
+	}
+	return []byte(id), nil
+}
+
+// UnmarshalText decodes an ID from the Classic API.
+func (id *ID) UnmarshalText(text []byte) error {
+	*id = ID(bytes.TrimSpace(text))
+	return nil
+}
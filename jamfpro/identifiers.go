@@ -0,0 +1,81 @@
+package jamfpro
+
+import (
+	"strconv"
+	"strings"
+)
+
+// maxSerialNumberLength is the longest serial number Jamf Pro stores for a device.
+const maxSerialNumberLength = 64
+
+// IsValidSerialNumber reports whether serialNumber can be the serial number of a device. Apple hardware serials are 10
+// to 12 letters and digits, but virtual machines report longer ones with spaces and punctuation, e.g. "VMware-56 4d
+// ..." or "Parallels-56 4D ...", so only what no serial contains is rejected: leading or trailing whitespace, control
+// and non-ASCII characters, and punctuation other than '-', '+', '/', '.' and '_', such as the commas and quotes of a
+// pasted CSV cell.
+func IsValidSerialNumber(serialNumber string) bool {
+	if len(serialNumber) < 4 || len(serialNumber) > maxSerialNumberLength || strings.TrimSpace(serialNumber) != serialNumber {
+		return false
+	}
+	for _, r := range serialNumber {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+		case r == ' ', r == '-', r == '+', r == '/', r == '.', r == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// NormalizeUDID returns udid in the form Jamf Pro stores it: upper case, with surrounding whitespace removed and the
+// hyphens of a UUID in place. It accepts the UUIDs of computers, with or without hyphens, and the 40 digit and
+// 8-16 digit UDIDs of mobile devices, and returns an *ArgError for anything else.
+func NormalizeUDID(udid string) (string, error) {
+	udid = strings.ToUpper(strings.TrimSpace(udid))
+	if udid == "" {
+		return "", NewArgError("udid", "cannot be empty")
+	}
+
+	switch {
+	case len(udid) == 36 && isHexGroups(udid, 8, 4, 4, 4, 12):
+		return udid, nil
+	case len(udid) == 32 && isHexGroups(udid, 32):
+		return udid[:8] + "-" + udid[8:12] + "-" + udid[12:16] + "-" + udid[16:20] + "-" + udid[20:], nil
+	case len(udid) == 40 && isHexGroups(udid, 40):
+		return udid, nil
+	case len(udid) == 25 && isHexGroups(udid, 8, 16):
+		return udid, nil
+	}
+	return "", NewArgError("udid", strconv.Quote(udid)+" is not a UUID or the UDID of a mobile device")
+}
+
+// isHexGroups reports whether s is made of groups of hexadecimal digits of the given lengths, separated by hyphens.
+func isHexGroups(s string, lengths ...int) bool {
+	groups := strings.Split(s, "-")
+	if len(groups) != len(lengths) {
+		return false
+	}
+	for i, group := range groups {
+		if len(group) != lengths[i] {
+			return false
+		}
+		for _, r := range group {
+			if !(r >= '0' && r <= '9' || r >= 'A' && r <= 'F') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// validateSerialNumber returns an *ArgError if serialNumber cannot be the serial number of a device.
+func validateSerialNumber(serialNumber string) error {
+	if serialNumber == "" {
+		return NewArgError("serialNumber", "cannot be empty")
+	}
+	if !IsValidSerialNumber(serialNumber) {
+		return NewArgError("serialNumber", strconv.Quote(serialNumber)+" is not a serial number")
+	}
+	return nil
+}
@@ -0,0 +1,333 @@
+package jamfpro
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+const inventoryPreloadBasePath = "uapi/v2/inventory-preload"
+
+// Device types of inventory preload records
+const (
+	InventoryPreloadDeviceTypeComputer     = "Computer"
+	InventoryPreloadDeviceTypeMobileDevice = "Mobile Device"
+	InventoryPreloadDeviceTypeUnknown      = "Unknown"
+)
+
+type InventoryPreloadService interface {
+	List(context.Context, *ListOptions) ([]InventoryPreloadRecord, *Response, error)
+	GetByID(context.Context, string) (*InventoryPreloadRecord, *Response, error)
+	Create(context.Context, *InventoryPreloadRecord) (*InventoryPreloadRecord, *Response, error)
+	Update(context.Context, string, *InventoryPreloadRecord) (*InventoryPreloadRecord, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+	DeleteAll(context.Context) (*Response, error)
+	UploadCSV(context.Context, io.Reader) ([]InventoryPreloadCreateResponse, *Response, error)
+	ValidateCSV(context.Context, io.Reader) (*InventoryPreloadValidation, *Response, error)
+	DownloadCSVTemplate(context.Context, io.Writer) (*Response, error)
+	ExportCSV(context.Context, io.Writer, *InventoryPreloadExportOptions) (*Response, error)
+	ListExtensionAttributeColumns(context.Context) ([]InventoryPreloadExtensionAttributeColumn, *Response, error)
+	ListHistory(context.Context, *ListOptions) ([]HistoryEntry, *Response, error)
+	AddHistoryNote(context.Context, string) (*HistoryNoteResponse, *Response, error)
+}
+
+// InventoryPreloadServiceOp handles communication with the inventory preload-related
+// methods of the Jamf Pro API.
+type InventoryPreloadServiceOp struct {
+	client *Client
+}
+
+var _ InventoryPreloadService = &InventoryPreloadServiceOp{}
+
+// InventoryPreloadRecord represents inventory information Jamf Pro applies to a device, matched by serial number,
+// when it enrolls
+type InventoryPreloadRecord struct {
+	Id                  ID                                   `json:"id,omitempty"`
+	SerialNumber        string                               `json:"serialNumber"`
+	DeviceType          string                               `json:"deviceType"`
+	Username            string                               `json:"username,omitempty"`
+	FullName            string                               `json:"fullName,omitempty"`
+	EmailAddress        string                               `json:"emailAddress,omitempty"`
+	PhoneNumber         string                               `json:"phoneNumber,omitempty"`
+	Position            string                               `json:"position,omitempty"`
+	Department          string                               `json:"department,omitempty"`
+	Building            string                               `json:"building,omitempty"`
+	Room                string                               `json:"room,omitempty"`
+	PoNumber            string                               `json:"poNumber,omitempty"`
+	PoDate              string                               `json:"poDate,omitempty"`
+	WarrantyExpiration  string                               `json:"warrantyExpiration,omitempty"`
+	AppleCareId         string                               `json:"appleCareId,omitempty"`
+	LifeExpectancy      string                               `json:"lifeExpectancy,omitempty"`
+	PurchasePrice       string                               `json:"purchasePrice,omitempty"`
+	PurchasingContact   string                               `json:"purchasingContact,omitempty"`
+	PurchasingAccount   string                               `json:"purchasingAccount,omitempty"`
+	LeaseExpiration     string                               `json:"leaseExpiration,omitempty"`
+	BarCode1            string                               `json:"barCode1,omitempty"`
+	BarCode2            string                               `json:"barCode2,omitempty"`
+	AssetTag            string                               `json:"assetTag,omitempty"`
+	Vendor              string                               `json:"vendor,omitempty"`
+	ExtensionAttributes []InventoryPreloadExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+// InventoryPreloadExtensionAttribute is the value of an extension attribute in an inventory preload record
+type InventoryPreloadExtensionAttribute struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// InventoryPreloadListResponse represents the raw API response to getting all inventory preload records
+type InventoryPreloadListResponse struct {
+	TotalCount *int64                    `json:"totalCount"`
+	Records    *[]InventoryPreloadRecord `json:"results"`
+}
+
+// InventoryPreloadCreateResponse represents an API response to creating an inventory preload record
+type InventoryPreloadCreateResponse struct {
+	Id   ID     `json:"id"`
+	Href string `json:"href"`
+}
+
+// InventoryPreloadValidation represents an API response to validating an inventory preload CSV file
+type InventoryPreloadValidation struct {
+	RecordCount int `json:"recordCount"`
+}
+
+// InventoryPreloadExtensionAttributeColumn is an extension attribute that can be set from an inventory preload CSV
+// file. Name is the column header to use in the file.
+type InventoryPreloadExtensionAttributeColumn struct {
+	Name     string `json:"name"`
+	FullName string `json:"fullName"`
+}
+
+// InventoryPreloadExtensionAttributeColumnListResponse represents the raw API response to getting all extension
+// attribute columns
+type InventoryPreloadExtensionAttributeColumnListResponse struct {
+	TotalCount *int64                                      `json:"totalCount"`
+	Columns    *[]InventoryPreloadExtensionAttributeColumn `json:"results"`
+}
+
+// InventoryPreloadExportOptions selects the records and columns included in a CSV export. Fields lists the record
+// fields to export, and Labels the matching column headers; both default to every field.
+type InventoryPreloadExportOptions struct {
+	ListOptions
+	Fields []string
+	Labels []string
+}
+
+// EncodeQuery adds the list options, and the export-fields and export-labels parameters.
+func (o *InventoryPreloadExportOptions) EncodeQuery(q *QueryParams) {
+	if o == nil {
+		return
+	}
+	o.ListOptions.EncodeQuery(q)
+	q.Strings("export-fields", o.Fields).Strings("export-labels", o.Labels)
+}
+
+func (i *InventoryPreloadServiceOp) List(ctx context.Context, opts *ListOptions) ([]InventoryPreloadRecord, *Response, error) {
+	path, err := addOptions(inventoryPreloadBasePath+"/records", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := i.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var recordResponse InventoryPreloadListResponse
+	resp, err := i.client.Do(ctx, req, &recordResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if recordResponse.Records == nil {
+		return nil, resp, err
+	}
+
+	return *recordResponse.Records, resp, err
+}
+
+func (i *InventoryPreloadServiceOp) GetByID(ctx context.Context, id string) (*InventoryPreloadRecord, *Response, error) {
+	path := pathJoin(inventoryPreloadBasePath, "records", id)
+
+	req, err := i.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record InventoryPreloadRecord
+	resp, err := i.client.Do(ctx, req, &record)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &record, resp, err
+}
+
+// Create creates an inventory preload record and returns the record as stored by the server.
+func (i *InventoryPreloadServiceOp) Create(ctx context.Context, record *InventoryPreloadRecord) (*InventoryPreloadRecord, *Response, error) {
+	if record == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	} else if record.SerialNumber == "" {
+		return nil, nil, NewArgError("SerialNumber", "cannot be empty")
+	} else if record.DeviceType == "" {
+		return nil, nil, NewArgError("DeviceType", "cannot be empty")
+	}
+
+	req, err := i.client.NewRequest(ctx, http.MethodPost, inventoryPreloadBasePath+"/records", record, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	recordCreation := new(InventoryPreloadCreateResponse)
+	resp, err := i.client.Do(ctx, req, recordCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i.GetByID(ctx, recordCreation.Id.String())
+}
+
+func (i *InventoryPreloadServiceOp) Update(ctx context.Context, id string, record *InventoryPreloadRecord) (*InventoryPreloadRecord, *Response, error) {
+	path := pathJoin(inventoryPreloadBasePath, "records", id)
+	if record == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if id == "" {
+		return nil, nil, NewArgError("inventory preload record ID", "cannot be empty")
+	}
+
+	req, err := i.client.NewRequest(ctx, http.MethodPut, path, record, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated InventoryPreloadRecord
+	resp, err := i.client.Do(ctx, req, &updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &updated, resp, err
+}
+
+func (i *InventoryPreloadServiceOp) Delete(ctx context.Context, id string) (*Response, error) {
+	path := pathJoin(inventoryPreloadBasePath, "records", id)
+
+	req, err := i.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// UploadCSV creates inventory preload records from a CSV file in the format of the Jamf Pro CSV template, and
+// returns a reference to every record created.
+func (i *InventoryPreloadServiceOp) UploadCSV(ctx context.Context, csv io.Reader) ([]InventoryPreloadCreateResponse, *Response, error) {
+	if csv == nil {
+		return nil, nil, NewArgError("csv", "cannot be nil")
+	}
+
+	req, err := i.client.newUploadRequest(ctx, http.MethodPost, inventoryPreloadBasePath+"/csv", "file", "inventory-preload.csv", csv)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created []InventoryPreloadCreateResponse
+	resp, err := i.client.Do(ctx, req, &created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, err
+}
+
+// DeleteAll deletes every inventory preload record.
+func (i *InventoryPreloadServiceOp) DeleteAll(ctx context.Context) (*Response, error) {
+	req, err := i.client.NewRequest(ctx, http.MethodPost, inventoryPreloadBasePath+"/delete-all", nil, "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// ValidateCSV checks a CSV file without creating any records, and returns the number of records it would create.
+// A file with errors is rejected by the server with an ErrorResponse describing the problems.
+func (i *InventoryPreloadServiceOp) ValidateCSV(ctx context.Context, csv io.Reader) (*InventoryPreloadValidation, *Response, error) {
+	if csv == nil {
+		return nil, nil, NewArgError("csv", "cannot be nil")
+	}
+
+	req, err := i.client.newUploadRequest(ctx, http.MethodPost, inventoryPreloadBasePath+"/csv-validate", "file", "inventory-preload.csv", csv)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var validation InventoryPreloadValidation
+	resp, err := i.client.Do(ctx, req, &validation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &validation, resp, err
+}
+
+// DownloadCSVTemplate writes the CSV template for inventory preload files to w.
+func (i *InventoryPreloadServiceOp) DownloadCSVTemplate(ctx context.Context, w io.Writer) (*Response, error) {
+	if w == nil {
+		return nil, NewArgError("writer", "cannot be nil")
+	}
+
+	req, err := i.client.NewRequest(ctx, http.MethodGet, inventoryPreloadBasePath+"/csv-template", nil, "application/json", WithHeader("Accept", "text/csv"))
+	if err != nil {
+		return nil, err
+	}
+
+	return i.client.Do(ctx, req, w)
+}
+
+// ExportCSV writes the inventory preload records selected by opts to w as CSV.
+func (i *InventoryPreloadServiceOp) ExportCSV(ctx context.Context, w io.Writer, opts *InventoryPreloadExportOptions) (*Response, error) {
+	return exportCSV(ctx, i.client, inventoryPreloadBasePath+"/export", w, opts)
+}
+
+// ListExtensionAttributeColumns returns the extension attributes that can be included in inventory preload CSV files.
+func (i *InventoryPreloadServiceOp) ListExtensionAttributeColumns(ctx context.Context) ([]InventoryPreloadExtensionAttributeColumn, *Response, error) {
+	req, err := i.client.NewRequest(ctx, http.MethodGet, inventoryPreloadBasePath+"/ea-columns", nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var columnResponse InventoryPreloadExtensionAttributeColumnListResponse
+	resp, err := i.client.Do(ctx, req, &columnResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if columnResponse.Columns == nil {
+		return nil, resp, err
+	}
+
+	return *columnResponse.Columns, resp, err
+}
+
+// ListHistory returns the change history of the inventory preload records.
+func (i *InventoryPreloadServiceOp) ListHistory(ctx context.Context, opts *ListOptions) ([]HistoryEntry, *Response, error) {
+	return listHistory(ctx, i.client, inventoryPreloadBasePath+"/history", opts)
+}
+
+// AddHistoryNote adds a note to the change history of the inventory preload records.
+func (i *InventoryPreloadServiceOp) AddHistoryNote(ctx context.Context, note string) (*HistoryNoteResponse, *Response, error) {
+	return addHistoryNote(ctx, i.client, inventoryPreloadBasePath+"/history", note)
+}
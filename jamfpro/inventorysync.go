@@ -0,0 +1,263 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultInventorySyncKey = "computers-inventory"
+
+// CheckpointStore persists the checkpoint of an InventorySync between runs, e.g. across nightly jobs. Checkpoints
+// are keyed, so a single store can hold those of several syncs.
+type CheckpointStore interface {
+	// Load returns the checkpoint stored for key, or the zero time if there is none.
+	Load(key string) (time.Time, error)
+	// Save stores checkpoint under key, replacing any previous checkpoint.
+	Save(key string, checkpoint time.Time) error
+}
+
+// MemoryCheckpointStore is a CheckpointStore that keeps checkpoints in memory. It is safe for concurrent use.
+type MemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]time.Time
+}
+
+var _ CheckpointStore = &MemoryCheckpointStore{}
+
+// NewMemoryCheckpointStore returns an empty MemoryCheckpointStore.
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{checkpoints: make(map[string]time.Time)}
+}
+
+func (m *MemoryCheckpointStore) Load(key string) (time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.checkpoints[key], nil
+}
+
+func (m *MemoryCheckpointStore) Save(key string, checkpoint time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.checkpoints[key] = checkpoint
+	return nil
+}
+
+// FileCheckpointStore is a CheckpointStore that keeps checkpoints in a JSON file readable only by the current user.
+// Writes replace the file atomically, like those of FileTokenStore.
+type FileCheckpointStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+var _ CheckpointStore = &FileCheckpointStore{}
+
+// NewFileCheckpointStore returns a FileCheckpointStore backed by the file at path. The file is created on the first
+// Save.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+func (f *FileCheckpointStore) Load(key string) (time.Time, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	checkpoints, err := f.read()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return checkpoints[key], nil
+}
+
+func (f *FileCheckpointStore) Save(key string, checkpoint time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	checkpoints, err := f.read()
+	if err != nil {
+		return err
+	}
+	checkpoints[key] = checkpoint
+
+	data, err := json.Marshal(checkpoints)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(f.path, data)
+}
+
+func (f *FileCheckpointStore) read() (map[string]time.Time, error) {
+	checkpoints := make(map[string]time.Time)
+
+	data, err := os.ReadFile(f.path)
+	if os.IsNotExist(err) || len(data) == 0 {
+		return checkpoints, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &checkpoints); err != nil {
+		return nil, err
+	}
+	return checkpoints, nil
+}
+
+// InventorySyncOptions configures NewInventorySync.
+type InventorySyncOptions struct {
+	// Store holds the checkpoint between runs.
+	Store CheckpointStore
+
+	// Key is the key of the checkpoint in Store. It defaults to "computers-inventory".
+	Key string
+
+	// Sections are the inventory sections fetched for each computer. Only GENERAL is fetched if none are given.
+	Sections []string
+
+	// Filter is an RSQL expression that computers must also match, e.g. general.site.name=="Amsterdam".
+	Filter string
+
+	// PageSize is the number of computers fetched per request. It defaults to 100.
+	PageSize int
+
+	// Overlap moves every checkpoint back by this much, to catch inventory reports that were still being written
+	// when the previous run started.
+	Overlap time.Duration
+}
+
+// InventorySyncResult describes a completed run of an InventorySync.
+type InventorySyncResult struct {
+	// Since is the checkpoint the run started from. It is zero for a first, full, sync.
+	Since time.Time
+
+	// Checkpoint is the checkpoint saved for the next run.
+	Checkpoint time.Time
+
+	// Computers is the number of computers passed to the handler.
+	Computers int
+}
+
+// InventorySync fetches the inventory of the computers that reported since the previous run, using an RSQL filter on
+// general.reportDate, so that a recurring sync does not pull the whole fleet every time:
+//
+//	sync, err := jamfpro.NewInventorySync(client.Computers, jamfpro.InventorySyncOptions{
+//		Store:    jamfpro.NewFileCheckpointStore("/var/lib/jamf-sync/checkpoints.json"),
+//		Sections: []string{jamfpro.ComputerInventorySectionGeneral, jamfpro.ComputerInventorySectionHardware},
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	result, err := sync.Run(ctx, func(computer jamfpro.ComputerInventory) error {
+//		return db.Upsert(computer)
+//	})
+//
+// The checkpoint is the server's time when a run started, taken from the Date header of its first response, so
+// computers reporting during the run are fetched again by the next one. A computer may be passed to the handler by
+// two consecutive runs, so the handler should be idempotent.
+type InventorySync struct {
+	computers ComputersService
+	opts      InventorySyncOptions
+}
+
+// NewInventorySync returns an InventorySync fetching computers with computers.ListInventory.
+func NewInventorySync(computers ComputersService, opts InventorySyncOptions) (*InventorySync, error) {
+	if computers == nil {
+		return nil, NewArgError("computers", "cannot be nil")
+	} else if opts.Store == nil {
+		return nil, NewArgError("Store", "cannot be nil")
+	} else if opts.PageSize < 0 {
+		return nil, NewArgError("PageSize", "cannot be negative")
+	} else if opts.Overlap < 0 {
+		return nil, NewArgError("Overlap", "cannot be negative")
+	}
+
+	if opts.Key == "" {
+		opts.Key = defaultInventorySyncKey
+	}
+	if opts.PageSize == 0 {
+		opts.PageSize = defaultPageSize
+	}
+	return &InventorySync{computers: computers, opts: opts}, nil
+}
+
+// Run passes every computer that reported since the stored checkpoint, or every computer on the first run, to
+// handle, and then saves the new checkpoint. If handle or a request fails, Run stops and keeps the old checkpoint, so
+// the next run starts over from it.
+func (s *InventorySync) Run(ctx context.Context, handle func(ComputerInventory) error) (*InventorySyncResult, error) {
+	since, err := s.opts.Store.Load(s.opts.Key)
+	if err != nil {
+		return nil, err
+	}
+	result := &InventorySyncResult{Since: since}
+
+	listOpts := &ComputerInventoryListOptions{
+		ListOptions: ListOptions{
+			PageSize: s.opts.PageSize,
+			// Sorting by ID keeps the pages stable: computers that report during the run only join the filtered
+			// set, which at worst repeats a computer on the next page, but never skips one.
+			Sort:   "id:asc",
+			Filter: s.filter(since),
+		},
+		Sections: s.opts.Sections,
+	}
+
+	seen := make(map[ID]bool)
+	for {
+		page, resp, err := s.computers.ListInventory(ctx, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		if listOpts.Page == 0 {
+			result.Checkpoint = serverTime(resp)
+		}
+
+		for _, computer := range page {
+			if seen[computer.Id] {
+				continue
+			}
+			seen[computer.Id] = true
+			if err := handle(computer); err != nil {
+				return nil, err
+			}
+			result.Computers++
+		}
+
+		if len(page) < listOpts.PageSize || (resp.TotalCount > 0 && (listOpts.Page+1)*listOpts.PageSize >= resp.TotalCount) {
+			break
+		}
+		listOpts.Page++
+	}
+
+	if err := s.opts.Store.Save(s.opts.Key, result.Checkpoint); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// filter returns the RSQL filter selecting the computers that reported since the checkpoint.
+func (s *InventorySync) filter(since time.Time) string {
+	var filters []string
+	if !since.IsZero() {
+		since = since.Add(-s.opts.Overlap)
+		filters = append(filters, `general.reportDate>="`+since.UTC().Format(time.RFC3339)+`"`)
+	}
+	if s.opts.Filter != "" {
+		filters = append(filters, "("+s.opts.Filter+")")
+	}
+	return strings.Join(filters, ";")
+}
+
+// serverTime returns the time resp was sent according to its Date header, or the current time if it has none.
+func serverTime(resp *Response) time.Time {
+	if resp != nil && resp.Response != nil {
+		if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+			return date
+		}
+	}
+	return time.Now()
+}
@@ -0,0 +1,184 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+)
+
+const jamfConnectBasePath = "uapi/v1/jamf-connect"
+
+type JamfConnectService interface {
+	ListConfigProfiles(context.Context, *ListOptions) ([]JamfConnectConfigProfile, *Response, error)
+	UpdateConfigProfile(context.Context, string, *JamfConnectConfigProfileUpdateRequest) (*JamfConnectConfigProfile, *Response, error)
+	ListDeploymentTasks(context.Context, string, *ListOptions) ([]JamfConnectDeploymentTask, *Response, error)
+	RetryDeploymentTasks(context.Context, string, []string) (*Response, error)
+	ListHistory(context.Context, *ListOptions) ([]HistoryEntry, *Response, error)
+	AddHistoryNote(context.Context, string) (*HistoryNoteResponse, *Response, error)
+}
+
+// JamfConnectServiceOp handles communication with the Jamf Connect-related
+// methods of the Jamf Pro API.
+type JamfConnectServiceOp struct {
+	client *Client
+}
+
+var _ JamfConnectService = &JamfConnectServiceOp{}
+
+// Jamf Connect app auto deployment types
+const (
+	JamfConnectAutoDeploymentPatchUpdates = "PATCH_UPDATES"
+	JamfConnectAutoDeploymentMinorUpdates = "MINOR_AND_PATCH_UPDATES"
+	JamfConnectAutoDeploymentInitialOnly  = "INITIAL_INSTALLATION_ONLY"
+	JamfConnectAutoDeploymentNone         = "NONE"
+)
+
+// JamfConnectConfigProfile is a configuration profile that deploys the Jamf Connect app
+type JamfConnectConfigProfile struct {
+	Uuid               string `json:"uuid"`
+	ProfileId          int    `json:"profileId"`
+	ProfileName        string `json:"profileName"`
+	ScopeDescription   string `json:"scopeDescription"`
+	SiteId             ID     `json:"siteId"`
+	Version            string `json:"version"`
+	AutoDeploymentType string `json:"autoDeploymentType"`
+}
+
+// JamfConnectConfigProfileListResponse represents the raw API response to getting all Jamf Connect config profiles
+type JamfConnectConfigProfileListResponse struct {
+	TotalCount *int64                      `json:"totalCount"`
+	Profiles   *[]JamfConnectConfigProfile `json:"results"`
+}
+
+// JamfConnectConfigProfileUpdateRequest represents a request to change how a config profile deploys Jamf Connect.
+type JamfConnectConfigProfileUpdateRequest struct {
+	Version            string `json:"version,omitempty"`
+	AutoDeploymentType string `json:"autoDeploymentType,omitempty"`
+}
+
+// JamfConnectDeploymentTask is the installation of Jamf Connect on a single computer
+type JamfConnectDeploymentTask struct {
+	Id           ID     `json:"id"`
+	ComputerId   ID     `json:"computerId"`
+	ComputerName string `json:"computerName"`
+	Version      string `json:"version"`
+	Status       string `json:"status"`
+	Updated      string `json:"updated"`
+}
+
+// JamfConnectDeploymentTaskListResponse represents the raw API response to getting the deployment tasks of a profile
+type JamfConnectDeploymentTaskListResponse struct {
+	TotalCount *int64                       `json:"totalCount"`
+	Tasks      *[]JamfConnectDeploymentTask `json:"results"`
+}
+
+type jamfConnectRetryRequest struct {
+	Ids []string `json:"ids"`
+}
+
+func (j *JamfConnectServiceOp) ListConfigProfiles(ctx context.Context, opts *ListOptions) ([]JamfConnectConfigProfile, *Response, error) {
+	path, err := addOptions(jamfConnectBasePath+"/config-profiles", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := j.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var profileResponse JamfConnectConfigProfileListResponse
+	resp, err := j.client.Do(ctx, req, &profileResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if profileResponse.Profiles == nil {
+		return nil, resp, err
+	}
+
+	return *profileResponse.Profiles, resp, err
+}
+
+// UpdateConfigProfile changes the Jamf Connect version and update behaviour of the config profile with the given UUID.
+func (j *JamfConnectServiceOp) UpdateConfigProfile(ctx context.Context, uuid string, request *JamfConnectConfigProfileUpdateRequest) (*JamfConnectConfigProfile, *Response, error) {
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if uuid == "" {
+		return nil, nil, NewArgError("uuid", "cannot be empty")
+	}
+	path := pathJoin(jamfConnectBasePath, "config-profiles", uuid)
+
+	req, err := j.client.NewRequest(ctx, http.MethodPut, path, request, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var profile JamfConnectConfigProfile
+	resp, err := j.client.Do(ctx, req, &profile)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &profile, resp, err
+}
+
+// ListDeploymentTasks lists the per-computer deployment tasks of the config profile with the given UUID.
+func (j *JamfConnectServiceOp) ListDeploymentTasks(ctx context.Context, uuid string, opts *ListOptions) ([]JamfConnectDeploymentTask, *Response, error) {
+	if uuid == "" {
+		return nil, nil, NewArgError("uuid", "cannot be empty")
+	}
+
+	path, err := addOptions(pathJoin(jamfConnectBasePath, "config-profiles", uuid, "deployment-tasks"), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := j.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var taskResponse JamfConnectDeploymentTaskListResponse
+	resp, err := j.client.Do(ctx, req, &taskResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if taskResponse.Tasks == nil {
+		return nil, resp, err
+	}
+
+	return *taskResponse.Tasks, resp, err
+}
+
+// RetryDeploymentTasks requests that the given failed deployment tasks of a config profile are attempted again.
+func (j *JamfConnectServiceOp) RetryDeploymentTasks(ctx context.Context, uuid string, taskIds []string) (*Response, error) {
+	if uuid == "" {
+		return nil, NewArgError("uuid", "cannot be empty")
+	} else if len(taskIds) == 0 {
+		return nil, NewArgError("taskIds", "must contain at least one task")
+	}
+	path := pathJoin(jamfConnectBasePath, "config-profiles", uuid, "deployment-tasks", "retry")
+
+	req, err := j.client.NewRequest(ctx, http.MethodPost, path, &jamfConnectRetryRequest{Ids: taskIds}, "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := j.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// ListHistory returns the change history of the Jamf Connect settings.
+func (j *JamfConnectServiceOp) ListHistory(ctx context.Context, opts *ListOptions) ([]HistoryEntry, *Response, error) {
+	return listHistory(ctx, j.client, jamfConnectBasePath+"/history", opts)
+}
+
+// AddHistoryNote adds a note to the change history of the Jamf Connect settings.
+func (j *JamfConnectServiceOp) AddHistoryNote(ctx context.Context, note string) (*HistoryNoteResponse, *Response, error) {
+	return addHistoryNote(ctx, j.client, jamfConnectBasePath+"/history", note)
+}
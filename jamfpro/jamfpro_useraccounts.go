@@ -7,6 +7,28 @@ import (
 
 const accountsBasePath = "JSSResource/accounts"
 
+// AccessLevel is how much of Jamf Pro a user account can manage.
+type AccessLevel string
+
+const (
+	AccessLevelFull  AccessLevel = "Full Access"
+	AccessLevelSite  AccessLevel = "Site Access"
+	AccessLevelGroup AccessLevel = "Group Access"
+)
+
+var accessLevels = []AccessLevel{AccessLevelFull, AccessLevelSite, AccessLevelGroup}
+
+// Valid reports whether Jamf Pro accepts the access level.
+func (a AccessLevel) Valid() bool {
+	return isKnown(a, accessLevels)
+}
+
+// UnmarshalText reads an access level, ignoring case.
+func (a *AccessLevel) UnmarshalText(text []byte) error {
+	*a = unmarshalEnum(text, accessLevels)
+	return nil
+}
+
 type UserAccountsService interface {
 	List(context.Context) ([]UserAccount, *Response, error)
 	GetByID(context.Context, int) (*UserAccount, *Response, error)
@@ -23,7 +45,7 @@ type UserAccountsServiceOp struct {
 var _ UserAccountsService = &UserAccountsServiceOp{}
 
 type UserAccount struct {
-	Id                  int              `xml:"id"`
+	Id                  ID               `xml:"id"`
 	Name                string           `xml:"name"`
 	IsDirectoryUser     bool             `xml:"directory_user"`
 	FullName            string           `xml:"full_name"`
@@ -32,7 +54,7 @@ type UserAccount struct {
 	PasswordSha256      string           `xml:"password_sha256"`
 	Enabled             string           `xml:"enabled"`
 	ForcePasswordChange bool             `xml:"force_password_change"`
-	AccessLevel         string           `xml:"access_level"`
+	AccessLevel         AccessLevel      `xml:"access_level"`
 	PrivilegeSet        string           `xml:"privilege_set"`
 	Privileges          PrivilegesObject `xml:"privileges"`
 }
@@ -57,7 +79,7 @@ type UserAccountRequest struct {
 	Password            string           `xml:"password"`
 	Enabled             string           `xml:"enabled"`
 	ForcePasswordChange bool             `xml:"force_password_change"`
-	AccessLevel         string           `xml:"access_level"`
+	AccessLevel         AccessLevel      `xml:"access_level"`
 	PrivilegeSet        string           `xml:"privilege_set"`
 	Privileges          PrivilegesObject `xml:"privileges"`
 }
@@ -0,0 +1,152 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const logFlushBasePath = "JSSResource/logflush"
+
+// LogFlushInterval is the age of the log entries to flush: entries older than the interval are deleted.
+type LogFlushInterval string
+
+const (
+	LogFlushIntervalZeroDays    LogFlushInterval = "Zero Days"
+	LogFlushIntervalOneDay      LogFlushInterval = "One Day"
+	LogFlushIntervalOneWeek     LogFlushInterval = "One Week"
+	LogFlushIntervalTwoWeeks    LogFlushInterval = "Two Weeks"
+	LogFlushIntervalOneMonth    LogFlushInterval = "One Month"
+	LogFlushIntervalThreeMonths LogFlushInterval = "Three Months"
+	LogFlushIntervalSixMonths   LogFlushInterval = "Six Months"
+	LogFlushIntervalOneYear     LogFlushInterval = "One Year"
+	LogFlushIntervalTwoYears    LogFlushInterval = "Two Years"
+	LogFlushIntervalThreeYears  LogFlushInterval = "Three Years"
+)
+
+var logFlushIntervals = []LogFlushInterval{
+	LogFlushIntervalZeroDays, LogFlushIntervalOneDay, LogFlushIntervalOneWeek, LogFlushIntervalTwoWeeks,
+	LogFlushIntervalOneMonth, LogFlushIntervalThreeMonths, LogFlushIntervalSixMonths, LogFlushIntervalOneYear,
+	LogFlushIntervalTwoYears, LogFlushIntervalThreeYears,
+}
+
+// Valid reports whether i is one of the intervals Jamf Pro accepts
+func (i LogFlushInterval) Valid() bool {
+	for _, interval := range logFlushIntervals {
+		if i == interval {
+			return true
+		}
+	}
+	return false
+}
+
+// pathSegment formats the interval the way the logflush URL expects it, e.g. Three+Months
+func (i LogFlushInterval) pathSegment() string {
+	return strings.ReplaceAll(string(i), " ", "+")
+}
+
+// LogFlushLogType is the kind of log to flush
+type LogFlushLogType string
+
+const (
+	LogFlushLogTypePolicy LogFlushLogType = "policy"
+)
+
+type LogFlushService interface {
+	FlushPolicyLogs(context.Context, int, LogFlushInterval) (*Response, error)
+	Flush(context.Context, *LogFlushRequest) (*Response, error)
+}
+
+// LogFlushServiceOp handles communication with the log flushing
+// methods of the Jamf Pro API.
+type LogFlushServiceOp struct {
+	client *Client
+}
+
+var _ LogFlushService = &LogFlushServiceOp{}
+
+// LogFlushRequest represents a request to flush the logs of a policy for specific computers or mobile devices.
+type LogFlushRequest struct {
+	LogType       LogFlushLogType
+	LogId         int
+	Interval      LogFlushInterval
+	Computers     []int
+	MobileDevices []int
+}
+
+type logFlushRequestBody struct {
+	XMLName       xml.Name         `xml:"logflush"`
+	LogType       LogFlushLogType  `xml:"log_type"`
+	LogId         int              `xml:"log_id"`
+	Interval      string           `xml:"interval"`
+	Computers     []logFlushDevice `xml:"computers>computer,omitempty"`
+	MobileDevices []logFlushDevice `xml:"mobile_devices>mobile_device,omitempty"`
+}
+
+type logFlushDevice struct {
+	Id ID `xml:"id"`
+}
+
+// FlushPolicyLogs deletes the log entries of a policy older than interval, for all devices.
+func (l *LogFlushServiceOp) FlushPolicyLogs(ctx context.Context, policyId int, interval LogFlushInterval) (*Response, error) {
+	if policyId == 0 {
+		return nil, NewArgError("policy ID", "cannot be 0")
+	} else if !interval.Valid() {
+		return nil, NewArgError("interval", "must be one of the LogFlushInterval constants")
+	}
+	path := logFlushBasePath + "/" + string(LogFlushLogTypePolicy) + "/id/" + strconv.Itoa(policyId) + "/interval/" + interval.pathSegment()
+
+	req, err := l.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// Flush deletes log entries older than the requested interval, limited to the given computers and mobile devices.
+func (l *LogFlushServiceOp) Flush(ctx context.Context, request *LogFlushRequest) (*Response, error) {
+	if request == nil {
+		return nil, NewArgError("flushRequest", "cannot be nil")
+	} else if request.LogId == 0 {
+		return nil, NewArgError("LogId", "cannot be 0")
+	} else if !request.Interval.Valid() {
+		return nil, NewArgError("Interval", "must be one of the LogFlushInterval constants")
+	} else if len(request.Computers) == 0 && len(request.MobileDevices) == 0 {
+		return nil, NewArgError("flushRequest", "must name at least one computer or mobile device")
+	}
+
+	body := &logFlushRequestBody{
+		LogType:  request.LogType,
+		LogId:    request.LogId,
+		Interval: strings.ToUpper(string(request.Interval)),
+	}
+	if body.LogType == "" {
+		body.LogType = LogFlushLogTypePolicy
+	}
+	for _, id := range request.Computers {
+		body.Computers = append(body.Computers, logFlushDevice{Id: FromInt(id)})
+	}
+	for _, id := range request.MobileDevices {
+		body.MobileDevices = append(body.MobileDevices, logFlushDevice{Id: FromInt(id)})
+	}
+
+	req, err := l.client.NewRequest(ctx, http.MethodDelete, logFlushBasePath, body, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
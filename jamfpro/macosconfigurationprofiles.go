@@ -0,0 +1,239 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+
+	"github.com/jc0b/go-jamfpro-api/jamfpro/mobileconfig"
+)
+
+const macOSConfigurationProfilesBasePath = "JSSResource/osxconfigurationprofiles"
+
+type MacOSConfigurationProfilesService interface {
+	List(context.Context) ([]MacOSConfigurationProfileListItem, *Response, error)
+	GetByID(context.Context, int) (*MacOSConfigurationProfile, *Response, error)
+	GetByName(context.Context, string) (*MacOSConfigurationProfile, *Response, error)
+	Create(context.Context, *MacOSConfigurationProfileRequest) (*MacOSConfigurationProfile, *Response, error)
+	Update(context.Context, int, *MacOSConfigurationProfileRequest) (*MacOSConfigurationProfile, *Response, error)
+	UpdatePayloads(context.Context, int, func(*mobileconfig.Profile) error) (*MacOSConfigurationProfile, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+}
+
+// MacOSConfigurationProfilesServiceOp handles communication with the macOS configuration profile related
+// methods of the Jamf Pro API.
+type MacOSConfigurationProfilesServiceOp struct {
+	client *Client
+}
+
+var _ MacOSConfigurationProfilesService = &MacOSConfigurationProfilesServiceOp{}
+
+// MacOSConfigurationProfile represents a macOS configuration profile in Jamf Pro. The profile itself is the property
+// list in General.Payloads, which Profile parses.
+type MacOSConfigurationProfile struct {
+	XMLName     xml.Name                             `xml:"os_x_configuration_profile"`
+	General     MacOSConfigurationProfileGeneral     `xml:"general"`
+	Scope       Scope                                `xml:"scope"`
+	SelfService MacOSConfigurationProfileSelfService `xml:"self_service"`
+}
+
+type MacOSConfigurationProfileGeneral struct {
+	Id                 ID               `xml:"id,omitempty"`
+	Name               string           `xml:"name"`
+	Description        string           `xml:"description"`
+	Site               ClassicReference `xml:"site"`
+	Category           ClassicReference `xml:"category"`
+	DistributionMethod string           `xml:"distribution_method,omitempty"`
+	UserRemovable      bool             `xml:"user_removable"`
+	Level              string           `xml:"level,omitempty"`
+	UUID               string           `xml:"uuid,omitempty"`
+	RedeployOnUpdate   string           `xml:"redeploy_on_update,omitempty"`
+	Payloads           CDATA            `xml:"payloads"`
+}
+
+type MacOSConfigurationProfileSelfService struct {
+	InstallButtonText           string `xml:"install_button_text,omitempty"`
+	SelfServiceDescription      string `xml:"self_service_description,omitempty"`
+	ForceUsersToViewDescription bool   `xml:"force_users_to_view_description"`
+	FeatureOnMainPage           bool   `xml:"feature_on_main_page"`
+}
+
+// MacOSConfigurationProfileListItem is the summary of a profile returned when listing all macOS configuration profiles
+type MacOSConfigurationProfileListItem struct {
+	Id   ID     `xml:"id"`
+	Name string `xml:"name"`
+}
+
+// MacOSConfigurationProfileListResponse represents the raw API response to getting all macOS configuration profiles
+type MacOSConfigurationProfileListResponse struct {
+	XMLName  xml.Name                            `xml:"os_x_configuration_profiles"`
+	Size     int                                 `xml:"size"`
+	Profiles []MacOSConfigurationProfileListItem `xml:"os_x_configuration_profile"`
+}
+
+// MacOSConfigurationProfileRequest represents a request to create or update a macOS configuration profile. The
+// payloads can be set from a *mobileconfig.Profile with SetProfile.
+type MacOSConfigurationProfileRequest struct {
+	XMLName     xml.Name                              `xml:"os_x_configuration_profile"`
+	General     MacOSConfigurationProfileGeneral      `xml:"general"`
+	Scope       *Scope                                `xml:"scope,omitempty"`
+	SelfService *MacOSConfigurationProfileSelfService `xml:"self_service,omitempty"`
+}
+
+type MacOSConfigurationProfileResponse struct {
+	Id ID `xml:"id"`
+}
+
+// macOSConfigurationProfilePayloadsUpdate changes only the payloads of a profile, leaving its other settings alone
+type macOSConfigurationProfilePayloadsUpdate struct {
+	XMLName  xml.Name `xml:"os_x_configuration_profile"`
+	Payloads CDATA    `xml:"general>payloads"`
+}
+
+// Profile parses the payloads of the profile.
+func (p *MacOSConfigurationProfile) Profile() (*mobileconfig.Profile, error) {
+	return mobileconfig.Parse([]byte(p.General.Payloads))
+}
+
+// SetProfile sets the payloads of the request to the marshaled profile.
+func (r *MacOSConfigurationProfileRequest) SetProfile(profile *mobileconfig.Profile) error {
+	payloads, err := profile.Marshal()
+	if err != nil {
+		return err
+	}
+	r.General.Payloads = CDATA(payloads)
+	return nil
+}
+
+func (m *MacOSConfigurationProfilesServiceOp) List(ctx context.Context) ([]MacOSConfigurationProfileListItem, *Response, error) {
+	req, err := m.client.NewRequest(ctx, http.MethodGet, macOSConfigurationProfilesBasePath, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var profileResponse MacOSConfigurationProfileListResponse
+	resp, err := m.client.Do(ctx, req, &profileResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return profileResponse.Profiles, resp, err
+}
+
+func (m *MacOSConfigurationProfilesServiceOp) GetByID(ctx context.Context, id int) (*MacOSConfigurationProfile, *Response, error) {
+	path := macOSConfigurationProfilesBasePath + "/id/" + strconv.Itoa(id)
+	return m.get(ctx, path)
+}
+
+func (m *MacOSConfigurationProfilesServiceOp) GetByName(ctx context.Context, name string) (*MacOSConfigurationProfile, *Response, error) {
+	path := pathJoin(macOSConfigurationProfilesBasePath, "name", name)
+	return m.get(ctx, path)
+}
+
+func (m *MacOSConfigurationProfilesServiceOp) Create(ctx context.Context, request *MacOSConfigurationProfileRequest) (*MacOSConfigurationProfile, *Response, error) {
+	path := macOSConfigurationProfilesBasePath + "/id/0"
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	req, err := m.client.NewRequest(ctx, http.MethodPost, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	profileCreation := new(MacOSConfigurationProfileResponse)
+	resp, err := m.client.Do(ctx, req, profileCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, profileCreation.Id, m.GetByID)
+}
+
+func (m *MacOSConfigurationProfilesServiceOp) Update(ctx context.Context, id int, request *MacOSConfigurationProfileRequest) (*MacOSConfigurationProfile, *Response, error) {
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	}
+	return m.update(ctx, id, request)
+}
+
+// UpdatePayloads fetches the profile with the given ID, passes its parsed payloads to edit, and uploads the result,
+// leaving the other settings of the profile unchanged. Nothing is uploaded if edit returns an error.
+func (m *MacOSConfigurationProfilesServiceOp) UpdatePayloads(ctx context.Context, id int, edit func(*mobileconfig.Profile) error) (*MacOSConfigurationProfile, *Response, error) {
+	current, resp, err := m.GetByID(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	profile, err := current.Profile()
+	if err != nil {
+		return nil, resp, err
+	}
+	if err := edit(profile); err != nil {
+		return nil, resp, err
+	}
+	payloads, err := profile.Marshal()
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m.update(ctx, id, &macOSConfigurationProfilePayloadsUpdate{Payloads: CDATA(payloads)})
+}
+
+func (m *MacOSConfigurationProfilesServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := macOSConfigurationProfilesBasePath + "/id/" + strconv.Itoa(id)
+
+	req, err := m.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := m.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DeleteByName deletes the macOS configuration profile called name. The error matches ErrNotFound if there is none.
+func (m *MacOSConfigurationProfilesServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "macOS configuration profile", name, m.GetByName, func(p *MacOSConfigurationProfile) ID { return p.General.Id }, m.Delete)
+}
+
+func (m *MacOSConfigurationProfilesServiceOp) get(ctx context.Context, path string) (*MacOSConfigurationProfile, *Response, error) {
+	req, err := m.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var profile MacOSConfigurationProfile
+	resp, err := m.client.Do(ctx, req, &profile)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &profile, resp, err
+}
+
+func (m *MacOSConfigurationProfilesServiceOp) update(ctx context.Context, id int, body interface{}) (*MacOSConfigurationProfile, *Response, error) {
+	path := macOSConfigurationProfilesBasePath + "/id/" + strconv.Itoa(id)
+	if id == 0 {
+		return nil, nil, NewArgError("profile ID", "cannot be 0")
+	}
+
+	req, err := m.client.NewRequest(ctx, http.MethodPut, path, body, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	profileUpdate := new(MacOSConfigurationProfileResponse)
+	resp, err := m.client.Do(ctx, req, profileUpdate)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m.GetByID(ctx, id)
+}
@@ -0,0 +1,240 @@
+package jamfpro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const mdmCommandsBasePath = "uapi/v2/mdm/commands"
+
+// MDM command types accepted by the v2 MDM commands endpoint
+const (
+	MdmCommandTypeDeviceLock  = "DEVICE_LOCK"
+	MdmCommandTypeEraseDevice = "ERASE_DEVICE"
+)
+
+// MDM command states reported by the v2 MDM commands endpoint
+const (
+	MdmCommandStatePending      = "PENDING"
+	MdmCommandStateAcknowledged = "ACKNOWLEDGED"
+	MdmCommandStateError        = "ERROR"
+	MdmCommandStateNotNow       = "NOT_NOW"
+)
+
+const defaultMdmCommandPollInterval = 5 * time.Second
+
+// maxMdmCommandNotFoundPolls is how many polls in a row WaitForCommandCompletion lets a command be missing for before
+// it gives up on it, which leaves a newly queued command time to become visible
+const maxMdmCommandNotFoundPolls = 12
+
+// Obliteration behaviours for the ERASE_DEVICE command on Macs
+const (
+	ObliterationBehaviorDefault               = "Default"
+	ObliterationBehaviorDoNotObliterate       = "DoNotObliterate"
+	ObliterationBehaviorObliterateWithWarning = "ObliterateWithWarning"
+	ObliterationBehaviorAlways                = "Always"
+)
+
+type MdmCommandsService interface {
+	List(context.Context, *ListOptions) ([]MdmCommand, *Response, error)
+	GetByUUID(context.Context, string) (*MdmCommand, *Response, error)
+	Send(context.Context, *MdmCommandRequest) ([]MdmCommandResult, *Response, error)
+	WaitForCommandCompletion(context.Context, string, time.Duration) (*MdmCommand, error)
+}
+
+// MdmCommandsServiceOp handles communication with the MDM command-related
+// methods of the Jamf Pro API.
+type MdmCommandsServiceOp struct {
+	client *Client
+}
+
+var _ MdmCommandsService = &MdmCommandsServiceOp{}
+
+// MdmCommand represents an MDM command that has been queued for a device
+type MdmCommand struct {
+	Uuid          string           `json:"uuid"`
+	Client        MdmCommandClient `json:"client"`
+	CommandState  string           `json:"commandState"`
+	CommandType   string           `json:"commandType"`
+	DateSent      string           `json:"dateSent"`
+	DateCompleted string           `json:"dateCompleted"`
+}
+
+// MdmCommandClient identifies the device an MDM command is sent to
+type MdmCommandClient struct {
+	ManagementId string `json:"managementId"`
+	ClientType   string `json:"clientType,omitempty"`
+}
+
+// MdmCommandListResponse represents the raw API response to listing MDM commands
+type MdmCommandListResponse struct {
+	TotalCount *int64        `json:"totalCount"`
+	Commands   *[]MdmCommand `json:"results"`
+}
+
+// MdmCommandRequest represents a request to send an MDM command to one or more devices.
+type MdmCommandRequest struct {
+	ClientData  []MdmCommandClient `json:"clientData"`
+	CommandData MdmCommandData     `json:"commandData"`
+}
+
+// MdmCommandData holds the command type and its parameters. Only the parameters relevant to CommandType are sent.
+type MdmCommandData struct {
+	CommandType          string `json:"commandType"`
+	Pin                  string `json:"pin,omitempty"`
+	Message              string `json:"message,omitempty"`
+	PhoneNumber          string `json:"phoneNumber,omitempty"`
+	ObliterationBehavior string `json:"obliterationBehavior,omitempty"`
+}
+
+// MdmCommandResult is returned for every command queued by Send. Id is the command UUID.
+type MdmCommandResult struct {
+	Id   ID     `json:"id"`
+	Href string `json:"href"`
+}
+
+func (m *MdmCommandsServiceOp) List(ctx context.Context, opts *ListOptions) ([]MdmCommand, *Response, error) {
+	path, err := addOptions(mdmCommandsBasePath, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := m.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var commandResponse MdmCommandListResponse
+	resp, err := m.client.Do(ctx, req, &commandResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if commandResponse.Commands == nil {
+		return nil, resp, err
+	}
+
+	return *commandResponse.Commands, resp, err
+}
+
+// GetByUUID returns the MDM command with the given UUID, or an error matching ErrNotFound if there is none.
+func (m *MdmCommandsServiceOp) GetByUUID(ctx context.Context, uuid string) (*MdmCommand, *Response, error) {
+	if uuid == "" {
+		return nil, nil, NewArgError("uuid", "cannot be empty")
+	}
+
+	commands, resp, err := m.List(ctx, &ListOptions{Filter: fmt.Sprintf("uuid==%q", uuid)})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for i := range commands {
+		if commands[i].Uuid == uuid {
+			return &commands[i], resp, err
+		}
+	}
+
+	return nil, resp, fmt.Errorf("%w: no MDM command has UUID %s", ErrNotFound, uuid)
+}
+
+// Send queues an MDM command for the devices listed in the request.
+func (m *MdmCommandsServiceOp) Send(ctx context.Context, request *MdmCommandRequest) ([]MdmCommandResult, *Response, error) {
+	if request == nil {
+		return nil, nil, NewArgError("commandRequest", "cannot be nil")
+	} else if len(request.ClientData) == 0 {
+		return nil, nil, NewArgError("ClientData", "must contain at least one device")
+	} else if request.CommandData.CommandType == "" {
+		return nil, nil, NewArgError("CommandType", "cannot be empty")
+	}
+
+	if request.CommandData.CommandType == MdmCommandTypeEraseDevice {
+		ctx = withDestructive(ctx)
+	}
+
+	req, err := m.client.NewRequest(ctx, http.MethodPost, mdmCommandsBasePath, request, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var results []MdmCommandResult
+	resp, err := m.client.Do(ctx, req, &results)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return results, resp, err
+}
+
+// IsComplete reports whether the device has responded to the command, either by acknowledging it, reporting an
+// error, or telling the server it cannot process the command right now.
+func (m *MdmCommand) IsComplete() bool {
+	switch m.CommandState {
+	case MdmCommandStateAcknowledged, MdmCommandStateError, MdmCommandStateNotNow:
+		return true
+	}
+	return false
+}
+
+// WaitForCommandCompletion polls the status of the command with the given UUID every pollInterval (5 seconds if
+// pollInterval is not positive) until the device has responded to it, and returns the command in its final state.
+// A command the device rejected is returned without an error, so check CommandState. Polling stops with ctx's error
+// when ctx is cancelled, and with an error matching ErrNotFound if the command is missing from 12 polls in a row, e.g.
+// because the UUID is wrong or the command was cleared.
+func (m *MdmCommandsServiceOp) WaitForCommandCompletion(ctx context.Context, uuid string, pollInterval time.Duration) (*MdmCommand, error) {
+	if uuid == "" {
+		return nil, NewArgError("uuid", "cannot be empty")
+	}
+	if pollInterval <= 0 {
+		pollInterval = defaultMdmCommandPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	notFound := 0
+	for {
+		command, _, err := m.GetByUUID(ctx, uuid)
+		if err != nil {
+			if !errors.Is(err, ErrNotFound) {
+				return nil, err
+			}
+			// The command may not be visible yet right after it was queued
+			notFound++
+			if notFound >= maxMdmCommandNotFoundPolls {
+				return nil, err
+			}
+		} else if command.IsComplete() {
+			return command, nil
+		} else {
+			notFound = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendMdmCommand sends a single command to the device with the given management ID and returns the command UUID
+func sendMdmCommand(ctx context.Context, client *Client, managementId string, data MdmCommandData) (string, *Response, error) {
+	request := &MdmCommandRequest{
+		ClientData:  []MdmCommandClient{{ManagementId: managementId}},
+		CommandData: data,
+	}
+
+	results, resp, err := client.MdmCommands.Send(ctx, request)
+	if err != nil {
+		return "", resp, err
+	}
+
+	if len(results) == 0 {
+		return "", resp, NewArgError("command", "was not queued by the server")
+	}
+
+	return results[0].Id.String(), resp, err
+}
@@ -0,0 +1,95 @@
+package jamfpro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const commandUUID = "A1B2C3D4-0000-4000-8000-000000000001"
+
+// commandStates answers MDM command lookups with the command in the next of states, an empty state meaning the
+// command is not listed.
+func commandStates(polls *int64, states ...string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state := states[min(int(atomic.AddInt64(polls, 1)), len(states))-1]
+		w.Header().Set("Content-Type", "application/json")
+		if state == "" {
+			fmt.Fprint(w, `{"totalCount":0,"results":[]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"totalCount":1,"results":[{"uuid":%q,"commandState":%q,"commandType":"DEVICE_LOCK"}]}`, commandUUID, state)
+	})
+}
+
+func TestMdmCommandGetByUUIDNotFound(t *testing.T) {
+	var polls int64
+	client, _ := newTestClient(t, commandStates(&polls, ""))
+
+	_, _, err := client.MdmCommands.GetByUUID(context.Background(), commandUUID)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetByUUID of a missing command = %v, want ErrNotFound", err)
+	}
+	var argErr *ArgError
+	if errors.As(err, &argErr) {
+		t.Errorf("GetByUUID of a missing command returned an ArgError: %v", err)
+	}
+}
+
+func TestWaitForCommandCompletion(t *testing.T) {
+	var polls int64
+	client, _ := newTestClient(t, commandStates(&polls, "", MdmCommandStatePending, MdmCommandStateAcknowledged))
+
+	command, err := client.MdmCommands.WaitForCommandCompletion(context.Background(), commandUUID, time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForCommandCompletion: %v", err)
+	}
+	if command.CommandState != MdmCommandStateAcknowledged {
+		t.Errorf("CommandState = %q, want %q", command.CommandState, MdmCommandStateAcknowledged)
+	}
+	if got := atomic.LoadInt64(&polls); got != 3 {
+		t.Errorf("polled %d times, want 3", got)
+	}
+}
+
+func TestWaitForCommandCompletionStopsOnErrors(t *testing.T) {
+	var polls int64
+	client, _ := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&polls, 1)
+		w.WriteHeader(http.StatusForbidden)
+	}))
+
+	if _, err := client.MdmCommands.WaitForCommandCompletion(context.Background(), commandUUID, time.Millisecond); err == nil {
+		t.Fatal("WaitForCommandCompletion succeeded for a lookup the server refuses")
+	}
+	if got := atomic.LoadInt64(&polls); got != 1 {
+		t.Errorf("polled %d times, want polling to stop after the first error", got)
+	}
+}
+
+func TestWaitForCommandCompletionGivesUpOnMissingCommands(t *testing.T) {
+	var polls int64
+	client, _ := newTestClient(t, commandStates(&polls, ""))
+
+	if _, err := client.MdmCommands.WaitForCommandCompletion(context.Background(), commandUUID, time.Millisecond); !errors.Is(err, ErrNotFound) {
+		t.Errorf("WaitForCommandCompletion of a command that never appears = %v, want ErrNotFound", err)
+	}
+	if got := atomic.LoadInt64(&polls); got != maxMdmCommandNotFoundPolls {
+		t.Errorf("polled %d times, want %d", got, maxMdmCommandNotFoundPolls)
+	}
+}
+
+func TestWaitForCommandCompletionCancelled(t *testing.T) {
+	var polls int64
+	client, _ := newTestClient(t, commandStates(&polls, MdmCommandStatePending))
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.MdmCommands.WaitForCommandCompletion(ctx, commandUUID, time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("WaitForCommandCompletion = %v, want the context's error", err)
+	}
+}
@@ -0,0 +1,52 @@
+package jamfpro
+
+import (
+	"net/http"
+	"time"
+)
+
+// Metrics receives measurements of the client's API calls, e.g. to export them to Prometheus with the
+// jamfpro/prometheus package. Implementations must be safe for concurrent use.
+type Metrics interface {
+	// ObserveRequest is called once every API call has completed, successfully or not.
+	ObserveRequest(RequestObservation)
+
+	// TokenRefreshed is called whenever the client has been issued a new bearer token.
+	TokenRefreshed()
+
+	// RateLimited is called whenever Jamf Pro rejects an API call because too many requests have been made.
+	RateLimited(resource string)
+}
+
+// RequestObservation describes a completed API call
+type RequestObservation struct {
+	// Resource is the API resource called, e.g. computergroups or computer-groups
+	Resource string
+	Method   string
+	// StatusCode is zero if no response was received
+	StatusCode int
+	Duration   time.Duration
+	// Err is the error the call returned, if any
+	Err error
+}
+
+// observeRequest reports a completed API call to the client's Metrics, if it has any.
+func (c *Client) observeRequest(req *http.Request, start time.Time, resp *Response, err error) {
+	if c.metrics == nil {
+		return
+	}
+
+	resource, _ := apiRoute(req.URL.Path)
+	observation := RequestObservation{Resource: resource, Method: req.Method, Duration: time.Since(start)}
+	if resp != nil && resp.Response != nil {
+		observation.StatusCode = resp.StatusCode
+	}
+	if err != nil && err.Error() != "EOF" {
+		observation.Err = err
+	}
+
+	c.metrics.ObserveRequest(observation)
+	if observation.StatusCode == http.StatusTooManyRequests {
+		c.metrics.RateLimited(resource)
+	}
+}
@@ -0,0 +1,253 @@
+package mobileconfig
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const plistHeader = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+`
+
+// UnmarshalPlist decodes an XML property list. Dictionaries are decoded as map[string]interface{}, arrays as
+// []interface{}, integers as int64, reals as float64, dates as time.Time and data as []byte.
+func UnmarshalPlist(data []byte) (interface{}, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	d.Strict = false
+
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("mobileconfig: no property list found")
+		} else if err != nil {
+			return nil, fmt.Errorf("mobileconfig: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Local != "plist" {
+			// Bare values, such as a dict without the plist element, are accepted too.
+			return decodeValue(d, start)
+		}
+
+		for {
+			token, err := d.Token()
+			if err != nil {
+				return nil, fmt.Errorf("mobileconfig: %w", err)
+			}
+			switch t := token.(type) {
+			case xml.StartElement:
+				return decodeValue(d, t)
+			case xml.EndElement:
+				return nil, fmt.Errorf("mobileconfig: empty property list")
+			}
+		}
+	}
+}
+
+func decodeValue(d *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	switch start.Name.Local {
+	case "dict":
+		dict := make(map[string]interface{})
+		var key *string
+		for {
+			token, err := d.Token()
+			if err != nil {
+				return nil, fmt.Errorf("mobileconfig: %w", err)
+			}
+			switch t := token.(type) {
+			case xml.StartElement:
+				if t.Name.Local == "key" {
+					var k string
+					if err := d.DecodeElement(&k, &t); err != nil {
+						return nil, fmt.Errorf("mobileconfig: %w", err)
+					}
+					key = &k
+					continue
+				}
+				if key == nil {
+					return nil, fmt.Errorf("mobileconfig: <%s> in a dict without a key", t.Name.Local)
+				}
+				value, err := decodeValue(d, t)
+				if err != nil {
+					return nil, err
+				}
+				dict[*key] = value
+				key = nil
+			case xml.EndElement:
+				if key != nil {
+					return nil, fmt.Errorf("mobileconfig: key %q has no value", *key)
+				}
+				return dict, nil
+			}
+		}
+	case "array":
+		array := []interface{}{}
+		for {
+			token, err := d.Token()
+			if err != nil {
+				return nil, fmt.Errorf("mobileconfig: %w", err)
+			}
+			switch t := token.(type) {
+			case xml.StartElement:
+				value, err := decodeValue(d, t)
+				if err != nil {
+					return nil, err
+				}
+				array = append(array, value)
+			case xml.EndElement:
+				return array, nil
+			}
+		}
+	case "true", "false":
+		if err := d.Skip(); err != nil {
+			return nil, fmt.Errorf("mobileconfig: %w", err)
+		}
+		return start.Name.Local == "true", nil
+	}
+
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return nil, fmt.Errorf("mobileconfig: %w", err)
+	}
+
+	switch start.Name.Local {
+	case "string":
+		return text, nil
+	case "integer":
+		n, err := strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("mobileconfig: invalid integer %q", text)
+		}
+		return n, nil
+	case "real":
+		f, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+		if err != nil {
+			return nil, fmt.Errorf("mobileconfig: invalid real %q", text)
+		}
+		return f, nil
+	case "date":
+		t, err := time.Parse(time.RFC3339, strings.TrimSpace(text))
+		if err != nil {
+			return nil, fmt.Errorf("mobileconfig: invalid date %q", text)
+		}
+		return t, nil
+	case "data":
+		b, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(text), ""))
+		if err != nil {
+			return nil, fmt.Errorf("mobileconfig: invalid data: %w", err)
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("mobileconfig: unknown property list element <%s>", start.Name.Local)
+	}
+}
+
+// MarshalPlist encodes v as an XML property list. Besides the types UnmarshalPlist returns, it accepts the other Go
+// integer and float types, and slices and string-keyed maps of any supported type. Dictionary keys are written in
+// sorted order, so the same value always encodes to the same bytes.
+func MarshalPlist(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(plistHeader)
+	if err := encodeValue(&buf, reflect.ValueOf(v), 0); err != nil {
+		return nil, err
+	}
+	buf.WriteString("</plist>\n")
+	return buf.Bytes(), nil
+}
+
+// escaper escapes the characters that cannot appear in XML text. Unlike xml.EscapeText it leaves newlines and tabs
+// alone, so multi-line strings such as certificates and scripts stay readable.
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+var (
+	timeType  = reflect.TypeOf(time.Time{})
+	bytesType = reflect.TypeOf([]byte(nil))
+)
+
+func encodeValue(buf *bytes.Buffer, v reflect.Value, depth int) error {
+	indent := strings.Repeat("\t", depth)
+	for v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return fmt.Errorf("mobileconfig: property lists cannot hold nil values")
+		}
+		v = v.Elem()
+	}
+
+	switch {
+	case !v.IsValid():
+		return fmt.Errorf("mobileconfig: property lists cannot hold nil values")
+	case v.Type() == timeType:
+		fmt.Fprintf(buf, "%s<date>%s</date>\n", indent, v.Interface().(time.Time).UTC().Format(time.RFC3339))
+		return nil
+	case v.Type() == bytesType:
+		fmt.Fprintf(buf, "%s<data>%s</data>\n", indent, base64.StdEncoding.EncodeToString(v.Bytes()))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		fmt.Fprintf(buf, "%s<string>%s</string>\n", indent, escaper.Replace(v.String()))
+	case reflect.Bool:
+		if v.Bool() {
+			buf.WriteString(indent + "<true/>\n")
+		} else {
+			buf.WriteString(indent + "<false/>\n")
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		fmt.Fprintf(buf, "%s<integer>%d</integer>\n", indent, v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		fmt.Fprintf(buf, "%s<integer>%d</integer>\n", indent, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		fmt.Fprintf(buf, "%s<real>%s</real>\n", indent, strconv.FormatFloat(v.Float(), 'g', -1, 64))
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			buf.WriteString(indent + "<array/>\n")
+			return nil
+		}
+		buf.WriteString(indent + "<array>\n")
+		for i := 0; i < v.Len(); i++ {
+			if err := encodeValue(buf, v.Index(i), depth+1); err != nil {
+				return err
+			}
+		}
+		buf.WriteString(indent + "</array>\n")
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("mobileconfig: dictionary keys must be strings, not %s", v.Type().Key())
+		}
+		if v.Len() == 0 {
+			buf.WriteString(indent + "<dict/>\n")
+			return nil
+		}
+		keys := make([]string, 0, v.Len())
+		for _, key := range v.MapKeys() {
+			keys = append(keys, key.String())
+		}
+		sort.Strings(keys)
+
+		buf.WriteString(indent + "<dict>\n")
+		for _, key := range keys {
+			fmt.Fprintf(buf, "%s\t<key>%s</key>\n", indent, escaper.Replace(key))
+			value := v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key()))
+			if err := encodeValue(buf, value, depth+1); err != nil {
+				return fmt.Errorf("%w (in key %q)", err, key)
+			}
+		}
+		buf.WriteString(indent + "</dict>\n")
+	default:
+		return fmt.Errorf("mobileconfig: %s values cannot be stored in a property list", v.Type())
+	}
+	return nil
+}
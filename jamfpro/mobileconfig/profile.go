@@ -0,0 +1,274 @@
+// Package mobileconfig reads, edits, writes and signs configuration profiles, the property lists Jamf Pro stores in
+// the payloads of macOS and mobile device configuration profiles. It lets a single payload key be changed without
+// editing the XML by hand:
+//
+//	profile, err := mobileconfig.Parse([]byte(payloads))
+//	if err != nil {
+//		return err
+//	}
+//	for _, payload := range profile.PayloadsOfType("com.apple.screensaver") {
+//		payload.Set("idleTime", 600)
+//	}
+//	payloads, err := profile.Marshal()
+package mobileconfig
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Keys shared by profiles and their payloads
+const (
+	KeyPayloadContent      = "PayloadContent"
+	KeyPayloadDisplayName  = "PayloadDisplayName"
+	KeyPayloadDescription  = "PayloadDescription"
+	KeyPayloadIdentifier   = "PayloadIdentifier"
+	KeyPayloadOrganization = "PayloadOrganization"
+	KeyPayloadType         = "PayloadType"
+	KeyPayloadUUID         = "PayloadUUID"
+	KeyPayloadVersion      = "PayloadVersion"
+)
+
+// ProfileType is the PayloadType of a configuration profile itself, as opposed to one of its payloads
+const ProfileType = "Configuration"
+
+// Profile is a configuration profile: a dictionary of profile-level keys whose PayloadContent array holds the
+// payloads. Changes made through the Payload values it returns are changes to the profile.
+type Profile struct {
+	dict map[string]interface{}
+}
+
+// Payload is one of the payloads of a Profile, such as the settings for a Wi-Fi network or a restrictions payload.
+type Payload struct {
+	dict map[string]interface{}
+}
+
+// New returns an empty profile with the given identifier and display name, and a new UUID.
+func New(identifier, displayName string) *Profile {
+	return &Profile{dict: map[string]interface{}{
+		KeyPayloadContent:     []interface{}{},
+		KeyPayloadDisplayName: displayName,
+		KeyPayloadIdentifier:  identifier,
+		KeyPayloadType:        ProfileType,
+		KeyPayloadUUID:        NewUUID(),
+		KeyPayloadVersion:     1,
+	}}
+}
+
+// Parse reads a configuration profile from its XML property list. A signed profile is accepted too; its signature
+// is not checked, and is lost once the profile is marshaled again.
+func Parse(data []byte) (*Profile, error) {
+	if isSigned(data) {
+		content, err := signedContent(data)
+		if err != nil {
+			return nil, err
+		}
+		data = content
+	}
+
+	value, err := UnmarshalPlist(data)
+	if err != nil {
+		return nil, err
+	}
+	dict, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("mobileconfig: a profile is a dict, not %T", value)
+	}
+	if _, ok := dict[KeyPayloadContent]; ok {
+		if _, ok := dict[KeyPayloadContent].([]interface{}); !ok {
+			return nil, fmt.Errorf("mobileconfig: %s is a %T, not an array", KeyPayloadContent, dict[KeyPayloadContent])
+		}
+	}
+	return &Profile{dict: dict}, nil
+}
+
+// Marshal writes the profile as an XML property list, the form Jamf Pro expects in the payloads of a profile.
+func (p *Profile) Marshal() ([]byte, error) {
+	return MarshalPlist(p.dict)
+}
+
+// Identifier returns the PayloadIdentifier of the profile.
+func (p *Profile) Identifier() string { return stringValue(p.dict, KeyPayloadIdentifier) }
+
+// UUID returns the PayloadUUID of the profile.
+func (p *Profile) UUID() string { return stringValue(p.dict, KeyPayloadUUID) }
+
+// DisplayName returns the PayloadDisplayName of the profile.
+func (p *Profile) DisplayName() string { return stringValue(p.dict, KeyPayloadDisplayName) }
+
+// Get returns the value of a profile-level key.
+func (p *Profile) Get(key string) (interface{}, bool) {
+	value, ok := p.dict[key]
+	return value, ok
+}
+
+// Set sets a profile-level key. PayloadContent is managed through AddPayload and RemovePayload instead.
+func (p *Profile) Set(key string, value interface{}) {
+	p.dict[key] = value
+}
+
+// Payloads returns the payloads of the profile, in order.
+func (p *Profile) Payloads() []*Payload {
+	content, _ := p.dict[KeyPayloadContent].([]interface{})
+	payloads := make([]*Payload, 0, len(content))
+	for _, item := range content {
+		if dict, ok := item.(map[string]interface{}); ok {
+			payloads = append(payloads, &Payload{dict: dict})
+		}
+	}
+	return payloads
+}
+
+// PayloadsOfType returns the payloads whose PayloadType is payloadType, such as com.apple.wifi.managed.
+func (p *Profile) PayloadsOfType(payloadType string) []*Payload {
+	var payloads []*Payload
+	for _, payload := range p.Payloads() {
+		if payload.Type() == payloadType {
+			payloads = append(payloads, payload)
+		}
+	}
+	return payloads
+}
+
+// Payload returns the payload with the given PayloadUUID, or nil if there is none.
+func (p *Profile) Payload(uuid string) *Payload {
+	for _, payload := range p.Payloads() {
+		if strings.EqualFold(payload.UUID(), uuid) {
+			return payload
+		}
+	}
+	return nil
+}
+
+// AddPayload appends a new payload of the given type to the profile and returns it. It is given the identifier, a
+// new UUID and version 1; its settings are then added with Set.
+func (p *Profile) AddPayload(payloadType, identifier string) *Payload {
+	payload := &Payload{dict: map[string]interface{}{
+		KeyPayloadIdentifier: identifier,
+		KeyPayloadType:       payloadType,
+		KeyPayloadUUID:       NewUUID(),
+		KeyPayloadVersion:    1,
+	}}
+	content, _ := p.dict[KeyPayloadContent].([]interface{})
+	p.dict[KeyPayloadContent] = append(content, payload.dict)
+	return payload
+}
+
+// RemovePayload removes the payload with the given PayloadUUID, and reports whether there was one.
+func (p *Profile) RemovePayload(uuid string) bool {
+	content, _ := p.dict[KeyPayloadContent].([]interface{})
+	for i, item := range content {
+		if dict, ok := item.(map[string]interface{}); ok && strings.EqualFold(stringValue(dict, KeyPayloadUUID), uuid) {
+			p.dict[KeyPayloadContent] = append(content[:i:i], content[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// RegenerateUUIDs gives the profile and each of its payloads a new UUID. Devices treat profiles and payloads with
+// the same UUID as the same object, so a profile copied from another one needs new UUIDs before both are deployed.
+func (p *Profile) RegenerateUUIDs() {
+	p.dict[KeyPayloadUUID] = NewUUID()
+	for _, payload := range p.Payloads() {
+		payload.dict[KeyPayloadUUID] = NewUUID()
+	}
+}
+
+// Validate checks that the profile and its payloads have the keys devices require, and that no two payloads share a
+// UUID or an identifier.
+func (p *Profile) Validate() error {
+	var problems []string
+	for _, key := range []string{KeyPayloadIdentifier, KeyPayloadUUID, KeyPayloadType} {
+		if stringValue(p.dict, key) == "" {
+			problems = append(problems, "the profile has no "+key)
+		}
+	}
+	if t := stringValue(p.dict, KeyPayloadType); t != "" && t != ProfileType {
+		problems = append(problems, fmt.Sprintf("the profile has PayloadType %q rather than %q", t, ProfileType))
+	}
+
+	uuids := map[string]bool{strings.ToUpper(p.UUID()): true}
+	identifiers := make(map[string]bool)
+	for i, payload := range p.Payloads() {
+		name := fmt.Sprintf("payload %d", i+1)
+		if payload.Type() != "" {
+			name += " (" + payload.Type() + ")"
+		}
+		for _, key := range []string{KeyPayloadIdentifier, KeyPayloadUUID, KeyPayloadType} {
+			if stringValue(payload.dict, key) == "" {
+				problems = append(problems, name+" has no "+key)
+			}
+		}
+		if uuid := strings.ToUpper(payload.UUID()); uuid != "" {
+			if uuids[uuid] {
+				problems = append(problems, name+" reuses UUID "+payload.UUID())
+			}
+			uuids[uuid] = true
+		}
+		if identifier := payload.Identifier(); identifier != "" {
+			if identifiers[identifier] {
+				problems = append(problems, name+" reuses identifier "+identifier)
+			}
+			identifiers[identifier] = true
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("mobileconfig: invalid profile: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// Type returns the PayloadType of the payload.
+func (p *Payload) Type() string { return stringValue(p.dict, KeyPayloadType) }
+
+// Identifier returns the PayloadIdentifier of the payload.
+func (p *Payload) Identifier() string { return stringValue(p.dict, KeyPayloadIdentifier) }
+
+// UUID returns the PayloadUUID of the payload.
+func (p *Payload) UUID() string { return stringValue(p.dict, KeyPayloadUUID) }
+
+// Get returns the value of a key of the payload, with the types UnmarshalPlist decodes to.
+func (p *Payload) Get(key string) (interface{}, bool) {
+	value, ok := p.dict[key]
+	return value, ok
+}
+
+// Set sets a key of the payload. The value must be of a type MarshalPlist accepts.
+func (p *Payload) Set(key string, value interface{}) {
+	p.dict[key] = value
+}
+
+// Delete removes a key from the payload.
+func (p *Payload) Delete(key string) {
+	delete(p.dict, key)
+}
+
+// Keys returns the keys of the payload, sorted.
+func (p *Payload) Keys() []string {
+	keys := make([]string, 0, len(p.dict))
+	for key := range p.dict {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// NewUUID returns a random UUID in the upper case form Apple uses for payload UUIDs.
+func NewUUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic("mobileconfig: reading random bytes: " + err.Error())
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%X-%X-%X-%X-%X", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+func stringValue(dict map[string]interface{}, key string) string {
+	s, _ := dict[key].(string)
+	return s
+}
@@ -0,0 +1,248 @@
+package mobileconfig
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Object identifiers of the CMS (RFC 5652) structures a signed profile is made of
+var (
+	oidData                   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidSignedData             = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidAttributeContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidAttributeMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	oidAttributeSigningTime   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 5}
+	oidSHA256                 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidRSAEncryption          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+	oidECDSAWithSHA256        = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+)
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo encapContentInfo
+	Certificates     asn1.RawValue
+	SignerInfos      []signerInfo `asn1:"set"`
+}
+
+type encapContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+	EContent     asn1.RawValue
+}
+
+type signerInfo struct {
+	Version            int
+	Sid                issuerAndSerialNumber
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+}
+
+type issuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values asn1.RawValue
+}
+
+type attributes struct {
+	Attributes []attribute `asn1:"set"`
+}
+
+// Sign signs the profile with the certificate and its private key, and returns the signed profile in the DER form
+// devices and Jamf Pro accept. Intermediate certificates are included so devices can build the chain to a trusted
+// root. RSA and ECDSA keys are supported.
+func (p *Profile) Sign(certificate *x509.Certificate, key crypto.Signer, intermediates ...*x509.Certificate) ([]byte, error) {
+	content, err := p.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return Sign(content, certificate, key, intermediates...)
+}
+
+// Sign wraps content, usually a marshaled profile, in a CMS signed-data structure signed with the certificate and
+// its private key.
+func Sign(content []byte, certificate *x509.Certificate, key crypto.Signer, intermediates ...*x509.Certificate) ([]byte, error) {
+	if certificate == nil || key == nil {
+		return nil, fmt.Errorf("mobileconfig: signing needs a certificate and its private key")
+	}
+
+	var signatureAlgorithm pkix.AlgorithmIdentifier
+	switch key.Public().(type) {
+	case *rsa.PublicKey:
+		signatureAlgorithm = pkix.AlgorithmIdentifier{Algorithm: oidRSAEncryption, Parameters: asn1.NullRawValue}
+	case *ecdsa.PublicKey:
+		signatureAlgorithm = pkix.AlgorithmIdentifier{Algorithm: oidECDSAWithSHA256}
+	default:
+		return nil, fmt.Errorf("mobileconfig: %T keys cannot sign profiles", key.Public())
+	}
+	digestAlgorithm := pkix.AlgorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1.NullRawValue}
+
+	contentDigest := sha256.Sum256(content)
+	signedAttrs, err := marshalAttributes([]attributeValue{
+		{oidAttributeContentType, oidData},
+		{oidAttributeMessageDigest, contentDigest[:]},
+		{oidAttributeSigningTime, time.Now().UTC()},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The signature covers the DER encoding of the attributes as a SET, rather than with the implicit tag they carry
+	// in the signer info.
+	attrsDigest := sha256.Sum256(signedAttrs)
+	signature, err := key.Sign(rand.Reader, attrsDigest[:], crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("mobileconfig: signing profile: %w", err)
+	}
+
+	var certificates []byte
+	for _, cert := range append([]*x509.Certificate{certificate}, intermediates...) {
+		certificates = append(certificates, cert.Raw...)
+	}
+
+	eContent, err := asn1.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	implicitAttrs := append([]byte{0xa0}, signedAttrs[1:]...)
+
+	sd, err := asn1.Marshal(signedData{
+		Version:          1,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{digestAlgorithm},
+		EncapContentInfo: encapContentInfo{
+			EContentType: oidData,
+			EContent:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: eContent},
+		},
+		Certificates: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: certificates},
+		SignerInfos: []signerInfo{{
+			Version: 1,
+			Sid: issuerAndSerialNumber{
+				Issuer:       asn1.RawValue{FullBytes: certificate.RawIssuer},
+				SerialNumber: certificate.SerialNumber,
+			},
+			DigestAlgorithm:    digestAlgorithm,
+			SignedAttrs:        asn1.RawValue{FullBytes: implicitAttrs},
+			SignatureAlgorithm: signatureAlgorithm,
+			Signature:          signature,
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("mobileconfig: encoding signed profile: %w", err)
+	}
+
+	return asn1.Marshal(contentInfo{
+		ContentType: oidSignedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: sd},
+	})
+}
+
+type attributeValue struct {
+	oid   asn1.ObjectIdentifier
+	value interface{}
+}
+
+// marshalAttributes returns the DER encoding of the SET of signed attributes.
+func marshalAttributes(values []attributeValue) ([]byte, error) {
+	var attrs attributes
+	for _, v := range values {
+		value, err := asn1.Marshal(v.value)
+		if err != nil {
+			return nil, fmt.Errorf("mobileconfig: encoding attribute %s: %w", v.oid, err)
+		}
+		attrs.Attributes = append(attrs.Attributes, attribute{
+			Type:   v.oid,
+			Values: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: value},
+		})
+	}
+
+	encoded, err := asn1.Marshal(attrs)
+	if err != nil {
+		return nil, fmt.Errorf("mobileconfig: encoding attributes: %w", err)
+	}
+	// Strip the SEQUENCE the struct was encoded as, leaving the SET inside.
+	var outer asn1.RawValue
+	if _, err := asn1.Unmarshal(encoded, &outer); err != nil {
+		return nil, err
+	}
+	return outer.Bytes, nil
+}
+
+// isSigned reports whether data looks like a DER signed profile rather than a property list.
+func isSigned(data []byte) bool {
+	return len(data) > 0 && data[0] == 0x30
+}
+
+// signedContent returns the profile wrapped in a signed profile, without checking the signature.
+func signedContent(data []byte) ([]byte, error) {
+	var info contentInfo
+	if _, err := asn1.Unmarshal(data, &info); err != nil {
+		return nil, fmt.Errorf("mobileconfig: reading signed profile: %w", err)
+	}
+	if !info.ContentType.Equal(oidSignedData) {
+		return nil, fmt.Errorf("mobileconfig: signed profile holds %s rather than signed data", info.ContentType)
+	}
+
+	// Only the encapsulated content is needed, so the signed data is read as a sequence of raw elements.
+	var fields []asn1.RawValue
+	if _, err := asn1.Unmarshal(info.Content.Bytes, &fields); err != nil {
+		return nil, fmt.Errorf("mobileconfig: reading signed profile: %w", err)
+	}
+	if len(fields) < 3 {
+		return nil, fmt.Errorf("mobileconfig: signed profile has no content")
+	}
+
+	var encap encapContentInfo
+	if _, err := asn1.Unmarshal(fields[2].FullBytes, &encap); err != nil {
+		return nil, fmt.Errorf("mobileconfig: reading signed profile content: %w", err)
+	}
+
+	content, err := octets(encap.EContent.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("mobileconfig: reading signed profile content: %w", err)
+	}
+	return bytes.TrimSpace(content), nil
+}
+
+// octets returns the contents of the OCTET STRING encoded in data. BER allows an OCTET STRING to be split into a
+// constructed series of chunks, which some signing tools produce.
+func octets(data []byte) ([]byte, error) {
+	var content []byte
+	for len(data) > 0 {
+		var value asn1.RawValue
+		rest, err := asn1.Unmarshal(data, &value)
+		if err != nil {
+			return nil, err
+		}
+		if value.IsCompound {
+			inner, err := octets(value.Bytes)
+			if err != nil {
+				return nil, err
+			}
+			content = append(content, inner...)
+		} else {
+			content = append(content, value.Bytes...)
+		}
+		data = rest
+	}
+	return content, nil
+}
@@ -0,0 +1,171 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+)
+
+const mobileDeviceAppsBasePath = "JSSResource/mobiledeviceapps"
+
+type MobileDeviceAppsService interface {
+	List(context.Context) ([]MobileDeviceAppListItem, *Response, error)
+	GetByID(context.Context, int) (*MobileDeviceApp, *Response, error)
+	GetByName(context.Context, string) (*MobileDeviceApp, *Response, error)
+	GetByBundleID(context.Context, string) (*MobileDeviceApp, *Response, error)
+	GetByBundleIDAndVersion(context.Context, string, string) (*MobileDeviceApp, *Response, error)
+}
+
+// MobileDeviceAppsServiceOp handles communication with the mobile device app-related
+// methods of the Jamf Pro API.
+//
+// Apps are looked up through the Classic API. The Jamf Pro API has no v1 endpoint that lists or reads mobile device
+// apps: the only one under v1/mobile-device-apps validates a reinstall app config. App Store country codes, which
+// are only in the Jamf Pro API, are looked up through AppStoreCountryCodesService.
+type MobileDeviceAppsServiceOp struct {
+	client *Client
+}
+
+var _ MobileDeviceAppsService = &MobileDeviceAppsServiceOp{}
+
+// MobileDeviceApp represents a Jamf Pro mobile device app, along with the App Store metadata Jamf Pro holds for it
+type MobileDeviceApp struct {
+	XMLName     xml.Name                   `xml:"mobile_device_app"`
+	General     MobileDeviceAppGeneral     `xml:"general"`
+	Scope       Scope                      `xml:"scope"`
+	SelfService MobileDeviceAppSelfService `xml:"self_service"`
+	Vpp         MobileDeviceAppVpp         `xml:"vpp"`
+}
+
+type MobileDeviceAppGeneral struct {
+	Id                               ID                  `xml:"id"`
+	Name                             string              `xml:"name"`
+	DisplayName                      string              `xml:"display_name"`
+	Description                      string              `xml:"description"`
+	BundleId                         string              `xml:"bundle_id"`
+	Version                          string              `xml:"version"`
+	InternalApp                      bool                `xml:"internal_app"`
+	OsType                           string              `xml:"os_type"`
+	Category                         ClassicReference    `xml:"category"`
+	Icon                             MobileDeviceAppIcon `xml:"icon"`
+	ItunesStoreUrl                   string              `xml:"itunes_store_url"`
+	ItunesCountryRegion              string              `xml:"itunes_country_region"`
+	ItunesSyncTime                   int                 `xml:"itunes_sync_time"`
+	MakeAvailableAfterInstall        bool                `xml:"make_available_after_install"`
+	DeploymentType                   string              `xml:"deployment_type"`
+	DeployAutomatically              bool                `xml:"deploy_automatically"`
+	DeployAsManagedApp               bool                `xml:"deploy_as_managed_app"`
+	RemoveAppWhenMdmProfileIsRemoved bool                `xml:"remove_app_when_mdm_profile_is_removed"`
+	PreventBackupOfAppData           bool                `xml:"prevent_backup_of_app_data"`
+	KeepDescriptionAndIconUpToDate   bool                `xml:"keep_description_and_icon_up_to_date"`
+	Free                             bool                `xml:"free"`
+	TakeOverManagement               bool                `xml:"take_over_management"`
+	HostExternally                   bool                `xml:"host_externally"`
+	ExternalUrl                      string              `xml:"external_url"`
+	Site                             ClassicReference    `xml:"site"`
+}
+
+type MobileDeviceAppIcon struct {
+	Id   ID     `xml:"id"`
+	Name string `xml:"name"`
+	Uri  string `xml:"uri"`
+}
+
+type MobileDeviceAppSelfService struct {
+	SelfServiceDescription string `xml:"self_service_description"`
+	FeatureOnMainPage      bool   `xml:"feature_on_main_page"`
+	Notification           bool   `xml:"notification"`
+	NotificationSubject    string `xml:"notification_subject"`
+	NotificationMessage    string `xml:"notification_message"`
+}
+
+type MobileDeviceAppVpp struct {
+	AssignVppDeviceBasedLicenses bool `xml:"assign_vpp_device_based_licenses"`
+	VppAdminAccountId            int  `xml:"vpp_admin_account_id"`
+	TotalVppLicenses             int  `xml:"total_vpp_licenses"`
+	RemainingVppLicenses         int  `xml:"remaining_vpp_licenses"`
+	UsedVppLicenses              int  `xml:"used_vpp_licenses"`
+}
+
+// MobileDeviceAppListItem is the summary of a mobile device app returned when listing all apps
+type MobileDeviceAppListItem struct {
+	Id          ID     `xml:"id"`
+	Name        string `xml:"name"`
+	DisplayName string `xml:"display_name"`
+	BundleId    string `xml:"bundle_id"`
+	Version     string `xml:"version"`
+	InternalApp bool   `xml:"internal_app"`
+}
+
+// MobileDeviceAppListResponse represents the raw API response to getting all mobile device apps
+type MobileDeviceAppListResponse struct {
+	XMLName          xml.Name                  `xml:"mobile_device_applications"`
+	Size             int                       `xml:"size"`
+	MobileDeviceApps []MobileDeviceAppListItem `xml:"mobile_device_application"`
+}
+
+func (m *MobileDeviceAppsServiceOp) List(ctx context.Context) ([]MobileDeviceAppListItem, *Response, error) {
+	return m.list(ctx)
+}
+
+func (m *MobileDeviceAppsServiceOp) GetByID(ctx context.Context, id int) (*MobileDeviceApp, *Response, error) {
+	path := mobileDeviceAppsBasePath + "/id/" + strconv.Itoa(id)
+	return m.get(ctx, path)
+}
+
+func (m *MobileDeviceAppsServiceOp) GetByName(ctx context.Context, name string) (*MobileDeviceApp, *Response, error) {
+	path := pathJoin(mobileDeviceAppsBasePath, "name", name)
+	return m.get(ctx, path)
+}
+
+// GetByBundleID looks up a mobile device app by its App Store bundle identifier, e.g. com.apple.Pages.
+func (m *MobileDeviceAppsServiceOp) GetByBundleID(ctx context.Context, bundleId string) (*MobileDeviceApp, *Response, error) {
+	if bundleId == "" {
+		return nil, nil, NewArgError("bundleId", "cannot be empty")
+	}
+	path := pathJoin(mobileDeviceAppsBasePath, "bundleid", bundleId)
+	return m.get(ctx, path)
+}
+
+// GetByBundleIDAndVersion looks up a specific version of a mobile device app by its bundle identifier.
+func (m *MobileDeviceAppsServiceOp) GetByBundleIDAndVersion(ctx context.Context, bundleId, version string) (*MobileDeviceApp, *Response, error) {
+	if bundleId == "" {
+		return nil, nil, NewArgError("bundleId", "cannot be empty")
+	} else if version == "" {
+		return nil, nil, NewArgError("version", "cannot be empty")
+	}
+	path := pathJoin(mobileDeviceAppsBasePath, "bundleid", bundleId, "version", version)
+	return m.get(ctx, path)
+}
+
+func (m *MobileDeviceAppsServiceOp) get(ctx context.Context, path string) (*MobileDeviceApp, *Response, error) {
+	req, err := m.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mobileDeviceApp MobileDeviceApp
+	resp, err := m.client.Do(ctx, req, &mobileDeviceApp)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &mobileDeviceApp, resp, err
+}
+
+func (m *MobileDeviceAppsServiceOp) list(ctx context.Context) ([]MobileDeviceAppListItem, *Response, error) {
+	path := mobileDeviceAppsBasePath
+	req, err := m.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mobileDeviceAppResponse MobileDeviceAppListResponse
+	resp, err := m.client.Do(ctx, req, &mobileDeviceAppResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mobileDeviceAppResponse.MobileDeviceApps, resp, err
+}
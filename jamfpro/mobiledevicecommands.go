@@ -0,0 +1,362 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const (
+	mobileDeviceCommandsBasePath = "JSSResource/mobiledevicecommands/command"
+	mobileDeviceHistoryBasePath  = "JSSResource/mobiledevicehistory"
+)
+
+// Mobile device command names used by the Classic API
+const (
+	MobileDeviceCommandBlankPush         = "BlankPush"
+	MobileDeviceCommandClearPasscode     = "ClearPasscode"
+	MobileDeviceCommandDeviceLock        = "DeviceLock"
+	MobileDeviceCommandDeviceName        = "DeviceName"
+	MobileDeviceCommandDisableLostMode   = "DisableLostMode"
+	MobileDeviceCommandEnableLostMode    = "EnableLostMode"
+	MobileDeviceCommandEraseDevice       = "EraseDevice"
+	MobileDeviceCommandPlayLostModeSound = "PlayLostModeSound"
+	MobileDeviceCommandRestartDevice     = "RestartDevice"
+	MobileDeviceCommandSettings          = "Settings"
+	MobileDeviceCommandShutDownDevice    = "ShutDownDevice"
+	MobileDeviceCommandUpdateInventory   = "UpdateInventory"
+	MobileDeviceCommandWallpaper         = "Wallpaper"
+)
+
+// Where a WallpaperCommand sets the wallpaper
+const (
+	WallpaperLockScreen = 1
+	WallpaperHomeScreen = 2
+	WallpaperBoth       = 3
+)
+
+type MobileDeviceCommandsService interface {
+	Send(context.Context, []int, MobileDeviceCommand) (*MobileDeviceCommandResult, *Response, error)
+	ListHistory(context.Context, int) (*MobileDeviceCommandHistory, *Response, error)
+}
+
+// MobileDeviceCommandsServiceOp handles communication with the mobile device command related
+// methods of the Jamf Pro API.
+type MobileDeviceCommandsServiceOp struct {
+	client *Client
+}
+
+var _ MobileDeviceCommandsService = &MobileDeviceCommandsServiceOp{}
+
+// MobileDeviceCommand is one of the *Command types of this package, which hold the parameters of a command.
+type MobileDeviceCommand interface {
+	// general validates the command and returns it as sent to the Classic API.
+	general() (*mobileDeviceCommandGeneral, error)
+}
+
+// mobileDeviceCommandGeneral holds the command name and its parameters. Only the parameters relevant to Command are
+// sent.
+type mobileDeviceCommandGeneral struct {
+	Command                string `xml:"command"`
+	LockMessage            string `xml:"lock_message,omitempty"`
+	DeviceName             string `xml:"device_name,omitempty"`
+	LostModeMessage        string `xml:"lost_mode_message,omitempty"`
+	LostModePhone          string `xml:"lost_mode_phone,omitempty"`
+	LostModeFootnote       string `xml:"lost_mode_footnote,omitempty"`
+	AlwaysEnforceLostMode  *bool  `xml:"always_enforce_lost_mode,omitempty"`
+	PreserveDataPlan       *bool  `xml:"preserve_data_plan,omitempty"`
+	DisallowProximitySetup *bool  `xml:"disallow_proximity_setup,omitempty"`
+	DataRoaming            string `xml:"data_roaming,omitempty"`
+	VoiceRoaming           string `xml:"voice_roaming,omitempty"`
+	PersonalHotspot        string `xml:"personal_hotspot,omitempty"`
+	Bluetooth              string `xml:"bluetooth,omitempty"`
+	WallpaperSetting       int    `xml:"wallpaper_setting,omitempty"`
+	WallpaperId            int    `xml:"wallpaper_id,omitempty"`
+	WallpaperContent       string `xml:"wallpaper_content,omitempty"`
+}
+
+// mobileDeviceCommandRequest represents a request to send a command to one or more mobile devices
+type mobileDeviceCommandRequest struct {
+	XMLName       xml.Name                   `xml:"mobile_device_command"`
+	General       mobileDeviceCommandGeneral `xml:"general"`
+	MobileDevices []ClassicReference         `xml:"mobile_devices>mobile_device"`
+}
+
+// MobileDeviceCommandResult represents the API response to sending a mobile device command
+type MobileDeviceCommandResult struct {
+	XMLName       xml.Name                          `xml:"mobile_device_command"`
+	Uuid          string                            `xml:"uuid"`
+	Command       string                            `xml:"command"`
+	MobileDevices []MobileDeviceCommandResultDevice `xml:"mobile_devices>mobile_device"`
+}
+
+// MobileDeviceCommandResultDevice is a device a command was queued for
+type MobileDeviceCommandResultDevice struct {
+	Id           ID     `xml:"id"`
+	ManagementId string `xml:"management_id"`
+	Status       string `xml:"status"`
+}
+
+// BlankPushCommand asks devices to check in, without running anything.
+type BlankPushCommand struct{}
+
+func (BlankPushCommand) general() (*mobileDeviceCommandGeneral, error) {
+	return &mobileDeviceCommandGeneral{Command: MobileDeviceCommandBlankPush}, nil
+}
+
+// ClearPasscodeCommand removes the passcode of devices.
+type ClearPasscodeCommand struct{}
+
+func (ClearPasscodeCommand) general() (*mobileDeviceCommandGeneral, error) {
+	return &mobileDeviceCommandGeneral{Command: MobileDeviceCommandClearPasscode}, nil
+}
+
+// DeviceLockCommand locks devices, showing Message on the lock screen if set.
+type DeviceLockCommand struct {
+	Message string
+}
+
+func (c DeviceLockCommand) general() (*mobileDeviceCommandGeneral, error) {
+	return &mobileDeviceCommandGeneral{Command: MobileDeviceCommandDeviceLock, LockMessage: c.Message}, nil
+}
+
+// DeviceNameCommand renames supervised devices.
+type DeviceNameCommand struct {
+	Name string
+}
+
+func (c DeviceNameCommand) general() (*mobileDeviceCommandGeneral, error) {
+	if c.Name == "" {
+		return nil, NewArgError("Name", "cannot be empty")
+	}
+	return &mobileDeviceCommandGeneral{Command: MobileDeviceCommandDeviceName, DeviceName: c.Name}, nil
+}
+
+// EnableLostModeCommand puts supervised devices in Lost Mode, showing the message, phone number and footnote on the
+// lock screen. At least one of Message and Phone is required.
+type EnableLostModeCommand struct {
+	Message  string
+	Phone    string
+	Footnote string
+
+	// AlwaysEnforce puts the device back in Lost Mode if it is taken out of it without a DisableLostModeCommand,
+	// e.g. by erasing it.
+	AlwaysEnforce bool
+}
+
+func (c EnableLostModeCommand) general() (*mobileDeviceCommandGeneral, error) {
+	if c.Message == "" && c.Phone == "" {
+		return nil, NewArgError("Message", "cannot be empty if Phone is")
+	}
+	return &mobileDeviceCommandGeneral{
+		Command:               MobileDeviceCommandEnableLostMode,
+		LostModeMessage:       c.Message,
+		LostModePhone:         c.Phone,
+		LostModeFootnote:      c.Footnote,
+		AlwaysEnforceLostMode: &c.AlwaysEnforce,
+	}, nil
+}
+
+// DisableLostModeCommand takes devices out of Lost Mode.
+type DisableLostModeCommand struct{}
+
+func (DisableLostModeCommand) general() (*mobileDeviceCommandGeneral, error) {
+	return &mobileDeviceCommandGeneral{Command: MobileDeviceCommandDisableLostMode}, nil
+}
+
+// PlayLostModeSoundCommand plays a sound on devices in Lost Mode, to help find them.
+type PlayLostModeSoundCommand struct{}
+
+func (PlayLostModeSoundCommand) general() (*mobileDeviceCommandGeneral, error) {
+	return &mobileDeviceCommandGeneral{Command: MobileDeviceCommandPlayLostModeSound}, nil
+}
+
+// EraseDeviceCommand erases devices. It is refused on instances protected by WithRequireConfirmation unless sent
+// with a context from ContextWithConfirmation.
+type EraseDeviceCommand struct {
+	// PreserveDataPlan keeps the cellular data plan of devices with an eSIM.
+	PreserveDataPlan bool
+
+	// DisallowProximitySetup stops the device from offering Quick Start with a nearby device after it is erased.
+	DisallowProximitySetup bool
+}
+
+func (c EraseDeviceCommand) general() (*mobileDeviceCommandGeneral, error) {
+	return &mobileDeviceCommandGeneral{
+		Command:                MobileDeviceCommandEraseDevice,
+		PreserveDataPlan:       &c.PreserveDataPlan,
+		DisallowProximitySetup: &c.DisallowProximitySetup,
+	}, nil
+}
+
+// RestartDeviceCommand restarts supervised devices.
+type RestartDeviceCommand struct{}
+
+func (RestartDeviceCommand) general() (*mobileDeviceCommandGeneral, error) {
+	return &mobileDeviceCommandGeneral{Command: MobileDeviceCommandRestartDevice}, nil
+}
+
+// ShutDownDeviceCommand shuts down supervised devices.
+type ShutDownDeviceCommand struct{}
+
+func (ShutDownDeviceCommand) general() (*mobileDeviceCommandGeneral, error) {
+	return &mobileDeviceCommandGeneral{Command: MobileDeviceCommandShutDownDevice}, nil
+}
+
+// UpdateInventoryCommand asks devices to send an inventory update.
+type UpdateInventoryCommand struct{}
+
+func (UpdateInventoryCommand) general() (*mobileDeviceCommandGeneral, error) {
+	return &mobileDeviceCommandGeneral{Command: MobileDeviceCommandUpdateInventory}, nil
+}
+
+// SettingsCommand changes device settings. Nil fields are left as they are, and at least one must be set.
+type SettingsCommand struct {
+	DataRoaming     *bool
+	VoiceRoaming    *bool
+	PersonalHotspot *bool
+	Bluetooth       *bool
+}
+
+func (c SettingsCommand) general() (*mobileDeviceCommandGeneral, error) {
+	if c.DataRoaming == nil && c.VoiceRoaming == nil && c.PersonalHotspot == nil && c.Bluetooth == nil {
+		return nil, NewArgError("SettingsCommand", "must change at least one setting")
+	}
+	if c.VoiceRoaming != nil && *c.VoiceRoaming && c.DataRoaming != nil && !*c.DataRoaming {
+		return nil, NewArgError("VoiceRoaming", "cannot be enabled while DataRoaming is disabled")
+	}
+	return &mobileDeviceCommandGeneral{
+		Command:         MobileDeviceCommandSettings,
+		DataRoaming:     settingValue(c.DataRoaming),
+		VoiceRoaming:    settingValue(c.VoiceRoaming),
+		PersonalHotspot: settingValue(c.PersonalHotspot),
+		Bluetooth:       settingValue(c.Bluetooth),
+	}, nil
+}
+
+// settingValue returns how the Classic API spells turning a setting on or off, or "" to leave it unchanged.
+func settingValue(enable *bool) string {
+	switch {
+	case enable == nil:
+		return ""
+	case *enable:
+		return "enable"
+	}
+	return "disable"
+}
+
+// WallpaperCommand sets the wallpaper of supervised devices, either to an image uploaded to Jamf Pro or to Image.
+type WallpaperCommand struct {
+	// Setting is where the wallpaper is shown, one of the Wallpaper* constants.
+	Setting int
+
+	// ImageId is the ID of an image uploaded to Jamf Pro. Exactly one of ImageId and Image must be set.
+	ImageId int
+
+	// Image is a PNG or JPEG image.
+	Image []byte
+}
+
+func (c WallpaperCommand) general() (*mobileDeviceCommandGeneral, error) {
+	if c.Setting < WallpaperLockScreen || c.Setting > WallpaperBoth {
+		return nil, NewArgError("Setting", fmt.Sprintf("%d is not one of WallpaperLockScreen, WallpaperHomeScreen or WallpaperBoth", c.Setting))
+	}
+	if (c.ImageId == 0) == (len(c.Image) == 0) {
+		return nil, NewArgError("ImageId", "must be set if and only if Image is not")
+	}
+	general := &mobileDeviceCommandGeneral{Command: MobileDeviceCommandWallpaper, WallpaperSetting: c.Setting, WallpaperId: c.ImageId}
+	if len(c.Image) > 0 {
+		general.WallpaperContent = base64.StdEncoding.EncodeToString(c.Image)
+	}
+	return general, nil
+}
+
+// Send queues command for the mobile devices with the given IDs, and returns the UUID of the queued command along with
+// the devices it was queued for.
+func (m *MobileDeviceCommandsServiceOp) Send(ctx context.Context, ids []int, command MobileDeviceCommand) (*MobileDeviceCommandResult, *Response, error) {
+	if len(ids) == 0 {
+		return nil, nil, NewArgError("ids", "must contain at least one mobile device")
+	} else if command == nil {
+		return nil, nil, NewArgError("command", "cannot be nil")
+	}
+
+	general, err := command.general()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request := &mobileDeviceCommandRequest{General: *general}
+	for _, id := range ids {
+		if id == 0 {
+			return nil, nil, NewArgError("ids", "cannot contain 0")
+		}
+		request.MobileDevices = append(request.MobileDevices, ClassicReference{Id: FromInt(id)})
+	}
+
+	if general.Command == MobileDeviceCommandEraseDevice {
+		ctx = withDestructive(ctx)
+	}
+
+	req, err := m.client.NewRequest(ctx, http.MethodPost, mobileDeviceCommandsBasePath, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result MobileDeviceCommandResult
+	resp, err := m.client.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, err
+}
+
+// MobileDeviceCommandHistory lists the commands sent to a mobile device, by whether they completed, are pending or
+// failed.
+type MobileDeviceCommandHistory struct {
+	Completed []MobileDeviceHistoryCommand `xml:"completed>command"`
+	Pending   []MobileDeviceHistoryCommand `xml:"pending>command"`
+	Failed    []MobileDeviceHistoryCommand `xml:"failed>command"`
+}
+
+// MobileDeviceHistoryCommand is a command in the history of a mobile device. Times are in milliseconds since the
+// epoch, and only those matching the state of the command are set.
+type MobileDeviceHistoryCommand struct {
+	Name           string `xml:"name"`
+	Status         string `xml:"status"`
+	Error          string `xml:"error"`
+	IssuedEpoch    int64  `xml:"issued_epoch"`
+	LastPushEpoch  int64  `xml:"last_push_epoch"`
+	CompletedEpoch int64  `xml:"completed_epoch"`
+	FailedEpoch    int64  `xml:"failed_epoch"`
+}
+
+// mobileDeviceHistoryResponse represents the raw API response to getting the management commands of a mobile device
+type mobileDeviceHistoryResponse struct {
+	XMLName            xml.Name                   `xml:"mobile_device_history"`
+	ManagementCommands MobileDeviceCommandHistory `xml:"management_commands"`
+}
+
+// ListHistory returns the commands sent to the mobile device with the given ID.
+func (m *MobileDeviceCommandsServiceOp) ListHistory(ctx context.Context, id int) (*MobileDeviceCommandHistory, *Response, error) {
+	if id == 0 {
+		return nil, nil, NewArgError("mobile device ID", "cannot be 0")
+	}
+	path := mobileDeviceHistoryBasePath + "/id/" + strconv.Itoa(id) + "/subset/ManagementCommands"
+
+	req, err := m.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var history mobileDeviceHistoryResponse
+	resp, err := m.client.Do(ctx, req, &history)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &history.ManagementCommands, resp, err
+}
@@ -0,0 +1,240 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+
+	"github.com/jc0b/go-jamfpro-api/jamfpro/mobileconfig"
+)
+
+const mobileDeviceConfigurationProfilesBasePath = "JSSResource/mobiledeviceconfigurationprofiles"
+
+type MobileDeviceConfigurationProfilesService interface {
+	List(context.Context) ([]MobileDeviceConfigurationProfileListItem, *Response, error)
+	GetByID(context.Context, int) (*MobileDeviceConfigurationProfile, *Response, error)
+	GetByName(context.Context, string) (*MobileDeviceConfigurationProfile, *Response, error)
+	Create(context.Context, *MobileDeviceConfigurationProfileRequest) (*MobileDeviceConfigurationProfile, *Response, error)
+	Update(context.Context, int, *MobileDeviceConfigurationProfileRequest) (*MobileDeviceConfigurationProfile, *Response, error)
+	UpdatePayloads(context.Context, int, func(*mobileconfig.Profile) error) (*MobileDeviceConfigurationProfile, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+}
+
+// MobileDeviceConfigurationProfilesServiceOp handles communication with the mobile device configuration profile
+// related methods of the Jamf Pro API.
+type MobileDeviceConfigurationProfilesServiceOp struct {
+	client *Client
+}
+
+var _ MobileDeviceConfigurationProfilesService = &MobileDeviceConfigurationProfilesServiceOp{}
+
+// MobileDeviceConfigurationProfile represents a mobile device configuration profile in Jamf Pro. The profile itself
+// is the property list in General.Payloads, which Profile parses.
+type MobileDeviceConfigurationProfile struct {
+	XMLName     xml.Name                                    `xml:"configuration_profile"`
+	General     MobileDeviceConfigurationProfileGeneral     `xml:"general"`
+	Scope       Scope                                       `xml:"scope"`
+	SelfService MobileDeviceConfigurationProfileSelfService `xml:"self_service"`
+}
+
+type MobileDeviceConfigurationProfileGeneral struct {
+	Id                                   ID               `xml:"id,omitempty"`
+	Name                                 string           `xml:"name"`
+	Description                          string           `xml:"description"`
+	Site                                 ClassicReference `xml:"site"`
+	Category                             ClassicReference `xml:"category"`
+	DeploymentMethod                     string           `xml:"deployment_method,omitempty"`
+	Level                                string           `xml:"level,omitempty"`
+	UUID                                 string           `xml:"uuid,omitempty"`
+	RedeployOnUpdate                     string           `xml:"redeploy_on_update,omitempty"`
+	RedeployDaysBeforeCertificateExpires int              `xml:"redeploy_Days_before_certificate_expires,omitempty"`
+	Payloads                             CDATA            `xml:"payloads"`
+}
+
+type MobileDeviceConfigurationProfileSelfService struct {
+	InstallButtonText      string `xml:"install_button_text,omitempty"`
+	SelfServiceDescription string `xml:"self_service_description,omitempty"`
+	FeatureOnMainPage      bool   `xml:"feature_on_main_page"`
+}
+
+// MobileDeviceConfigurationProfileListItem is the summary of a profile returned when listing all mobile device
+// configuration profiles
+type MobileDeviceConfigurationProfileListItem struct {
+	Id   ID     `xml:"id"`
+	Name string `xml:"name"`
+}
+
+// MobileDeviceConfigurationProfileListResponse represents the raw API response to getting all mobile device
+// configuration profiles
+type MobileDeviceConfigurationProfileListResponse struct {
+	XMLName  xml.Name                                   `xml:"configuration_profiles"`
+	Size     int                                        `xml:"size"`
+	Profiles []MobileDeviceConfigurationProfileListItem `xml:"configuration_profile"`
+}
+
+// MobileDeviceConfigurationProfileRequest represents a request to create or update a mobile device configuration
+// profile. The payloads can be set from a *mobileconfig.Profile with SetProfile.
+type MobileDeviceConfigurationProfileRequest struct {
+	XMLName     xml.Name                                     `xml:"configuration_profile"`
+	General     MobileDeviceConfigurationProfileGeneral      `xml:"general"`
+	Scope       *Scope                                       `xml:"scope,omitempty"`
+	SelfService *MobileDeviceConfigurationProfileSelfService `xml:"self_service,omitempty"`
+}
+
+type MobileDeviceConfigurationProfileResponse struct {
+	Id ID `xml:"id"`
+}
+
+// mobileDeviceConfigurationProfilePayloadsUpdate changes only the payloads of a profile, leaving its other settings alone
+type mobileDeviceConfigurationProfilePayloadsUpdate struct {
+	XMLName  xml.Name `xml:"configuration_profile"`
+	Payloads CDATA    `xml:"general>payloads"`
+}
+
+// Profile parses the payloads of the profile.
+func (p *MobileDeviceConfigurationProfile) Profile() (*mobileconfig.Profile, error) {
+	return mobileconfig.Parse([]byte(p.General.Payloads))
+}
+
+// SetProfile sets the payloads of the request to the marshaled profile.
+func (r *MobileDeviceConfigurationProfileRequest) SetProfile(profile *mobileconfig.Profile) error {
+	payloads, err := profile.Marshal()
+	if err != nil {
+		return err
+	}
+	r.General.Payloads = CDATA(payloads)
+	return nil
+}
+
+func (m *MobileDeviceConfigurationProfilesServiceOp) List(ctx context.Context) ([]MobileDeviceConfigurationProfileListItem, *Response, error) {
+	req, err := m.client.NewRequest(ctx, http.MethodGet, mobileDeviceConfigurationProfilesBasePath, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var profileResponse MobileDeviceConfigurationProfileListResponse
+	resp, err := m.client.Do(ctx, req, &profileResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return profileResponse.Profiles, resp, err
+}
+
+func (m *MobileDeviceConfigurationProfilesServiceOp) GetByID(ctx context.Context, id int) (*MobileDeviceConfigurationProfile, *Response, error) {
+	path := mobileDeviceConfigurationProfilesBasePath + "/id/" + strconv.Itoa(id)
+	return m.get(ctx, path)
+}
+
+func (m *MobileDeviceConfigurationProfilesServiceOp) GetByName(ctx context.Context, name string) (*MobileDeviceConfigurationProfile, *Response, error) {
+	path := pathJoin(mobileDeviceConfigurationProfilesBasePath, "name", name)
+	return m.get(ctx, path)
+}
+
+func (m *MobileDeviceConfigurationProfilesServiceOp) Create(ctx context.Context, request *MobileDeviceConfigurationProfileRequest) (*MobileDeviceConfigurationProfile, *Response, error) {
+	path := mobileDeviceConfigurationProfilesBasePath + "/id/0"
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	req, err := m.client.NewRequest(ctx, http.MethodPost, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	profileCreation := new(MobileDeviceConfigurationProfileResponse)
+	resp, err := m.client.Do(ctx, req, profileCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, profileCreation.Id, m.GetByID)
+}
+
+func (m *MobileDeviceConfigurationProfilesServiceOp) Update(ctx context.Context, id int, request *MobileDeviceConfigurationProfileRequest) (*MobileDeviceConfigurationProfile, *Response, error) {
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	}
+	return m.update(ctx, id, request)
+}
+
+// UpdatePayloads fetches the profile with the given ID, passes its parsed payloads to edit, and uploads the result,
+// leaving the other settings of the profile unchanged. Nothing is uploaded if edit returns an error.
+func (m *MobileDeviceConfigurationProfilesServiceOp) UpdatePayloads(ctx context.Context, id int, edit func(*mobileconfig.Profile) error) (*MobileDeviceConfigurationProfile, *Response, error) {
+	current, resp, err := m.GetByID(ctx, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	profile, err := current.Profile()
+	if err != nil {
+		return nil, resp, err
+	}
+	if err := edit(profile); err != nil {
+		return nil, resp, err
+	}
+	payloads, err := profile.Marshal()
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m.update(ctx, id, &mobileDeviceConfigurationProfilePayloadsUpdate{Payloads: CDATA(payloads)})
+}
+
+func (m *MobileDeviceConfigurationProfilesServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := mobileDeviceConfigurationProfilesBasePath + "/id/" + strconv.Itoa(id)
+
+	req, err := m.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := m.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DeleteByName deletes the mobile device configuration profile called name. The error matches ErrNotFound if there is none.
+func (m *MobileDeviceConfigurationProfilesServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "mobile device configuration profile", name, m.GetByName, func(p *MobileDeviceConfigurationProfile) ID { return p.General.Id }, m.Delete)
+}
+
+func (m *MobileDeviceConfigurationProfilesServiceOp) get(ctx context.Context, path string) (*MobileDeviceConfigurationProfile, *Response, error) {
+	req, err := m.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var profile MobileDeviceConfigurationProfile
+	resp, err := m.client.Do(ctx, req, &profile)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &profile, resp, err
+}
+
+func (m *MobileDeviceConfigurationProfilesServiceOp) update(ctx context.Context, id int, body interface{}) (*MobileDeviceConfigurationProfile, *Response, error) {
+	path := mobileDeviceConfigurationProfilesBasePath + "/id/" + strconv.Itoa(id)
+	if id == 0 {
+		return nil, nil, NewArgError("profile ID", "cannot be 0")
+	}
+
+	req, err := m.client.NewRequest(ctx, http.MethodPut, path, body, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	profileUpdate := new(MobileDeviceConfigurationProfileResponse)
+	resp, err := m.client.Do(ctx, req, profileUpdate)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m.GetByID(ctx, id)
+}
@@ -0,0 +1,210 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const mobileDeviceInvitationsBasePath = "JSSResource/mobiledeviceinvitations"
+
+// Invitation types of mobile device enrollment invitations
+const (
+	MobileDeviceInvitationTypeDefault            = "DEFAULT"
+	MobileDeviceInvitationTypeUserInitiatedURL   = "USER_INITIATED_URL"
+	MobileDeviceInvitationTypeUserInitiatedEmail = "USER_INITIATED_EMAIL"
+)
+
+type MobileDeviceInvitationsService interface {
+	List(context.Context) ([]MobileDeviceInvitationListItem, *Response, error)
+	GetByID(context.Context, int) (*MobileDeviceInvitation, *Response, error)
+	GetByInvitation(context.Context, string) (*MobileDeviceInvitation, *Response, error)
+	Create(context.Context, *MobileDeviceInvitationRequest) (*MobileDeviceInvitation, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteExpired(context.Context) ([]MobileDeviceInvitationListItem, error)
+	EnrollmentURL(*MobileDeviceInvitation) string
+}
+
+// MobileDeviceInvitationsServiceOp handles communication with the mobile device enrollment invitation related
+// methods of the Jamf Pro API.
+type MobileDeviceInvitationsServiceOp struct {
+	client *Client
+}
+
+var _ MobileDeviceInvitationsService = &MobileDeviceInvitationsServiceOp{}
+
+// MobileDeviceInvitation represents a Jamf Pro mobile device enrollment invitation
+type MobileDeviceInvitation struct {
+	XMLName                    xml.Name         `xml:"mobile_device_invitation"`
+	Id                         ID               `xml:"id"`
+	Invitation                 string           `xml:"invitation"`
+	InvitationStatus           string           `xml:"invitation_status"`
+	InvitationType             string           `xml:"invitation_type"`
+	ExpirationDateEpoch        int64            `xml:"expiration_date_epoch"`
+	MultipleUsesAllowed        bool             `xml:"multiple_uses_allowed"`
+	TimesUsed                  int              `xml:"times_used"`
+	EnrollIntoSite             ClassicReference `xml:"enroll_into_site"`
+	KeepExistingSiteMembership bool             `xml:"keep_existing_site_membership"`
+	Site                       ClassicReference `xml:"site"`
+}
+
+// Expiration returns when the invitation expires.
+func (i *MobileDeviceInvitation) Expiration() time.Time {
+	return time.UnixMilli(i.ExpirationDateEpoch)
+}
+
+// MobileDeviceInvitationListItem is the summary of a mobile device enrollment invitation returned when listing all
+// of them
+type MobileDeviceInvitationListItem struct {
+	Id                  ID     `xml:"id"`
+	Invitation          string `xml:"invitation"`
+	InvitationType      string `xml:"invitation_type"`
+	ExpirationDateEpoch int64  `xml:"expiration_date_epoch"`
+}
+
+// Expiration returns when the invitation expires.
+func (i MobileDeviceInvitationListItem) Expiration() time.Time {
+	return time.UnixMilli(i.ExpirationDateEpoch)
+}
+
+// MobileDeviceInvitationListResponse represents the raw API response to getting all mobile device enrollment
+// invitations
+type MobileDeviceInvitationListResponse struct {
+	XMLName     xml.Name                         `xml:"mobile_device_invitations"`
+	Size        int                              `xml:"size"`
+	Invitations []MobileDeviceInvitationListItem `xml:"mobile_device_invitation"`
+}
+
+// MobileDeviceInvitationRequest represents a request to create a mobile device enrollment invitation. Expiration is
+// required, and must be in the future.
+type MobileDeviceInvitationRequest struct {
+	XMLName                    xml.Name          `xml:"mobile_device_invitation"`
+	InvitationType             string            `xml:"invitation_type"`
+	Expiration                 time.Time         `xml:"-"`
+	ExpirationDateEpoch        int64             `xml:"expiration_date_epoch"`
+	MultipleUsesAllowed        bool              `xml:"multiple_uses_allowed"`
+	EnrollIntoSite             *ClassicReference `xml:"enroll_into_site,omitempty"`
+	KeepExistingSiteMembership bool              `xml:"keep_existing_site_membership"`
+}
+
+// MobileDeviceInvitationResponse represents the API response to creating a mobile device enrollment invitation
+type MobileDeviceInvitationResponse struct {
+	Id ID `xml:"id"`
+}
+
+func (m *MobileDeviceInvitationsServiceOp) List(ctx context.Context) ([]MobileDeviceInvitationListItem, *Response, error) {
+	req, err := m.client.NewRequest(ctx, http.MethodGet, mobileDeviceInvitationsBasePath, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var listResponse MobileDeviceInvitationListResponse
+	resp, err := m.client.Do(ctx, req, &listResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return listResponse.Invitations, resp, err
+}
+
+func (m *MobileDeviceInvitationsServiceOp) GetByID(ctx context.Context, id int) (*MobileDeviceInvitation, *Response, error) {
+	return m.get(ctx, mobileDeviceInvitationsBasePath+"/id/"+strconv.Itoa(id))
+}
+
+// GetByInvitation returns the invitation with the given invitation code.
+func (m *MobileDeviceInvitationsServiceOp) GetByInvitation(ctx context.Context, invitation string) (*MobileDeviceInvitation, *Response, error) {
+	if invitation == "" {
+		return nil, nil, NewArgError("invitation", "cannot be empty")
+	}
+	return m.get(ctx, pathJoin(mobileDeviceInvitationsBasePath, "invitation", invitation))
+}
+
+// Create creates a mobile device enrollment invitation and returns it as stored by the server, with its invitation
+// code.
+func (m *MobileDeviceInvitationsServiceOp) Create(ctx context.Context, request *MobileDeviceInvitationRequest) (*MobileDeviceInvitation, *Response, error) {
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+	epoch, err := invitationExpiration(request.Expiration)
+	if err != nil {
+		return nil, nil, err
+	}
+	body := *request
+	body.ExpirationDateEpoch = epoch
+	if body.InvitationType == "" {
+		body.InvitationType = MobileDeviceInvitationTypeDefault
+	}
+
+	req, err := m.client.NewRequest(ctx, http.MethodPost, mobileDeviceInvitationsBasePath+"/id/0", &body, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invitationCreation := new(MobileDeviceInvitationResponse)
+	resp, err := m.client.Do(ctx, req, invitationCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, invitationCreation.Id, m.GetByID)
+}
+
+func (m *MobileDeviceInvitationsServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := mobileDeviceInvitationsBasePath + "/id/" + strconv.Itoa(id)
+
+	req, err := m.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := m.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DeleteExpired deletes the invitations that have expired, and returns them. It stops at the first invitation that
+// cannot be deleted, returning those deleted before it.
+func (m *MobileDeviceInvitationsServiceOp) DeleteExpired(ctx context.Context) ([]MobileDeviceInvitationListItem, error) {
+	invitations, _, err := m.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var deleted []MobileDeviceInvitationListItem
+	for _, invitation := range invitations {
+		if invitation.ExpirationDateEpoch == 0 || invitation.Expiration().After(now) {
+			continue
+		}
+		if _, err := m.Delete(ctx, invitation.Id.Int()); err != nil && err.Error() != "EOF" {
+			return deleted, err
+		}
+		deleted = append(deleted, invitation)
+	}
+	return deleted, nil
+}
+
+// EnrollmentURL returns the link that enrolls a mobile device with invitation, for user-initiated enrollment.
+func (m *MobileDeviceInvitationsServiceOp) EnrollmentURL(invitation *MobileDeviceInvitation) string {
+	return enrollmentURL(m.client, invitation.Invitation)
+}
+
+func (m *MobileDeviceInvitationsServiceOp) get(ctx context.Context, path string) (*MobileDeviceInvitation, *Response, error) {
+	req, err := m.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var invitation MobileDeviceInvitation
+	resp, err := m.client.Do(ctx, req, &invitation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &invitation, resp, err
+}
@@ -0,0 +1,319 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+const mobileDevicesInventoryBasePath = "uapi/v2/mobile-devices"
+
+// Sections of the mobile device inventory that can be requested with MobileDeviceInventoryListOptions
+const (
+	MobileDeviceInventorySectionGeneral             = "GENERAL"
+	MobileDeviceInventorySectionHardware            = "HARDWARE"
+	MobileDeviceInventorySectionUserAndLocation     = "USER_AND_LOCATION"
+	MobileDeviceInventorySectionPurchasing          = "PURCHASING"
+	MobileDeviceInventorySectionSecurity            = "SECURITY"
+	MobileDeviceInventorySectionApplications        = "APPLICATIONS"
+	MobileDeviceInventorySectionNetwork             = "NETWORK"
+	MobileDeviceInventorySectionExtensionAttributes = "EXTENSION_ATTRIBUTES"
+)
+
+type MobileDevicesInventoryService interface {
+	List(context.Context, *MobileDeviceInventoryListOptions) ([]MobileDeviceInventory, *Response, error)
+	Stream(context.Context, *MobileDeviceInventoryListOptions, func(MobileDeviceInventory) error) (*Response, error)
+	GetByID(context.Context, int) (*MobileDeviceInventory, *Response, error)
+	Update(context.Context, int, *MobileDeviceInventoryUpdateRequest) (*MobileDeviceInventory, *Response, error)
+	UpdatePurchasing(context.Context, int, Purchasing) (*MobileDeviceInventory, *Response, error)
+}
+
+// MobileDevicesInventoryServiceOp handles communication with the v2 mobile device inventory related
+// methods of the Jamf Pro API.
+type MobileDevicesInventoryServiceOp struct {
+	client *Client
+}
+
+var _ MobileDevicesInventoryService = &MobileDevicesInventoryServiceOp{}
+
+// MobileDeviceInventory represents a mobile device as returned by the v2 mobile device endpoints. Sections that were
+// not requested or not returned by the server are nil.
+type MobileDeviceInventory struct {
+	MobileDeviceId      ID                                        `json:"mobileDeviceId"`
+	DeviceType          string                                    `json:"deviceType"`
+	General             *MobileDeviceInventoryGeneral             `json:"general,omitempty"`
+	Hardware            *MobileDeviceInventoryHardware            `json:"hardware,omitempty"`
+	UserAndLocation     *MobileDeviceInventoryUserAndLocation     `json:"userAndLocation,omitempty"`
+	Purchasing          *MobileDeviceInventoryPurchasing          `json:"purchasing,omitempty"`
+	Security            *MobileDeviceInventorySecurity            `json:"security,omitempty"`
+	Network             *MobileDeviceInventoryNetwork             `json:"network,omitempty"`
+	Applications        []MobileDeviceInventoryApplication        `json:"applications,omitempty"`
+	ExtensionAttributes []MobileDeviceInventoryExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type MobileDeviceInventoryGeneral struct {
+	Udid                                 string                                    `json:"udid"`
+	DisplayName                          string                                    `json:"displayName"`
+	AssetTag                             string                                    `json:"assetTag"`
+	SiteId                               ID                                        `json:"siteId"`
+	LastInventoryUpdateDate              string                                    `json:"lastInventoryUpdateDate"`
+	OsVersion                            string                                    `json:"osVersion"`
+	OsRapidSecurityResponse              string                                    `json:"osRapidSecurityResponse"`
+	OsBuild                              string                                    `json:"osBuild"`
+	OsSupplementalBuildVersion           string                                    `json:"osSupplementalBuildVersion"`
+	SoftwareUpdateDeviceId               string                                    `json:"softwareUpdateDeviceId"`
+	IpAddress                            string                                    `json:"ipAddress"`
+	Managed                              bool                                      `json:"managed"`
+	Supervised                           bool                                      `json:"supervised"`
+	DeviceOwnershipType                  string                                    `json:"deviceOwnershipType"`
+	EnrollmentMethodPrestage             *MobileDeviceInventoryPrestage            `json:"enrollmentMethodPrestage,omitempty"`
+	EnrolledViaAutomatedDeviceEnrollment bool                                      `json:"enrolledViaAutomatedDeviceEnrollment"`
+	UserApprovedMdm                      bool                                      `json:"userApprovedMdm"`
+	LastEnrolledDate                     string                                    `json:"lastEnrolledDate"`
+	MdmProfileExpirationDate             string                                    `json:"mdmProfileExpirationDate"`
+	TimeZone                             string                                    `json:"timeZone"`
+	ManagementId                         string                                    `json:"managementId"`
+	ExtensionAttributes                  []MobileDeviceInventoryExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type MobileDeviceInventoryPrestage struct {
+	MobileDevicePrestageId ID     `json:"mobileDevicePrestageId"`
+	ProfileName            string `json:"profileName"`
+}
+
+type MobileDeviceInventoryHardware struct {
+	CapacityMb           int64                                     `json:"capacityMb"`
+	AvailableSpaceMb     int64                                     `json:"availableSpaceMb"`
+	UsedSpacePercentage  int                                       `json:"usedSpacePercentage"`
+	BatteryLevel         int                                       `json:"batteryLevel"`
+	SerialNumber         string                                    `json:"serialNumber"`
+	WifiMacAddress       string                                    `json:"wifiMacAddress"`
+	BluetoothMacAddress  string                                    `json:"bluetoothMacAddress"`
+	ModemFirmwareVersion string                                    `json:"modemFirmwareVersion"`
+	Model                string                                    `json:"model"`
+	ModelIdentifier      string                                    `json:"modelIdentifier"`
+	ModelNumber          string                                    `json:"modelNumber"`
+	DeviceId             string                                    `json:"deviceId"`
+	ExtensionAttributes  []MobileDeviceInventoryExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type MobileDeviceInventoryUserAndLocation struct {
+	Username            string                                    `json:"username"`
+	RealName            string                                    `json:"realName"`
+	EmailAddress        string                                    `json:"emailAddress"`
+	Position            string                                    `json:"position"`
+	PhoneNumber         string                                    `json:"phoneNumber"`
+	DepartmentId        ID                                        `json:"departmentId"`
+	BuildingId          ID                                        `json:"buildingId"`
+	Room                string                                    `json:"room"`
+	ExtensionAttributes []MobileDeviceInventoryExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type MobileDeviceInventoryPurchasing struct {
+	Purchased           bool                                      `json:"purchased"`
+	Leased              bool                                      `json:"leased"`
+	PoNumber            string                                    `json:"poNumber"`
+	Vendor              string                                    `json:"vendor"`
+	AppleCareId         string                                    `json:"appleCareId"`
+	PurchasePrice       string                                    `json:"purchasePrice"`
+	PurchasingAccount   string                                    `json:"purchasingAccount"`
+	PoDate              string                                    `json:"poDate"`
+	WarrantyExpiresDate string                                    `json:"warrantyExpiresDate"`
+	LeaseExpiresDate    string                                    `json:"leaseExpiresDate"`
+	LifeExpectancy      int                                       `json:"lifeExpectancy"`
+	PurchasingContact   string                                    `json:"purchasingContact"`
+	ExtensionAttributes []MobileDeviceInventoryExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type MobileDeviceInventorySecurity struct {
+	DataProtected                          bool   `json:"dataProtected"`
+	BlockLevelEncryptionCapable            bool   `json:"blockLevelEncryptionCapable"`
+	FileLevelEncryptionCapable             bool   `json:"fileLevelEncryptionCapable"`
+	PasscodePresent                        bool   `json:"passcodePresent"`
+	PasscodeCompliant                      bool   `json:"passcodeCompliant"`
+	PasscodeCompliantWithProfile           bool   `json:"passcodeCompliantWithProfile"`
+	HardwareEncryption                     int    `json:"hardwareEncryption"`
+	ActivationLockEnabled                  bool   `json:"activationLockEnabled"`
+	JailBreakDetected                      bool   `json:"jailBreakDetected"`
+	PasscodeLockGracePeriodEnforcedSeconds int    `json:"passcodeLockGracePeriodEnforcedSeconds"`
+	LostModeEnabled                        bool   `json:"lostModeEnabled"`
+	LostModePersistent                     bool   `json:"lostModePersistent"`
+	LostModeMessage                        string `json:"lostModeMessage"`
+	LostModePhoneNumber                    string `json:"lostModePhoneNumber"`
+	LostModeFootnote                       string `json:"lostModeFootnote"`
+}
+
+type MobileDeviceInventoryNetwork struct {
+	CellularTechnology       string `json:"cellularTechnology"`
+	VoiceRoamingEnabled      bool   `json:"voiceRoamingEnabled"`
+	Imei                     string `json:"imei"`
+	Iccid                    string `json:"iccid"`
+	Meid                     string `json:"meid"`
+	CurrentCarrierNetwork    string `json:"currentCarrierNetwork"`
+	CurrentMobileCountryCode string `json:"currentMobileCountryCode"`
+	CurrentMobileNetworkCode string `json:"currentMobileNetworkCode"`
+	HomeCarrierNetwork       string `json:"homeCarrierNetwork"`
+	HomeMobileCountryCode    string `json:"homeMobileCountryCode"`
+	HomeMobileNetworkCode    string `json:"homeMobileNetworkCode"`
+	DataRoamingEnabled       bool   `json:"dataRoamingEnabled"`
+	Roaming                  bool   `json:"roaming"`
+	PersonalHotspotEnabled   bool   `json:"personalHotspotEnabled"`
+	PhoneNumber              string `json:"phoneNumber"`
+}
+
+type MobileDeviceInventoryApplication struct {
+	Identifier       string `json:"identifier"`
+	Name             string `json:"name"`
+	Version          string `json:"version"`
+	ShortVersion     string `json:"shortVersion"`
+	ManagementStatus string `json:"managementStatus"`
+	ValidationStatus bool   `json:"validationStatus"`
+	BundleSize       string `json:"bundleSize"`
+	DynamicSize      string `json:"dynamicSize"`
+}
+
+// MobileDeviceInventoryExtensionAttribute is the value of an extension attribute on a mobile device
+type MobileDeviceInventoryExtensionAttribute struct {
+	Id                                  ID       `json:"id"`
+	Name                                string   `json:"name,omitempty"`
+	Type                                string   `json:"type,omitempty"`
+	Value                               []string `json:"value"`
+	ExtensionAttributeCollectionAllowed bool     `json:"extensionAttributeCollectionAllowed,omitempty"`
+	InventoryDisplay                    string   `json:"inventoryDisplay,omitempty"`
+}
+
+// MobileDeviceInventoryListOptions selects a page of mobile devices, and the sections of their inventory to return.
+// Only the GENERAL section is returned if no Sections are given. Filter takes an RSQL expression over the fields of
+// the requested sections, such as `hardware.serialNumber=="C02XXXXXXXXX"`.
+type MobileDeviceInventoryListOptions struct {
+	ListOptions
+	Sections []string
+}
+
+// EncodeQuery adds the list options, and a section parameter for every section.
+func (o *MobileDeviceInventoryListOptions) EncodeQuery(q *QueryParams) {
+	if o == nil {
+		return
+	}
+	o.ListOptions.EncodeQuery(q)
+	q.Strings("section", o.Sections)
+}
+
+// MobileDeviceInventoryListResponse represents the raw API response to listing mobile device inventory
+type MobileDeviceInventoryListResponse struct {
+	TotalCount int                     `json:"totalCount"`
+	Results    []MobileDeviceInventory `json:"results"`
+}
+
+// MobileDeviceInventoryUpdateRequest represents a request to update the inventory of a mobile device. The update is
+// applied with merge-patch semantics: nil fields and sections are left unchanged on the server, so only set what
+// should change. As nil fields are omitted, the request cannot clear a field by sending null; blank a text field by
+// setting it to an empty string instead. Fields of other types, such as SiteId, cannot be cleared through this API.
+type MobileDeviceInventoryUpdateRequest struct {
+	Name                       *string                                         `json:"name,omitempty"`
+	EnforceName                *bool                                           `json:"enforceName,omitempty"`
+	AssetTag                   *string                                         `json:"assetTag,omitempty"`
+	SiteId                     *ID                                             `json:"siteId,omitempty"`
+	TimeZone                   *string                                         `json:"timeZone,omitempty"`
+	Location                   *MobileDeviceInventoryLocationUpdate            `json:"location,omitempty"`
+	Purchasing                 *MobileDeviceInventoryPurchasingUpdate          `json:"purchasing,omitempty"`
+	UpdatedExtensionAttributes []MobileDeviceInventoryExtensionAttributeUpdate `json:"updatedExtensionAttributes,omitempty"`
+}
+
+type MobileDeviceInventoryLocationUpdate struct {
+	Username     *string `json:"username,omitempty"`
+	RealName     *string `json:"realName,omitempty"`
+	EmailAddress *string `json:"emailAddress,omitempty"`
+	Position     *string `json:"position,omitempty"`
+	PhoneNumber  *string `json:"phoneNumber,omitempty"`
+	DepartmentId *ID     `json:"departmentId,omitempty"`
+	BuildingId   *ID     `json:"buildingId,omitempty"`
+	Room         *string `json:"room,omitempty"`
+}
+
+type MobileDeviceInventoryPurchasingUpdate struct {
+	Purchased           *bool   `json:"purchased,omitempty"`
+	Leased              *bool   `json:"leased,omitempty"`
+	PoNumber            *string `json:"poNumber,omitempty"`
+	Vendor              *string `json:"vendor,omitempty"`
+	AppleCareId         *string `json:"appleCareId,omitempty"`
+	PurchasePrice       *string `json:"purchasePrice,omitempty"`
+	PurchasingAccount   *string `json:"purchasingAccount,omitempty"`
+	PoDate              *string `json:"poDate,omitempty"`
+	WarrantyExpiresDate *string `json:"warrantyExpiresDate,omitempty"`
+	LeaseExpiresDate    *string `json:"leaseExpiresDate,omitempty"`
+	LifeExpectancy      *int    `json:"lifeExpectancy,omitempty"`
+	PurchasingContact   *string `json:"purchasingContact,omitempty"`
+}
+
+// MobileDeviceInventoryExtensionAttributeUpdate sets the value of an extension attribute on a mobile device
+type MobileDeviceInventoryExtensionAttributeUpdate struct {
+	Id    ID       `json:"id"`
+	Name  string   `json:"name,omitempty"`
+	Type  string   `json:"type,omitempty"`
+	Value []string `json:"value"`
+}
+
+// List returns one page of the v2 inventory records of mobile devices. Response.TotalCount holds the number of
+// mobile devices across all pages.
+func (m *MobileDevicesInventoryServiceOp) List(ctx context.Context, opts *MobileDeviceInventoryListOptions) ([]MobileDeviceInventory, *Response, error) {
+	path, err := addOptions(mobileDevicesInventoryBasePath+"/detail", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := m.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var listResponse MobileDeviceInventoryListResponse
+	resp, err := m.client.Do(ctx, req, &listResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return listResponse.Results, resp, err
+}
+
+// GetByID returns the full v2 inventory record of a mobile device, with every section populated.
+func (m *MobileDevicesInventoryServiceOp) GetByID(ctx context.Context, id int) (*MobileDeviceInventory, *Response, error) {
+	path := mobileDevicesInventoryBasePath + "/" + strconv.Itoa(id) + "/detail"
+
+	req, err := m.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mobileDeviceInventory MobileDeviceInventory
+	resp, err := m.client.Do(ctx, req, &mobileDeviceInventory)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &mobileDeviceInventory, resp, err
+}
+
+// Update updates fields such as the name, asset tag, location, purchasing information and extension attribute values
+// of a mobile device, and returns the updated inventory record.
+func (m *MobileDevicesInventoryServiceOp) Update(ctx context.Context, id int, request *MobileDeviceInventoryUpdateRequest) (*MobileDeviceInventory, *Response, error) {
+	path := mobileDevicesInventoryBasePath + "/" + strconv.Itoa(id)
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if id == 0 {
+		return nil, nil, NewArgError("mobile device ID", "cannot be 0")
+	}
+
+	req, err := m.client.NewRequest(ctx, http.MethodPatch, path, request, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mobileDeviceInventory MobileDeviceInventory
+	resp, err := m.client.Do(ctx, req, &mobileDeviceInventory)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &mobileDeviceInventory, resp, err
+}
@@ -0,0 +1,34 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestMobileDevicesInventoryExtensionAttributeIDs(t *testing.T) {
+	var sent MobileDeviceInventoryUpdateRequest
+	client, _ := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+			t.Errorf("decoding the request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"mobileDeviceId":"12","extensionAttributes":[{"id":5,"name":"Cart","value":["3"]}]}`)
+	}))
+
+	request := &MobileDeviceInventoryUpdateRequest{
+		UpdatedExtensionAttributes: []MobileDeviceInventoryExtensionAttributeUpdate{{Id: FromInt(5), Value: []string{"3"}}},
+	}
+	got, _, err := client.MobileDevicesInventory.Update(context.Background(), 12, request)
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if len(sent.UpdatedExtensionAttributes) != 1 || sent.UpdatedExtensionAttributes[0].Id != "5" {
+		t.Errorf("sent extension attributes %+v, want the one with ID 5", sent.UpdatedExtensionAttributes)
+	}
+	if len(got.ExtensionAttributes) != 1 || got.ExtensionAttributes[0].Id.Int() != 5 {
+		t.Errorf("Update returned extension attributes %+v, want the one with the numeric ID 5", got.ExtensionAttributes)
+	}
+}
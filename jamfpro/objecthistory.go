@@ -0,0 +1,128 @@
+package jamfpro
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// HistoryObjectType names a kind of object whose change history the Jamf Pro API exposes at
+// /v1/<type>/<id>/history.
+type HistoryObjectType string
+
+const (
+	HistoryObjectBuildings   HistoryObjectType = "buildings"
+	HistoryObjectCategories  HistoryObjectType = "categories"
+	HistoryObjectDepartments HistoryObjectType = "departments"
+	HistoryObjectScripts     HistoryObjectType = "scripts"
+)
+
+// historyDateFormat is the timestamp format history entries are filtered and reported in
+const historyDateFormat = "2006-01-02T15:04:05.000Z"
+
+// HistoryService reads the change history of individual objects. Jamf Pro has no instance-wide audit log endpoint;
+// settings histories are available from the settings services, such as CheckInSettings.ListHistory, and an export
+// pipeline combines those with the object histories from here.
+type HistoryService interface {
+	List(context.Context, HistoryObjectType, string, *HistoryOptions) ([]HistoryEntry, *Response, error)
+	ListAll(context.Context, HistoryObjectType, string, *HistoryOptions) ([]HistoryEntry, *Response, error)
+	AddNote(context.Context, HistoryObjectType, string, string) (*HistoryNoteResponse, *Response, error)
+}
+
+// HistoryServiceOp handles communication with the object history
+// methods of the Jamf Pro API.
+type HistoryServiceOp struct {
+	client *Client
+}
+
+var _ HistoryService = &HistoryServiceOp{}
+
+// HistoryOptions selects history entries. Since and Until restrict the entries to a time range and are combined
+// with any Filter given in ListOptions.
+type HistoryOptions struct {
+	ListOptions
+	Since time.Time
+	Until time.Time
+}
+
+// Time returns the time the history entry was recorded.
+func (h HistoryEntry) Time() (time.Time, error) {
+	return time.Parse(time.RFC3339, h.Date)
+}
+
+// List returns one page of the history of an object.
+func (h *HistoryServiceOp) List(ctx context.Context, objectType HistoryObjectType, id string, opts *HistoryOptions) ([]HistoryEntry, *Response, error) {
+	path, err := objectHistoryPath(objectType, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return listHistory(ctx, h.client, path, opts.listOptions())
+}
+
+// ListAll returns the complete history of an object, fetching every page.
+func (h *HistoryServiceOp) ListAll(ctx context.Context, objectType HistoryObjectType, id string, opts *HistoryOptions) ([]HistoryEntry, *Response, error) {
+	path, err := objectHistoryPath(objectType, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	listOpts := opts.listOptions()
+	if listOpts == nil {
+		listOpts = &ListOptions{}
+	}
+	if listOpts.PageSize == 0 {
+		listOpts.PageSize = defaultPageSize
+	}
+
+	var entries []HistoryEntry
+	for {
+		page, resp, err := listHistory(ctx, h.client, path, listOpts)
+		if err != nil {
+			return entries, resp, err
+		}
+		entries = append(entries, page...)
+		if len(page) < listOpts.PageSize || (resp.TotalCount > 0 && len(entries) >= resp.TotalCount) {
+			return entries, resp, nil
+		}
+		listOpts.Page++
+	}
+}
+
+// AddNote adds a note to the history of an object.
+func (h *HistoryServiceOp) AddNote(ctx context.Context, objectType HistoryObjectType, id string, note string) (*HistoryNoteResponse, *Response, error) {
+	path, err := objectHistoryPath(objectType, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return addHistoryNote(ctx, h.client, path, note)
+}
+
+func objectHistoryPath(objectType HistoryObjectType, id string) (string, error) {
+	if objectType == "" {
+		return "", NewArgError("objectType", "cannot be empty")
+	} else if id == "" {
+		return "", NewArgError("id", "cannot be empty")
+	}
+	return pathJoin("uapi/v1/"+string(objectType), id, "history"), nil
+}
+
+// listOptions returns the ListOptions to send, with the time range folded into the RSQL filter
+func (o *HistoryOptions) listOptions() *ListOptions {
+	if o == nil {
+		return nil
+	}
+
+	opts := o.ListOptions
+	var filters []string
+	if opts.Filter != "" {
+		filters = append(filters, opts.Filter)
+	}
+	if !o.Since.IsZero() {
+		filters = append(filters, "date>="+o.Since.UTC().Format(historyDateFormat))
+	}
+	if !o.Until.IsZero() {
+		filters = append(filters, "date<="+o.Until.UTC().Format(historyDateFormat))
+	}
+	opts.Filter = strings.Join(filters, ";")
+	return &opts
+}
@@ -0,0 +1,415 @@
+package jamfpro
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ClientOption configures optional behaviour of a Client. Options are applied by NewClient before the first bearer
+// token is requested.
+type ClientOption func(*Client) error
+
+// WithHTTPClient makes the client send its requests, including those for bearer tokens, with httpClient instead of
+// http.DefaultClient. Pass it before any of the options configuring TLS or proxies, which change its transport.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return NewArgError("httpClient", "cannot be nil")
+		}
+		c.client = httpClient
+		c.customHTTPClient = true
+		return nil
+	}
+}
+
+// WithTokenStore makes the client persist bearer tokens to store, and reuse a still-valid token from it instead of
+// requesting a new one. A store that cannot be read or written does not fail requests: the client requests its own
+// token instead, and logs the error to the logger given with WithLogger.
+func WithTokenStore(store TokenStore) ClientOption {
+	return func(c *Client) error {
+		if store == nil {
+			return NewArgError("store", "cannot be nil")
+		}
+		c.tokenStore = store
+		return nil
+	}
+}
+
+// WithLogger makes the client log problems it works around rather than returns, such as a TokenStore that cannot be
+// read or written. The client logs nothing without it.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) error {
+		if logger == nil {
+			return NewArgError("logger", "cannot be nil")
+		}
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithUserAgent replaces the default User-Agent of go-jamfpro-api/<version>, e.g. to identify the integration
+// making the requests in the Jamf Pro access logs.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		if userAgent == "" {
+			return NewArgError("userAgent", "cannot be empty")
+		}
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithComputerGroupsV1 makes Client.ComputerGroups use the Jamf Pro API computer group endpoints instead of the
+// Classic API wherever they are available, so consumers can move off the Classic XML path without changing code.
+func WithComputerGroupsV1() ClientOption {
+	return func(c *Client) error {
+		c.ComputerGroups = &ComputerGroupsV1ServiceOp{client: c, classic: &ComputerGroupsServiceOp{client: c}}
+		return nil
+	}
+}
+
+// WithClassicUsageRecorder makes the client report every request to a Classic API endpoint to recorder, e.g. a
+// ClassicUsageCounter, to find the Classic API usage left to migrate before Jamf retires it.
+func WithClassicUsageRecorder(recorder ClassicUsageRecorder) ClientOption {
+	return func(c *Client) error {
+		if recorder == nil {
+			return NewArgError("recorder", "cannot be nil")
+		}
+		c.classicUsage = recorder
+		return nil
+	}
+}
+
+// WithTelemetry makes the client trace every API call with a span from tp, recording the resource and operation,
+// the status code and how often the request was retried.
+func WithTelemetry(tp trace.TracerProvider) ClientOption {
+	return func(c *Client) error {
+		if tp == nil {
+			return NewArgError("tp", "cannot be nil")
+		}
+		c.tracer = tp.Tracer(tracerName, trace.WithInstrumentationVersion(Version()))
+		return nil
+	}
+}
+
+// WithMetrics makes the client report the outcome and latency of every API call, token refreshes and rate limiting
+// to metrics.
+func WithMetrics(metrics Metrics) ClientOption {
+	return func(c *Client) error {
+		if metrics == nil {
+			return NewArgError("metrics", "cannot be nil")
+		}
+		c.metrics = metrics
+		return nil
+	}
+}
+
+// WithCircuitBreaker makes the client stop sending requests for coolDown once failures consecutive calls have
+// failed with a server error or timed out, returning ErrCircuitOpen instead. This keeps bulk jobs from hammering a
+// struggling instance. After the cool-down a single call is let through to test whether Jamf Pro has recovered.
+func WithCircuitBreaker(failures int, coolDown time.Duration) ClientOption {
+	return func(c *Client) error {
+		if failures < 1 {
+			return NewArgError("failures", "must be at least 1")
+		} else if coolDown <= 0 {
+			return NewArgError("coolDown", "must be positive")
+		}
+		c.breaker = &circuitBreaker{failureThreshold: failures, coolDown: coolDown}
+		return nil
+	}
+}
+
+// WithTLSConfig makes the client use config for its connections to Jamf Pro. The config is cloned, so changing it
+// afterwards has no effect.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) error {
+		if config == nil {
+			return NewArgError("config", "cannot be nil")
+		}
+		transport, err := c.httpTransport()
+		if err != nil {
+			return err
+		}
+		transport.TLSClientConfig = config.Clone()
+		return nil
+	}
+}
+
+// WithCACert makes the client trust the PEM encoded CA certificates in pem, in addition to the system roots, e.g.
+// for an on-premise Jamf Pro server with a certificate issued by a private CA.
+func WithCACert(pem []byte) ClientOption {
+	return func(c *Client) error {
+		config, err := c.tlsConfig()
+		if err != nil {
+			return err
+		}
+		return appendCACert(config, pem)
+	}
+}
+
+// WithInsecureSkipVerify makes the client accept any certificate Jamf Pro presents. This leaves the connection open
+// to interception, so only use it when testing.
+func WithInsecureSkipVerify() ClientOption {
+	return func(c *Client) error {
+		config, err := c.tlsConfig()
+		if err != nil {
+			return err
+		}
+		config.InsecureSkipVerify = true
+		return nil
+	}
+}
+
+// WithProxyURL sends all requests through the HTTP(S) proxy at proxyURL, e.g. http://proxy.example.com:3128,
+// instead of the proxy configured in the environment.
+func WithProxyURL(proxyURL string) ClientOption {
+	return func(c *Client) error {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return err
+		} else if u.Scheme == "" || u.Host == "" {
+			return NewArgError("proxyURL", "it must be an absolute URL")
+		}
+		transport, err := c.httpTransport()
+		if err != nil {
+			return err
+		}
+		transport.Proxy = http.ProxyURL(u)
+		return nil
+	}
+}
+
+// WithClientCertificate makes the client present cert to servers that ask for a client certificate, e.g. a
+// reverse proxy enforcing mutual TLS in front of Jamf Pro.
+func WithClientCertificate(cert tls.Certificate) ClientOption {
+	return func(c *Client) error {
+		if _, err := certificateLeaf(&cert); err != nil {
+			return err
+		}
+		config, err := c.tlsConfig()
+		if err != nil {
+			return err
+		}
+		config.Certificates = []tls.Certificate{cert}
+		config.GetClientCertificate = nil
+		return nil
+	}
+}
+
+// WithClientCertificateFiles is like WithClientCertificate, but loads the PEM encoded certificate and key from
+// certFile and keyFile. They are loaded again once the certificate has expired, or when either file changes, so
+// renewing the certificate on disk does not require a new Client.
+func WithClientCertificateFiles(certFile, keyFile string) ClientOption {
+	return func(c *Client) error {
+		files, err := newClientCertificateFiles(certFile, keyFile)
+		if err != nil {
+			return err
+		}
+		config, err := c.tlsConfig()
+		if err != nil {
+			return err
+		}
+		config.Certificates = nil
+		config.GetClientCertificate = files.GetClientCertificate
+		return nil
+	}
+}
+
+// WithCache caches the results of List and GetByName for the given resources, or all of buildings, categories,
+// departments and sites if none are given, for ttl. Creating, updating or deleting an object through the client
+// empties the cache of its resource, but changes made elsewhere go unnoticed until ttl has passed. Pass WithCache
+// again to cache different resources for a different time.
+func WithCache(ttl time.Duration, resources ...CachedResource) ClientOption {
+	return func(c *Client) error {
+		if ttl <= 0 {
+			return NewArgError("ttl", "must be positive")
+		}
+		if len(resources) == 0 {
+			resources = cachedResources
+		}
+		return c.enableCache(ttl, resources)
+	}
+}
+
+// WithRequireConfirmation refuses deletes and erase commands with an ErrProtectedInstance unless the host of the
+// instance matches one of patterns, such as "*.sandbox.jamfcloud.com" or "localhost:*", so automation that is
+// accidentally pointed at production cannot wipe it. Requests made with a context from ContextWithConfirmation are
+// always sent.
+func WithRequireConfirmation(patterns ...string) ClientOption {
+	return func(c *Client) error {
+		guard, err := newInstanceGuard(patterns)
+		if err != nil {
+			return err
+		}
+		c.guard = guard
+		return nil
+	}
+}
+
+// WithReadOnly makes the client refuse every request that would change the instance with an *ErrReadOnlyClient,
+// before it is sent, so dashboards and reports can share code with the tools that make changes without being able to
+// make any. Exports and connection tests are still sent, as they only read.
+func WithReadOnly() ClientOption {
+	return func(c *Client) error {
+		c.readOnly = true
+		return nil
+	}
+}
+
+// WithScriptValidation makes Client.Scripts check scripts with ValidateScript before creating or updating them, and
+// refuse those with errors. The *ScriptValidationReport is returned as the error, so the issues can be listed.
+func WithScriptValidation(opts ScriptValidationOptions) ClientOption {
+	return func(c *Client) error {
+		if opts.MaxSize < 0 {
+			return NewArgError("MaxSize", "cannot be negative")
+		}
+		c.Scripts = &ScriptsServiceOp{client: c, validate: &opts}
+		return nil
+	}
+}
+
+// WithTokenURL makes the client request bearer tokens from tokenURL instead of the instance's /api/oauth/token, e.g.
+// when an API gateway in front of Jamf Pro proxies the token endpoint at another address.
+func WithTokenURL(tokenURL string) ClientOption {
+	return func(c *Client) error {
+		u, err := url.Parse(tokenURL)
+		if err != nil || !u.IsAbs() {
+			return NewArgError("tokenURL", "must be an absolute URL")
+		}
+		c.tokenURL = u.String()
+		return nil
+	}
+}
+
+// WithScopes requests bearer tokens limited to scopes, which are sent space separated in the scope parameter of the
+// client credentials request. Tokens issued for different scopes are kept apart in a TokenStore.
+func WithScopes(scopes ...string) ClientOption {
+	return func(c *Client) error {
+		if len(scopes) == 0 {
+			return NewArgError("scopes", "cannot be empty")
+		}
+		for _, scope := range scopes {
+			if scope == "" || strings.ContainsAny(scope, " \t\n") {
+				return NewArgError("scopes", fmt.Sprintf("%q is not a valid scope", scope))
+			}
+		}
+		c.scopes = append([]string(nil), scopes...)
+		return nil
+	}
+}
+
+// WithOAuthDiscovery makes the client look up its token endpoint in the RFC 8414 authorization server metadata of
+// issuer, or of the instance if issuer is empty, before requesting its first bearer token. Servers that publish no
+// metadata are asked at their usual token endpoint. WithTokenURL takes precedence over discovery.
+func WithOAuthDiscovery(issuer string) ClientOption {
+	return func(c *Client) error {
+		metadata := *c.instanceUrl
+		if issuer != "" {
+			u, err := url.Parse(issuer)
+			if err != nil || !u.IsAbs() {
+				return NewArgError("issuer", "must be an absolute URL")
+			}
+			metadata = *u
+		}
+		// The well-known path goes between the host and any path of the issuer.
+		metadata.Path = uriOAuthDiscovery + strings.TrimSuffix(metadata.Path, "/")
+		metadata.RawPath = ""
+		metadata.RawQuery = ""
+		metadata.Fragment = ""
+		c.discoveryURL = metadata.String()
+		return nil
+	}
+}
+
+// WithSessionAffinity sets how the client keeps its requests on one node of a clustered instance, overriding the
+// session token passed to NewClient. The default is SessionAffinityFromFirstResponse.
+func WithSessionAffinity(affinity SessionAffinity) ClientOption {
+	return func(c *Client) error {
+		if affinity.mode == sessionAffinityPinned && affinity.value == "" {
+			return NewArgError("affinity", "cannot pin an empty cookie value")
+		}
+		c.setSessionAffinity(affinity)
+		return nil
+	}
+}
+
+// WithSessionAffinityHook calls hook whenever a response changes an affinity cookie the client sends, e.g. to log
+// that the load balancer moved the session to another node. hook is called synchronously, once per cookie changed.
+func WithSessionAffinityHook(hook func(SessionAffinityChange)) ClientOption {
+	return func(c *Client) error {
+		if hook == nil {
+			return NewArgError("hook", "cannot be nil")
+		}
+		c.affinityHook = hook
+		return nil
+	}
+}
+
+// WithTimeouts bounds how long connecting to Jamf Pro, including the TLS handshake, may take, and how long Jamf Pro
+// may take to start responding once a request has been sent. A zero timeout leaves that phase unbounded. Clients use
+// 30 seconds to connect and 60 seconds to read by default, unless given their own client with WithHTTPClient, whose
+// transport is then left as it is.
+func WithTimeouts(connect, read time.Duration) ClientOption {
+	return func(c *Client) error {
+		if connect < 0 || read < 0 {
+			return NewArgError("timeout", "cannot be negative")
+		}
+		c.connectTimeout, c.readTimeout = connect, read
+		c.timeoutsSet = true
+		return nil
+	}
+}
+
+// RequestOption modifies a single outgoing request. Options can be passed to NewRequest or Do.
+type RequestOption func(*http.Request)
+
+// WithHeader sets the header key to value, replacing any value set by the client.
+func WithHeader(key, value string) RequestOption {
+	return func(r *http.Request) {
+		r.Header.Set(key, value)
+	}
+}
+
+// WithQueryParam adds value to the query parameter key, keeping any values already present.
+func WithQueryParam(key, value string) RequestOption {
+	return func(r *http.Request) {
+		q := r.URL.Query()
+		q.Add(key, value)
+		r.URL.RawQuery = q.Encode()
+	}
+}
+
+// WithAcceptXML asks the server to respond with XML rather than JSON. This is mostly useful for Classic API
+// endpoints, some of which return more complete data as XML.
+func WithAcceptXML() RequestOption {
+	return func(r *http.Request) {
+		r.Header.Set("Accept", "application/xml")
+	}
+}
+
+type requestTimeoutKey struct{}
+
+// WithRequestTimeout limits a single call to d, including reading its response, on top of any deadline of the
+// context it is made with. It suits calls known to be slow, or that must fail fast, without deriving a context for
+// each.
+func WithRequestTimeout(d time.Duration) RequestOption {
+	return func(r *http.Request) {
+		*r = *r.WithContext(context.WithValue(r.Context(), requestTimeoutKey{}, d))
+	}
+}
+
+func applyRequestOptions(r *http.Request, opts []RequestOption) {
+	for _, opt := range opts {
+		if opt != nil {
+			opt(r)
+		}
+	}
+}
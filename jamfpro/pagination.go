@@ -0,0 +1,72 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// ListOptions specifies the paging, sorting and filtering parameters understood by the list endpoints of the Jamf Pro
+// (v1 and later) API. Page is zero-based. Sort takes a comma separated list of field:direction pairs, e.g.
+// "name:asc", and Filter an RSQL expression, e.g. name=="Marketing*".
+type ListOptions struct {
+	Page     int
+	PageSize int
+	Sort     string
+	Filter   string
+}
+
+// EncodeQuery adds the options as the page, page-size, sort and filter parameters.
+func (o *ListOptions) EncodeQuery(q *QueryParams) {
+	if o == nil {
+		return
+	}
+	q.Int("page", o.Page).Int("page-size", o.PageSize).String("sort", o.Sort).String("filter", o.Filter)
+}
+
+// nameFilter returns an RSQL filter matching objects whose name is exactly name.
+func nameFilter(name string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(name)
+	return `name=="` + escaped + `"`
+}
+
+// maxPresize caps the capacity listAll reserves from a totalCount, so that a bogus count cannot exhaust memory.
+const maxPresize = 1 << 16
+
+// listAll fetches every page of a Jamf Pro API list endpoint, sorted by ID so the pages do not shift while they are
+// read. The result is sized from the totalCount of the first page and each page is appended to it. Reading stops at a
+// short page, or once totalCount objects have been read if the endpoint reports a count.
+func listAll[T any](ctx context.Context, client *Client, path string) ([]T, *Response, error) {
+	opts := &ListOptions{PageSize: defaultPageSize, Sort: "id:asc"}
+
+	var all []T
+	for {
+		pagePath, err := addOptions(path, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		req, err := client.NewRequest(ctx, http.MethodGet, pagePath, nil, "application/json")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var page struct {
+			TotalCount int `json:"totalCount"`
+			Results    []T `json:"results"`
+		}
+		resp, err := client.Do(ctx, req, &page)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		if all == nil {
+			all = make([]T, 0, min(max(page.TotalCount, len(page.Results)), maxPresize))
+		}
+		all = append(all, page.Results...)
+		if len(page.Results) < opts.PageSize || (page.TotalCount > 0 && len(all) >= page.TotalCount) {
+			return all, resp, nil
+		}
+		opts.Page++
+	}
+}
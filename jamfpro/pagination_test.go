@@ -0,0 +1,44 @@
+package jamfpro
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestListAllWithoutTotalCount(t *testing.T) {
+	const total = 2*defaultPageSize + 50
+	var pages int64
+	client, _ := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&pages, 1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+		// Leave out totalCount, as some endpoints do
+		var b strings.Builder
+		b.WriteString(`{"results":[`)
+		for i := page*defaultPageSize + 1; i <= min(total, (page+1)*defaultPageSize); i++ {
+			if i > page*defaultPageSize+1 {
+				b.WriteByte(',')
+			}
+			fmt.Fprintf(&b, `{"id":"%d","name":"Department %d"}`, i, i)
+		}
+		b.WriteString(`]}`)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, b.String())
+	}))
+
+	departments, _, err := client.Departments.List(context.Background())
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(departments) != total {
+		t.Errorf("List returned %d departments, want %d", len(departments), total)
+	}
+	if got := atomic.LoadInt64(&pages); got != 3 {
+		t.Errorf("fetched %d pages, want 3", got)
+	}
+}
@@ -0,0 +1,26 @@
+package jamfpro
+
+import (
+	"net/url"
+	"strings"
+)
+
+// pathJoin returns base followed by each of segments, escaped with escapePathSegment, separated by slashes. Every path
+// built from a name, serial number or other caller-supplied string goes through it, so a value such as "Finance /
+// HR" or "C++ Tools" stays a single path segment instead of breaking the request.
+func pathJoin(base string, segments ...string) string {
+	var b strings.Builder
+	b.Grow(len(base) + 16*len(segments))
+	b.WriteString(base)
+	for _, segment := range segments {
+		b.WriteByte('/')
+		b.WriteString(escapePathSegment(segment))
+	}
+	return b.String()
+}
+
+// escapePathSegment escapes s for use as one segment of a path. It escapes what url.PathEscape does, and '+' too, as
+// the Classic API reads a plus sign in a path as a space.
+func escapePathSegment(s string) string {
+	return strings.ReplaceAll(url.PathEscape(s), "+", "%2B")
+}
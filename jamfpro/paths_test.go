@@ -0,0 +1,70 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+var specialNames = []struct {
+	name    string
+	escaped string
+}{
+	{"Finance", "Finance"},
+	{"Finance / HR", "Finance%20%2F%20HR"},
+	{"What?", "What%3F"},
+	{"Team #2", "Team%20%232"},
+	{"100% Macs", "100%25%20Macs"},
+	{"C++ Tools", "C%2B%2B%20Tools"},
+	{"a/b?c#d%e f+g", "a%2Fb%3Fc%23d%25e%20f%2Bg"},
+}
+
+func TestPathJoin(t *testing.T) {
+	for _, tt := range specialNames {
+		if got, want := pathJoin(directoryBindingsBasePath, "name", tt.name), directoryBindingsBasePath+"/name/"+tt.escaped; got != want {
+			t.Errorf("pathJoin(%q) = %q, want %q", tt.name, got, want)
+		}
+	}
+
+	if got, want := pathJoin("uapi/v1/jamf-connect", "config-profiles", "a/b", "deployment-tasks"), "uapi/v1/jamf-connect/config-profiles/a%2Fb/deployment-tasks"; got != want {
+		t.Errorf("pathJoin with several segments = %q, want %q", got, want)
+	}
+	if got := pathJoin(directoryBindingsBasePath); got != directoryBindingsBasePath {
+		t.Errorf("pathJoin without segments = %q, want the base", got)
+	}
+}
+
+func TestGetByNameEscapesName(t *testing.T) {
+	for _, tt := range specialNames {
+		t.Run(tt.name, func(t *testing.T) {
+			client, _ := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				prefix := "/" + directoryBindingsBasePath + "/name/"
+				if got, want := r.URL.EscapedPath(), prefix+tt.escaped; got != want {
+					t.Errorf("escaped path = %q, want %q", got, want)
+				}
+				if strings.ContainsAny(tt.name, "/+") && r.URL.RawPath == "" {
+					t.Errorf("RawPath is empty for %q, so the name was not sent escaped", tt.name)
+				}
+				if got := strings.TrimPrefix(r.URL.Path, prefix); got != tt.name {
+					t.Errorf("server read name %q, want %q", got, tt.name)
+				}
+
+				w.Header().Set("Content-Type", "application/xml")
+				fmt.Fprint(w, "<directory_binding><id>4</id><name>")
+				xml.EscapeText(w, []byte(tt.name))
+				fmt.Fprint(w, "</name></directory_binding>")
+			}))
+
+			binding, _, err := client.DirectoryBindings.GetByName(context.Background(), tt.name)
+			if err != nil {
+				t.Fatalf("GetByName: %v", err)
+			}
+			if binding.Name != tt.name {
+				t.Errorf("GetByName returned %q, want %q", binding.Name, tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package jamfpro
+
+// String returns a pointer to v. It makes it easier to fill in optional fields of request structs, where a nil
+// pointer means "leave unchanged".
+func String(v string) *string { return &v }
+
+// Bool returns a pointer to v.
+func Bool(v bool) *bool { return &v }
+
+// Int returns a pointer to v.
+func Int(v int) *int { return &v }
+
+// Int64 returns a pointer to v.
+func Int64(v int64) *int64 { return &v }
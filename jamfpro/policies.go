@@ -0,0 +1,304 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const policiesBasePath = "JSSResource/policies"
+
+// PolicyFrequency is how often a computer runs a policy.
+type PolicyFrequency string
+
+const (
+	PolicyFrequencyOncePerComputer        PolicyFrequency = "Once per computer"
+	PolicyFrequencyOncePerUserPerComputer PolicyFrequency = "Once per user per computer"
+	PolicyFrequencyOncePerUser            PolicyFrequency = "Once per user"
+	PolicyFrequencyOnceEveryDay           PolicyFrequency = "Once every day"
+	PolicyFrequencyOnceEveryWeek          PolicyFrequency = "Once every week"
+	PolicyFrequencyOnceEveryMonth         PolicyFrequency = "Once every month"
+	PolicyFrequencyOngoing                PolicyFrequency = "Ongoing"
+)
+
+var policyFrequencies = []PolicyFrequency{
+	PolicyFrequencyOncePerComputer, PolicyFrequencyOncePerUserPerComputer, PolicyFrequencyOncePerUser,
+	PolicyFrequencyOnceEveryDay, PolicyFrequencyOnceEveryWeek, PolicyFrequencyOnceEveryMonth, PolicyFrequencyOngoing,
+}
+
+// Valid reports whether Jamf Pro accepts the frequency.
+func (f PolicyFrequency) Valid() bool {
+	return isKnown(f, policyFrequencies)
+}
+
+// UnmarshalText reads a frequency, ignoring case.
+func (f *PolicyFrequency) UnmarshalText(text []byte) error {
+	*f = unmarshalEnum(text, policyFrequencies)
+	return nil
+}
+
+// Events a failed policy is retried on
+const (
+	PolicyRetryEventNone    = "none"
+	PolicyRetryEventTrigger = "trigger"
+	PolicyRetryEventCheckIn = "check-in"
+)
+
+// Actions a policy can take on a package
+const (
+	PolicyPackageInstall       = "Install"
+	PolicyPackageCache         = "Cache"
+	PolicyPackageInstallCached = "Install Cached"
+	PolicyPackageUninstall     = "Uninstall"
+)
+
+// Priorities of the scripts of a policy, relative to its other actions
+const (
+	PolicyScriptBefore = "Before"
+	PolicyScriptAfter  = "After"
+)
+
+type PoliciesService interface {
+	List(context.Context) ([]PolicyListItem, *Response, error)
+	GetByID(context.Context, int) (*Policy, *Response, error)
+	GetManyByID(context.Context, []int, int) (map[int]*Policy, error)
+	GetByName(context.Context, string) (*Policy, *Response, error)
+	Create(context.Context, *PolicyRequest) (*Policy, *Response, error)
+	Update(context.Context, int, *PolicyRequest) (*Policy, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+}
+
+// PoliciesServiceOp handles communication with the policy-related
+// methods of the Jamf Pro API.
+type PoliciesServiceOp struct {
+	client *Client
+}
+
+var _ PoliciesService = &PoliciesServiceOp{}
+
+// Policy represents a Jamf Pro policy
+type Policy struct {
+	XMLName              xml.Name                   `xml:"policy"`
+	General              PolicyGeneral              `xml:"general"`
+	Scope                Scope                      `xml:"scope"`
+	SelfService          PolicySelfService          `xml:"self_service"`
+	PackageConfiguration PolicyPackageConfiguration `xml:"package_configuration"`
+	Scripts              []PolicyScript             `xml:"scripts>script"`
+	Maintenance          PolicyMaintenance          `xml:"maintenance"`
+}
+
+type PolicyGeneral struct {
+	Id                         ID               `xml:"id,omitempty"`
+	Name                       string           `xml:"name"`
+	Enabled                    bool             `xml:"enabled"`
+	TriggerCheckin             bool             `xml:"trigger_checkin"`
+	TriggerEnrollmentComplete  bool             `xml:"trigger_enrollment_complete"`
+	TriggerLogin               bool             `xml:"trigger_login"`
+	TriggerStartup             bool             `xml:"trigger_startup"`
+	TriggerNetworkStateChanged bool             `xml:"trigger_network_state_changed"`
+	TriggerOther               string           `xml:"trigger_other,omitempty"`
+	Frequency                  PolicyFrequency  `xml:"frequency,omitempty"`
+	RetryEvent                 string           `xml:"retry_event,omitempty"`
+	RetryAttempts              int              `xml:"retry_attempts,omitempty"`
+	NotifyOnEachFailedRetry    bool             `xml:"notify_on_each_failed_retry"`
+	Offline                    bool             `xml:"offline"`
+	Category                   ClassicReference `xml:"category"`
+	Site                       ClassicReference `xml:"site"`
+}
+
+type PolicySelfService struct {
+	UseForSelfService           bool   `xml:"use_for_self_service"`
+	SelfServiceDisplayName      string `xml:"self_service_display_name,omitempty"`
+	InstallButtonText           string `xml:"install_button_text,omitempty"`
+	ReinstallButtonText         string `xml:"reinstall_button_text,omitempty"`
+	SelfServiceDescription      string `xml:"self_service_description,omitempty"`
+	ForceUsersToViewDescription bool   `xml:"force_users_to_view_description"`
+	FeatureOnMainPage           bool   `xml:"feature_on_main_page"`
+}
+
+type PolicyPackageConfiguration struct {
+	Packages []PolicyPackage `xml:"packages>package"`
+}
+
+// PolicyPackage is a package a policy installs, caches or uninstalls
+type PolicyPackage struct {
+	Id     ID     `xml:"id"`
+	Name   string `xml:"name,omitempty"`
+	Action string `xml:"action"`
+	// FillUserTemplate and FillExistingUsers copy the package's user home directory contents into the user template
+	// and the existing user home directories.
+	FillUserTemplate  bool `xml:"fut"`
+	FillExistingUsers bool `xml:"feu"`
+}
+
+// PolicyScript is a script a policy runs, with the values of its parameters 4 to 11
+type PolicyScript struct {
+	Id          ID     `xml:"id"`
+	Name        string `xml:"name,omitempty"`
+	Priority    string `xml:"priority"`
+	Parameter4  string `xml:"parameter4,omitempty"`
+	Parameter5  string `xml:"parameter5,omitempty"`
+	Parameter6  string `xml:"parameter6,omitempty"`
+	Parameter7  string `xml:"parameter7,omitempty"`
+	Parameter8  string `xml:"parameter8,omitempty"`
+	Parameter9  string `xml:"parameter9,omitempty"`
+	Parameter10 string `xml:"parameter10,omitempty"`
+	Parameter11 string `xml:"parameter11,omitempty"`
+}
+
+type PolicyMaintenance struct {
+	Recon                    bool `xml:"recon"`
+	ResetName                bool `xml:"reset_name"`
+	InstallAllCachedPackages bool `xml:"install_all_cached_packages"`
+	Permissions              bool `xml:"permissions"`
+	Byhost                   bool `xml:"byhost"`
+	SystemCache              bool `xml:"system_cache"`
+	UserCache                bool `xml:"user_cache"`
+	Verify                   bool `xml:"verify"`
+}
+
+// PolicyListItem is the summary of a policy returned when listing all policies
+type PolicyListItem struct {
+	Id   ID     `xml:"id"`
+	Name string `xml:"name"`
+}
+
+// PolicyListResponse represents the raw API response to getting all policies
+type PolicyListResponse struct {
+	XMLName  xml.Name         `xml:"policies"`
+	Size     int              `xml:"size"`
+	Policies []PolicyListItem `xml:"policy"`
+}
+
+// PolicyRequest represents a request to create or update a policy. NewPolicy builds one and checks that its
+// triggers, frequency and retries fit together.
+type PolicyRequest struct {
+	XMLName              xml.Name                    `xml:"policy"`
+	General              PolicyGeneral               `xml:"general"`
+	Scope                *Scope                      `xml:"scope,omitempty"`
+	SelfService          *PolicySelfService          `xml:"self_service,omitempty"`
+	PackageConfiguration *PolicyPackageConfiguration `xml:"package_configuration,omitempty"`
+	Scripts              []PolicyScript              `xml:"scripts>script,omitempty"`
+	Maintenance          *PolicyMaintenance          `xml:"maintenance,omitempty"`
+}
+
+type PolicyResponse struct {
+	Id ID `xml:"id"`
+}
+
+func (p *PoliciesServiceOp) List(ctx context.Context) ([]PolicyListItem, *Response, error) {
+	req, err := p.client.NewRequest(ctx, http.MethodGet, policiesBasePath, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var policyResponse PolicyListResponse
+	resp, err := p.client.Do(ctx, req, &policyResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return policyResponse.Policies, resp, err
+}
+
+func (p *PoliciesServiceOp) GetByID(ctx context.Context, id int) (*Policy, *Response, error) {
+	path := policiesBasePath + "/id/" + strconv.Itoa(id)
+	return p.get(ctx, path)
+}
+
+// GetManyByID fetches the policies with the given IDs, with at most concurrency requests in flight, as the Classic API
+// cannot fetch several at once. The records fetched are returned keyed by ID even if others failed, which are
+// reported in a *BatchGetError.
+func (p *PoliciesServiceOp) GetManyByID(ctx context.Context, ids []int, concurrency int) (map[int]*Policy, error) {
+	return getManyByID(ctx, ids, concurrency, p.GetByID)
+}
+
+func (p *PoliciesServiceOp) GetByName(ctx context.Context, name string) (*Policy, *Response, error) {
+	path := pathJoin(policiesBasePath, "name", name)
+	return p.get(ctx, path)
+}
+
+// Create creates a policy in Jamf Pro and returns the record as stored by the server.
+func (p *PoliciesServiceOp) Create(ctx context.Context, request *PolicyRequest) (*Policy, *Response, error) {
+	path := policiesBasePath + "/id/0"
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	} else if frequency := request.General.Frequency; frequency != "" && !frequency.Valid() {
+		return nil, nil, NewArgError("Frequency", fmt.Sprintf("%q is not a known policy frequency", frequency))
+	}
+
+	req, err := p.client.NewRequest(ctx, http.MethodPost, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policyCreation := new(PolicyResponse)
+	resp, err := p.client.Do(ctx, req, policyCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, policyCreation.Id, p.GetByID)
+}
+
+// Update updates a policy in Jamf Pro and returns the record as stored by the server.
+func (p *PoliciesServiceOp) Update(ctx context.Context, id int, request *PolicyRequest) (*Policy, *Response, error) {
+	path := policiesBasePath + "/id/" + strconv.Itoa(id)
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if id == 0 {
+		return nil, nil, NewArgError("policy ID", "cannot be 0")
+	}
+
+	req, err := p.client.NewRequest(ctx, http.MethodPut, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policyUpdate := new(PolicyResponse)
+	resp, err := p.client.Do(ctx, req, policyUpdate)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p.GetByID(ctx, id)
+}
+
+func (p *PoliciesServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := policiesBasePath + "/id/" + strconv.Itoa(id)
+
+	req, err := p.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DeleteByName deletes the policy called name. The error matches ErrNotFound if there is none.
+func (p *PoliciesServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "policy", name, p.GetByName, func(policy *Policy) ID { return policy.General.Id }, p.Delete)
+}
+
+func (p *PoliciesServiceOp) get(ctx context.Context, path string) (*Policy, *Response, error) {
+	req, err := p.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var policy Policy
+	resp, err := p.client.Do(ctx, req, &policy)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &policy, resp, err
+}
@@ -0,0 +1,244 @@
+package jamfpro
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Policy triggers, the events on which computers run a policy
+const (
+	PolicyTriggerCheckin             = "CHECKIN"
+	PolicyTriggerEnrollmentComplete  = "ENROLLMENT_COMPLETE"
+	PolicyTriggerLogin               = "LOGIN"
+	PolicyTriggerStartup             = "STARTUP"
+	PolicyTriggerNetworkStateChanged = "NETWORK_STATE_CHANGED"
+)
+
+// maxPolicyRetryAttempts is the most retries Jamf Pro allows for a failed policy
+const maxPolicyRetryAttempts = 10
+
+// PolicyBuilder builds a PolicyRequest step by step. Its methods return the builder so calls can be chained, and
+// mistakes are reported together by Build:
+//
+//	policy, err := jamfpro.NewPolicy("Install Firefox").
+//		Category("Browsers").
+//		Scope(jamfpro.Scope{AllComputers: true}).
+//		AddPackage(12, jamfpro.PolicyPackageInstall).
+//		AddScript(4, "--quiet").
+//		SelfService(jamfpro.PolicySelfService{SelfServiceDisplayName: "Firefox"}).
+//		Build()
+type PolicyBuilder struct {
+	request PolicyRequest
+	errs    []error
+}
+
+// NewPolicy starts building an enabled policy called name. It runs once per computer unless Frequency says otherwise.
+func NewPolicy(name string) *PolicyBuilder {
+	b := &PolicyBuilder{}
+	b.request.General.Name = name
+	b.request.General.Enabled = true
+	b.request.General.Frequency = PolicyFrequencyOncePerComputer
+	return b
+}
+
+// Disabled creates the policy disabled, so it does not run until it is enabled in Jamf Pro.
+func (b *PolicyBuilder) Disabled() *PolicyBuilder {
+	b.request.General.Enabled = false
+	return b
+}
+
+// Category files the policy under the category called name.
+func (b *PolicyBuilder) Category(name string) *PolicyBuilder {
+	b.request.General.Category = ClassicReference{Name: name}
+	return b
+}
+
+// Site assigns the policy to the site called name.
+func (b *PolicyBuilder) Site(name string) *PolicyBuilder {
+	b.request.General.Site = ClassicReference{Name: name}
+	return b
+}
+
+// Scope sets the computers and users the policy applies to.
+func (b *PolicyBuilder) Scope(scope Scope) *PolicyBuilder {
+	b.request.Scope = &scope
+	return b
+}
+
+// Trigger adds PolicyTrigger events the policy runs on.
+func (b *PolicyBuilder) Trigger(triggers ...string) *PolicyBuilder {
+	general := &b.request.General
+	for _, trigger := range triggers {
+		switch trigger {
+		case PolicyTriggerCheckin:
+			general.TriggerCheckin = true
+		case PolicyTriggerEnrollmentComplete:
+			general.TriggerEnrollmentComplete = true
+		case PolicyTriggerLogin:
+			general.TriggerLogin = true
+		case PolicyTriggerStartup:
+			general.TriggerStartup = true
+		case PolicyTriggerNetworkStateChanged:
+			general.TriggerNetworkStateChanged = true
+		default:
+			b.errs = append(b.errs, fmt.Errorf("%q is not a policy trigger", trigger))
+		}
+	}
+	return b
+}
+
+// CustomTrigger runs the policy when `jamf policy -event name` is run on a computer.
+func (b *PolicyBuilder) CustomTrigger(name string) *PolicyBuilder {
+	if strings.TrimSpace(name) == "" {
+		b.errs = append(b.errs, errors.New("the custom trigger cannot be empty"))
+	}
+	b.request.General.TriggerOther = name
+	return b
+}
+
+// Frequency sets how often a computer runs the policy, one of the PolicyFrequency constants.
+func (b *PolicyBuilder) Frequency(frequency PolicyFrequency) *PolicyBuilder {
+	b.request.General.Frequency = frequency
+	return b
+}
+
+// Retry retries a failed policy up to attempts times, on the next trigger or check-in.
+func (b *PolicyBuilder) Retry(event string, attempts int) *PolicyBuilder {
+	b.request.General.RetryEvent = event
+	b.request.General.RetryAttempts = attempts
+	return b
+}
+
+// AddPackage makes the policy install, cache or uninstall the package with the given ID.
+func (b *PolicyBuilder) AddPackage(id int, action string) *PolicyBuilder {
+	switch action {
+	case PolicyPackageInstall, PolicyPackageCache, PolicyPackageInstallCached, PolicyPackageUninstall:
+	default:
+		b.errs = append(b.errs, fmt.Errorf("package %d: %q is not a package action", id, action))
+	}
+
+	if b.request.PackageConfiguration == nil {
+		b.request.PackageConfiguration = &PolicyPackageConfiguration{}
+	}
+	packages := &b.request.PackageConfiguration.Packages
+	*packages = append(*packages, PolicyPackage{Id: FromInt(id), Action: action})
+	return b
+}
+
+// AddScript makes the policy run the script with the given ID after its other actions, passing params as parameters
+// 4 onwards.
+func (b *PolicyBuilder) AddScript(id int, params ...string) *PolicyBuilder {
+	return b.addScript(id, PolicyScriptAfter, params)
+}
+
+// AddScriptBefore is like AddScript, but runs the script before the other actions of the policy.
+func (b *PolicyBuilder) AddScriptBefore(id int, params ...string) *PolicyBuilder {
+	return b.addScript(id, PolicyScriptBefore, params)
+}
+
+func (b *PolicyBuilder) addScript(id int, priority string, params []string) *PolicyBuilder {
+	if len(params) > 8 {
+		b.errs = append(b.errs, fmt.Errorf("script %d: %d parameters given, but policies only pass parameters 4 to 11", id, len(params)))
+	}
+
+	script := PolicyScript{Id: FromInt(id), Priority: priority}
+	fields := []*string{
+		&script.Parameter4, &script.Parameter5, &script.Parameter6, &script.Parameter7,
+		&script.Parameter8, &script.Parameter9, &script.Parameter10, &script.Parameter11,
+	}
+	for i := 0; i < len(params) && i < len(fields); i++ {
+		*fields[i] = params[i]
+	}
+	b.request.Scripts = append(b.request.Scripts, script)
+	return b
+}
+
+// SelfService makes the policy available in Self Service, presented as described by selfService.
+func (b *PolicyBuilder) SelfService(selfService PolicySelfService) *PolicyBuilder {
+	selfService.UseForSelfService = true
+	b.request.SelfService = &selfService
+	return b
+}
+
+// UpdateInventory makes computers submit an inventory update once they have run the policy.
+func (b *PolicyBuilder) UpdateInventory() *PolicyBuilder {
+	if b.request.Maintenance == nil {
+		b.request.Maintenance = &PolicyMaintenance{}
+	}
+	b.request.Maintenance.Recon = true
+	return b
+}
+
+// Build checks the policy and returns the request to pass to Policies.Create or Policies.Update. All the mistakes
+// made while building are returned together.
+func (b *PolicyBuilder) Build() (*PolicyRequest, error) {
+	errs := append([]error(nil), b.errs...)
+	general := b.request.General
+	selfService := b.request.SelfService != nil
+
+	if strings.TrimSpace(general.Name) == "" {
+		errs = append(errs, errors.New("the policy has no name"))
+	}
+
+	triggered := general.TriggerCheckin || general.TriggerEnrollmentComplete || general.TriggerLogin ||
+		general.TriggerStartup || general.TriggerNetworkStateChanged || general.TriggerOther != ""
+	if !triggered && !selfService {
+		errs = append(errs, errors.New("the policy has no trigger and is not in Self Service, so it never runs"))
+	}
+
+	switch general.Frequency {
+	case PolicyFrequencyOncePerComputer, PolicyFrequencyOncePerUserPerComputer, PolicyFrequencyOnceEveryDay,
+		PolicyFrequencyOnceEveryWeek, PolicyFrequencyOnceEveryMonth, PolicyFrequencyOngoing:
+	case PolicyFrequencyOncePerUser:
+		// Without a user logging in, there is no user to count runs against.
+		if !general.TriggerLogin && !selfService {
+			errs = append(errs, errors.New("a policy run once per user needs the login trigger or Self Service"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("%q is not a policy frequency", general.Frequency))
+	}
+
+	// Enrollment completes once, so a policy triggered by nothing else cannot recur.
+	onlyEnrollment := general.TriggerEnrollmentComplete && !general.TriggerCheckin && !general.TriggerLogin &&
+		!general.TriggerStartup && !general.TriggerNetworkStateChanged && general.TriggerOther == "" && !selfService
+	switch general.Frequency {
+	case PolicyFrequencyOnceEveryDay, PolicyFrequencyOnceEveryWeek, PolicyFrequencyOnceEveryMonth:
+		if onlyEnrollment {
+			errs = append(errs, fmt.Errorf("a policy only triggered by enrollment runs once, so it cannot run %s", strings.ToLower(string(general.Frequency))))
+		}
+	}
+
+	switch general.RetryEvent {
+	case "", PolicyRetryEventNone:
+		if general.RetryAttempts != 0 {
+			errs = append(errs, errors.New("retry attempts are set but there is no retry event"))
+		}
+	case PolicyRetryEventTrigger, PolicyRetryEventCheckIn:
+		if general.Frequency != PolicyFrequencyOncePerComputer && general.Frequency != PolicyFrequencyOncePerUserPerComputer {
+			errs = append(errs, fmt.Errorf("failed policies can only be retried when run once per computer or once per user per computer, not %s", strings.ToLower(string(general.Frequency))))
+		}
+		if general.RetryAttempts < 1 || general.RetryAttempts > maxPolicyRetryAttempts {
+			errs = append(errs, fmt.Errorf("retry attempts must be between 1 and %d, not %d", maxPolicyRetryAttempts, general.RetryAttempts))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("%q is not a retry event", general.RetryEvent))
+	}
+
+	if b.request.PackageConfiguration != nil {
+		actions := make(map[ID]string)
+		for _, p := range b.request.PackageConfiguration.Packages {
+			if previous, ok := actions[p.Id]; ok {
+				errs = append(errs, fmt.Errorf("package %s is added twice, with the actions %q and %q", p.Id, previous, p.Action))
+			}
+			actions[p.Id] = p.Action
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("jamfpro: invalid policy %q: %w", general.Name, errors.Join(errs...))
+	}
+
+	request := b.request
+	return &request, nil
+}
@@ -0,0 +1,223 @@
+// Package pool holds clients for many Jamf Pro instances, such as the tenants of a managed service provider or the
+// contexts of a school district, and runs the same operation across all of them.
+//
+//	p, err := pool.New([]pool.Instance{
+//		{Name: "north", URL: "https://north.jamfcloud.com", ClientId: northId, ClientSecret: northSecret},
+//		{Name: "south", URL: "https://south.jamfcloud.com", ClientId: southId, ClientSecret: southSecret},
+//	})
+//	results := pool.Run(ctx, p, pool.Options{}, func(ctx context.Context, name string, c *jamfpro.Client) ([]jamfpro.Building, error) {
+//		buildings, _, err := c.Buildings.List(ctx)
+//		return buildings, err
+//	})
+//	if err := results.Err(); err != nil {
+//		log.Print(err)
+//	}
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jc0b/go-jamfpro-api/jamfpro"
+)
+
+const defaultConcurrency = 4
+
+// Instance describes a Jamf Pro instance and the API client credentials used for it. Options are applied after the
+// options shared by the whole pool, so they can override them.
+type Instance struct {
+	Name         string
+	URL          string
+	ClientId     string
+	ClientSecret string
+	Options      []jamfpro.ClientOption
+}
+
+// Pool holds a client per instance, keyed by instance name. It is safe for concurrent use.
+type Pool struct {
+	mu      sync.RWMutex
+	clients map[string]*jamfpro.Client
+}
+
+// New creates a client for each instance, applying opts to every one of them.
+func New(instances []Instance, opts ...jamfpro.ClientOption) (*Pool, error) {
+	p := &Pool{clients: make(map[string]*jamfpro.Client, len(instances))}
+	for _, instance := range instances {
+		if instance.Name == "" {
+			return nil, jamfpro.NewArgError("instances", "every instance needs a Name")
+		}
+		clientOpts := append(append([]jamfpro.ClientOption(nil), opts...), instance.Options...)
+		client, err := jamfpro.NewClient(instance.ClientId, instance.ClientSecret, instance.URL, "", clientOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", instance.Name, err)
+		}
+		if err := p.Add(instance.Name, client); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Add adds a client that was created elsewhere under name.
+func (p *Pool) Add(name string, client *jamfpro.Client) error {
+	if name == "" {
+		return jamfpro.NewArgError("name", "cannot be empty")
+	} else if client == nil {
+		return jamfpro.NewArgError("client", "cannot be nil")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.clients == nil {
+		p.clients = make(map[string]*jamfpro.Client)
+	}
+	if _, ok := p.clients[name]; ok {
+		return jamfpro.NewArgError("name", fmt.Sprintf("%q is already in the pool", name))
+	}
+	p.clients[name] = client
+	return nil
+}
+
+// Remove removes the client of an instance from the pool.
+func (p *Pool) Remove(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.clients, name)
+}
+
+// Client returns the client of an instance.
+func (p *Pool) Client(name string) (*jamfpro.Client, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	client, ok := p.clients[name]
+	return client, ok
+}
+
+// Names returns the names of the instances in the pool, sorted.
+func (p *Pool) Names() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	names := make([]string, 0, len(p.clients))
+	for name := range p.clients {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Options configures a Run. The zero value runs the operation on four instances at a time, without a timeout.
+type Options struct {
+	// Concurrency is the number of instances the operation runs on at the same time.
+	Concurrency int
+
+	// Timeout bounds the operation on each instance, so a single unreachable instance cannot hold up the rest.
+	Timeout time.Duration
+
+	// Instances restricts the run to the named instances. All instances are used if it is empty.
+	Instances []string
+}
+
+// Operation is run once per instance with the client of that instance.
+type Operation[T any] func(ctx context.Context, name string, client *jamfpro.Client) (T, error)
+
+// Result is the outcome of an operation on one instance.
+type Result[T any] struct {
+	Instance string
+	Value    T
+	Err      error
+	Duration time.Duration
+}
+
+// Results holds the outcome of a Run on every instance, sorted by instance name.
+type Results[T any] []Result[T]
+
+// Values returns the values of the instances the operation succeeded on, keyed by instance name.
+func (r Results[T]) Values() map[string]T {
+	values := make(map[string]T, len(r))
+	for _, result := range r {
+		if result.Err == nil {
+			values[result.Instance] = result.Value
+		}
+	}
+	return values
+}
+
+// Failures returns the results of the instances the operation failed on.
+func (r Results[T]) Failures() Results[T] {
+	var failures Results[T]
+	for _, result := range r {
+		if result.Err != nil {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// Err joins the errors of every failed instance, each prefixed with the instance name, or returns nil if the
+// operation succeeded everywhere. errors.Is and errors.As see through to the individual errors.
+func (r Results[T]) Err() error {
+	var errs []error
+	for _, result := range r.Failures() {
+		errs = append(errs, fmt.Errorf("%s: %w", result.Instance, result.Err))
+	}
+	return errors.Join(errs...)
+}
+
+// Run applies op to every instance of the pool and waits for all of them to finish. Instances that have not started
+// when ctx is cancelled are reported with the context's error.
+func Run[T any](ctx context.Context, p *Pool, opts Options, op Operation[T]) Results[T] {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultConcurrency
+	}
+	names := opts.Instances
+	if len(names) == 0 {
+		names = p.Names()
+	} else {
+		names = append([]string(nil), names...)
+		sort.Strings(names)
+	}
+
+	results := make(Results[T], len(names))
+	semaphore := make(chan struct{}, opts.Concurrency)
+	var wg sync.WaitGroup
+	for i, name := range names {
+		results[i].Instance = name
+
+		client, ok := p.Client(name)
+		if !ok {
+			results[i].Err = jamfpro.NewArgError("Instances", fmt.Sprintf("%q is not in the pool", name))
+			continue
+		}
+
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *Result[T], name string, client *jamfpro.Client) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			ctx := ctx
+			if opts.Timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+				defer cancel()
+			}
+
+			start := time.Now()
+			result.Value, result.Err = op(ctx, name, client)
+			result.Duration = time.Since(start)
+		}(&results[i], name, client)
+	}
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,889 @@
+// Code generated by internal/privileges from internal/privileges/privileges.json. DO NOT EDIT.
+
+package jamfpro
+
+// Privileges for Activation Code
+const (
+	PrivilegeReadActivationCode   = "Read Activation Code"
+	PrivilegeUpdateActivationCode = "Update Activation Code"
+)
+
+// Privileges for Advanced Computer Searches
+const (
+	PrivilegeCreateAdvancedComputerSearches = "Create Advanced Computer Searches"
+	PrivilegeReadAdvancedComputerSearches   = "Read Advanced Computer Searches"
+	PrivilegeUpdateAdvancedComputerSearches = "Update Advanced Computer Searches"
+	PrivilegeDeleteAdvancedComputerSearches = "Delete Advanced Computer Searches"
+)
+
+// Privileges for Advanced Mobile Device Searches
+const (
+	PrivilegeCreateAdvancedMobileDeviceSearches = "Create Advanced Mobile Device Searches"
+	PrivilegeReadAdvancedMobileDeviceSearches   = "Read Advanced Mobile Device Searches"
+	PrivilegeUpdateAdvancedMobileDeviceSearches = "Update Advanced Mobile Device Searches"
+	PrivilegeDeleteAdvancedMobileDeviceSearches = "Delete Advanced Mobile Device Searches"
+)
+
+// Privileges for Advanced User Searches
+const (
+	PrivilegeCreateAdvancedUserSearches = "Create Advanced User Searches"
+	PrivilegeReadAdvancedUserSearches   = "Read Advanced User Searches"
+	PrivilegeUpdateAdvancedUserSearches = "Update Advanced User Searches"
+	PrivilegeDeleteAdvancedUserSearches = "Delete Advanced User Searches"
+)
+
+// Privileges for Allowed File Extension
+const (
+	PrivilegeCreateAllowedFileExtension = "Create Allowed File Extension"
+	PrivilegeReadAllowedFileExtension   = "Read Allowed File Extension"
+	PrivilegeUpdateAllowedFileExtension = "Update Allowed File Extension"
+	PrivilegeDeleteAllowedFileExtension = "Delete Allowed File Extension"
+)
+
+// Privileges for API Integrations
+const (
+	PrivilegeCreateAPIIntegrations = "Create API Integrations"
+	PrivilegeReadAPIIntegrations   = "Read API Integrations"
+	PrivilegeUpdateAPIIntegrations = "Update API Integrations"
+	PrivilegeDeleteAPIIntegrations = "Delete API Integrations"
+)
+
+// Privileges for API Roles
+const (
+	PrivilegeCreateAPIRoles = "Create API Roles"
+	PrivilegeReadAPIRoles   = "Read API Roles"
+	PrivilegeUpdateAPIRoles = "Update API Roles"
+	PrivilegeDeleteAPIRoles = "Delete API Roles"
+)
+
+// Privileges for Buildings
+const (
+	PrivilegeCreateBuildings = "Create Buildings"
+	PrivilegeReadBuildings   = "Read Buildings"
+	PrivilegeUpdateBuildings = "Update Buildings"
+	PrivilegeDeleteBuildings = "Delete Buildings"
+)
+
+// Privileges for Cache
+const (
+	PrivilegeReadCache   = "Read Cache"
+	PrivilegeUpdateCache = "Update Cache"
+)
+
+// Privileges for Categories
+const (
+	PrivilegeCreateCategories = "Create Categories"
+	PrivilegeReadCategories   = "Read Categories"
+	PrivilegeUpdateCategories = "Update Categories"
+	PrivilegeDeleteCategories = "Delete Categories"
+)
+
+// Privileges for Change Management
+const (
+	PrivilegeReadChangeManagement   = "Read Change Management"
+	PrivilegeUpdateChangeManagement = "Update Change Management"
+)
+
+// Privileges for Classes
+const (
+	PrivilegeCreateClasses = "Create Classes"
+	PrivilegeReadClasses   = "Read Classes"
+	PrivilegeUpdateClasses = "Update Classes"
+	PrivilegeDeleteClasses = "Delete Classes"
+)
+
+// Privileges for Cloud Services Settings
+const (
+	PrivilegeReadCloudServicesSettings   = "Read Cloud Services Settings"
+	PrivilegeUpdateCloudServicesSettings = "Update Cloud Services Settings"
+)
+
+// Privileges for Clustering
+const (
+	PrivilegeReadClustering   = "Read Clustering"
+	PrivilegeUpdateClustering = "Update Clustering"
+)
+
+// Privileges for Computer Check-In
+const (
+	PrivilegeReadComputerCheckIn   = "Read Computer Check-In"
+	PrivilegeUpdateComputerCheckIn = "Update Computer Check-In"
+)
+
+// Privileges for Computer Enrollment Invitations
+const (
+	PrivilegeCreateComputerEnrollmentInvitations = "Create Computer Enrollment Invitations"
+	PrivilegeReadComputerEnrollmentInvitations   = "Read Computer Enrollment Invitations"
+	PrivilegeUpdateComputerEnrollmentInvitations = "Update Computer Enrollment Invitations"
+	PrivilegeDeleteComputerEnrollmentInvitations = "Delete Computer Enrollment Invitations"
+)
+
+// Privileges for Computer Extension Attributes
+const (
+	PrivilegeCreateComputerExtensionAttributes = "Create Computer Extension Attributes"
+	PrivilegeReadComputerExtensionAttributes   = "Read Computer Extension Attributes"
+	PrivilegeUpdateComputerExtensionAttributes = "Update Computer Extension Attributes"
+	PrivilegeDeleteComputerExtensionAttributes = "Delete Computer Extension Attributes"
+)
+
+// Privileges for Computer Inventory Collection Settings
+const (
+	PrivilegeReadComputerInventoryCollectionSettings   = "Read Computer Inventory Collection Settings"
+	PrivilegeUpdateComputerInventoryCollectionSettings = "Update Computer Inventory Collection Settings"
+)
+
+// Privileges for Computer PreStage Enrollments
+const (
+	PrivilegeCreateComputerPreStageEnrollments = "Create Computer PreStage Enrollments"
+	PrivilegeReadComputerPreStageEnrollments   = "Read Computer PreStage Enrollments"
+	PrivilegeUpdateComputerPreStageEnrollments = "Update Computer PreStage Enrollments"
+	PrivilegeDeleteComputerPreStageEnrollments = "Delete Computer PreStage Enrollments"
+)
+
+// Privileges for Computers
+const (
+	PrivilegeCreateComputers = "Create Computers"
+	PrivilegeReadComputers   = "Read Computers"
+	PrivilegeUpdateComputers = "Update Computers"
+	PrivilegeDeleteComputers = "Delete Computers"
+)
+
+// Privileges for Departments
+const (
+	PrivilegeCreateDepartments = "Create Departments"
+	PrivilegeReadDepartments   = "Read Departments"
+	PrivilegeUpdateDepartments = "Update Departments"
+	PrivilegeDeleteDepartments = "Delete Departments"
+)
+
+// Privileges for Device Enrollment Program Instances
+const (
+	PrivilegeCreateDeviceEnrollmentProgramInstances = "Create Device Enrollment Program Instances"
+	PrivilegeReadDeviceEnrollmentProgramInstances   = "Read Device Enrollment Program Instances"
+	PrivilegeUpdateDeviceEnrollmentProgramInstances = "Update Device Enrollment Program Instances"
+	PrivilegeDeleteDeviceEnrollmentProgramInstances = "Delete Device Enrollment Program Instances"
+)
+
+// Privileges for Directory Bindings
+const (
+	PrivilegeCreateDirectoryBindings = "Create Directory Bindings"
+	PrivilegeReadDirectoryBindings   = "Read Directory Bindings"
+	PrivilegeUpdateDirectoryBindings = "Update Directory Bindings"
+	PrivilegeDeleteDirectoryBindings = "Delete Directory Bindings"
+)
+
+// Privileges for Disk Encryption Configurations
+const (
+	PrivilegeCreateDiskEncryptionConfigurations = "Create Disk Encryption Configurations"
+	PrivilegeReadDiskEncryptionConfigurations   = "Read Disk Encryption Configurations"
+	PrivilegeUpdateDiskEncryptionConfigurations = "Update Disk Encryption Configurations"
+	PrivilegeDeleteDiskEncryptionConfigurations = "Delete Disk Encryption Configurations"
+)
+
+// Privileges for Dock Items
+const (
+	PrivilegeCreateDockItems = "Create Dock Items"
+	PrivilegeReadDockItems   = "Read Dock Items"
+	PrivilegeUpdateDockItems = "Update Dock Items"
+	PrivilegeDeleteDockItems = "Delete Dock Items"
+)
+
+// Privileges for eBooks
+const (
+	PrivilegeCreateEBooks = "Create eBooks"
+	PrivilegeReadEBooks   = "Read eBooks"
+	PrivilegeUpdateEBooks = "Update eBooks"
+	PrivilegeDeleteEBooks = "Delete eBooks"
+)
+
+// Privileges for Engage Settings
+const (
+	PrivilegeReadEngageSettings   = "Read Engage Settings"
+	PrivilegeUpdateEngageSettings = "Update Engage Settings"
+)
+
+// Privileges for Enrollment Customizations
+const (
+	PrivilegeCreateEnrollmentCustomizations = "Create Enrollment Customizations"
+	PrivilegeReadEnrollmentCustomizations   = "Read Enrollment Customizations"
+	PrivilegeUpdateEnrollmentCustomizations = "Update Enrollment Customizations"
+	PrivilegeDeleteEnrollmentCustomizations = "Delete Enrollment Customizations"
+)
+
+// Privileges for GSX Connection
+const (
+	PrivilegeReadGSXConnection   = "Read GSX Connection"
+	PrivilegeUpdateGSXConnection = "Update GSX Connection"
+)
+
+// Privileges for Inventory Preload Records
+const (
+	PrivilegeCreateInventoryPreloadRecords = "Create Inventory Preload Records"
+	PrivilegeReadInventoryPreloadRecords   = "Read Inventory Preload Records"
+	PrivilegeUpdateInventoryPreloadRecords = "Update Inventory Preload Records"
+	PrivilegeDeleteInventoryPreloadRecords = "Delete Inventory Preload Records"
+)
+
+// Privileges for Jamf Connect Deployments
+const (
+	PrivilegeReadJamfConnectDeployments   = "Read Jamf Connect Deployments"
+	PrivilegeUpdateJamfConnectDeployments = "Update Jamf Connect Deployments"
+)
+
+// Privileges for Jamf Pro User Accounts & Groups
+const (
+	PrivilegeCreateJamfProUserAccountsGroups = "Create Jamf Pro User Accounts & Groups"
+	PrivilegeReadJamfProUserAccountsGroups   = "Read Jamf Pro User Accounts & Groups"
+	PrivilegeUpdateJamfProUserAccountsGroups = "Update Jamf Pro User Accounts & Groups"
+	PrivilegeDeleteJamfProUserAccountsGroups = "Delete Jamf Pro User Accounts & Groups"
+)
+
+// Privileges for Jamf Protect Deployments
+const (
+	PrivilegeCreateJamfProtectDeployments = "Create Jamf Protect Deployments"
+	PrivilegeReadJamfProtectDeployments   = "Read Jamf Protect Deployments"
+	PrivilegeUpdateJamfProtectDeployments = "Update Jamf Protect Deployments"
+	PrivilegeDeleteJamfProtectDeployments = "Delete Jamf Protect Deployments"
+)
+
+// Privileges for LDAP Servers
+const (
+	PrivilegeCreateLDAPServers = "Create LDAP Servers"
+	PrivilegeReadLDAPServers   = "Read LDAP Servers"
+	PrivilegeUpdateLDAPServers = "Update LDAP Servers"
+	PrivilegeDeleteLDAPServers = "Delete LDAP Servers"
+)
+
+// Privileges for Licensed Software
+const (
+	PrivilegeCreateLicensedSoftware = "Create Licensed Software"
+	PrivilegeReadLicensedSoftware   = "Read Licensed Software"
+	PrivilegeUpdateLicensedSoftware = "Update Licensed Software"
+	PrivilegeDeleteLicensedSoftware = "Delete Licensed Software"
+)
+
+// Privileges for Mac Applications
+const (
+	PrivilegeCreateMacApplications = "Create Mac Applications"
+	PrivilegeReadMacApplications   = "Read Mac Applications"
+	PrivilegeUpdateMacApplications = "Update Mac Applications"
+	PrivilegeDeleteMacApplications = "Delete Mac Applications"
+)
+
+// Privileges for macOS Configuration Profiles
+const (
+	PrivilegeCreateMacOSConfigurationProfiles = "Create macOS Configuration Profiles"
+	PrivilegeReadMacOSConfigurationProfiles   = "Read macOS Configuration Profiles"
+	PrivilegeUpdateMacOSConfigurationProfiles = "Update macOS Configuration Profiles"
+	PrivilegeDeleteMacOSConfigurationProfiles = "Delete macOS Configuration Profiles"
+)
+
+// Privileges for Mobile Device Apps
+const (
+	PrivilegeCreateMobileDeviceApps = "Create Mobile Device Apps"
+	PrivilegeReadMobileDeviceApps   = "Read Mobile Device Apps"
+	PrivilegeUpdateMobileDeviceApps = "Update Mobile Device Apps"
+	PrivilegeDeleteMobileDeviceApps = "Delete Mobile Device Apps"
+)
+
+// Privileges for Mobile Device Configuration Profiles
+const (
+	PrivilegeCreateMobileDeviceConfigurationProfiles = "Create Mobile Device Configuration Profiles"
+	PrivilegeReadMobileDeviceConfigurationProfiles   = "Read Mobile Device Configuration Profiles"
+	PrivilegeUpdateMobileDeviceConfigurationProfiles = "Update Mobile Device Configuration Profiles"
+	PrivilegeDeleteMobileDeviceConfigurationProfiles = "Delete Mobile Device Configuration Profiles"
+)
+
+// Privileges for Mobile Device Enrollment Invitations
+const (
+	PrivilegeCreateMobileDeviceEnrollmentInvitations = "Create Mobile Device Enrollment Invitations"
+	PrivilegeReadMobileDeviceEnrollmentInvitations   = "Read Mobile Device Enrollment Invitations"
+	PrivilegeUpdateMobileDeviceEnrollmentInvitations = "Update Mobile Device Enrollment Invitations"
+	PrivilegeDeleteMobileDeviceEnrollmentInvitations = "Delete Mobile Device Enrollment Invitations"
+)
+
+// Privileges for Mobile Device Extension Attributes
+const (
+	PrivilegeCreateMobileDeviceExtensionAttributes = "Create Mobile Device Extension Attributes"
+	PrivilegeReadMobileDeviceExtensionAttributes   = "Read Mobile Device Extension Attributes"
+	PrivilegeUpdateMobileDeviceExtensionAttributes = "Update Mobile Device Extension Attributes"
+	PrivilegeDeleteMobileDeviceExtensionAttributes = "Delete Mobile Device Extension Attributes"
+)
+
+// Privileges for Mobile Device Inventory Collection
+const (
+	PrivilegeReadMobileDeviceInventoryCollection   = "Read Mobile Device Inventory Collection"
+	PrivilegeUpdateMobileDeviceInventoryCollection = "Update Mobile Device Inventory Collection"
+)
+
+// Privileges for Mobile Device PreStage Enrollments
+const (
+	PrivilegeCreateMobileDevicePreStageEnrollments = "Create Mobile Device PreStage Enrollments"
+	PrivilegeReadMobileDevicePreStageEnrollments   = "Read Mobile Device PreStage Enrollments"
+	PrivilegeUpdateMobileDevicePreStageEnrollments = "Update Mobile Device PreStage Enrollments"
+	PrivilegeDeleteMobileDevicePreStageEnrollments = "Delete Mobile Device PreStage Enrollments"
+)
+
+// Privileges for Mobile Devices
+const (
+	PrivilegeCreateMobileDevices = "Create Mobile Devices"
+	PrivilegeReadMobileDevices   = "Read Mobile Devices"
+	PrivilegeUpdateMobileDevices = "Update Mobile Devices"
+	PrivilegeDeleteMobileDevices = "Delete Mobile Devices"
+)
+
+// Privileges for Network Segments
+const (
+	PrivilegeCreateNetworkSegments = "Create Network Segments"
+	PrivilegeReadNetworkSegments   = "Read Network Segments"
+	PrivilegeUpdateNetworkSegments = "Update Network Segments"
+	PrivilegeDeleteNetworkSegments = "Delete Network Segments"
+)
+
+// Privileges for Packages
+const (
+	PrivilegeCreatePackages = "Create Packages"
+	PrivilegeReadPackages   = "Read Packages"
+	PrivilegeUpdatePackages = "Update Packages"
+	PrivilegeDeletePackages = "Delete Packages"
+)
+
+// Privileges for Patch Management Software Titles
+const (
+	PrivilegeCreatePatchManagementSoftwareTitles = "Create Patch Management Software Titles"
+	PrivilegeReadPatchManagementSoftwareTitles   = "Read Patch Management Software Titles"
+	PrivilegeUpdatePatchManagementSoftwareTitles = "Update Patch Management Software Titles"
+	PrivilegeDeletePatchManagementSoftwareTitles = "Delete Patch Management Software Titles"
+)
+
+// Privileges for Patch Policies
+const (
+	PrivilegeCreatePatchPolicies = "Create Patch Policies"
+	PrivilegeReadPatchPolicies   = "Read Patch Policies"
+	PrivilegeUpdatePatchPolicies = "Update Patch Policies"
+	PrivilegeDeletePatchPolicies = "Delete Patch Policies"
+)
+
+// Privileges for Policies
+const (
+	PrivilegeCreatePolicies = "Create Policies"
+	PrivilegeReadPolicies   = "Read Policies"
+	PrivilegeUpdatePolicies = "Update Policies"
+	PrivilegeDeletePolicies = "Delete Policies"
+)
+
+// Privileges for Printers
+const (
+	PrivilegeCreatePrinters = "Create Printers"
+	PrivilegeReadPrinters   = "Read Printers"
+	PrivilegeUpdatePrinters = "Update Printers"
+	PrivilegeDeletePrinters = "Delete Printers"
+)
+
+// Privileges for Provisioning Profiles
+const (
+	PrivilegeCreateProvisioningProfiles = "Create Provisioning Profiles"
+	PrivilegeReadProvisioningProfiles   = "Read Provisioning Profiles"
+	PrivilegeUpdateProvisioningProfiles = "Update Provisioning Profiles"
+	PrivilegeDeleteProvisioningProfiles = "Delete Provisioning Profiles"
+)
+
+// Privileges for Push Certificates
+const (
+	PrivilegeCreatePushCertificates = "Create Push Certificates"
+	PrivilegeReadPushCertificates   = "Read Push Certificates"
+	PrivilegeUpdatePushCertificates = "Update Push Certificates"
+	PrivilegeDeletePushCertificates = "Delete Push Certificates"
+)
+
+// Privileges for Re-enrollment
+const (
+	PrivilegeReadReEnrollment   = "Read Re-enrollment"
+	PrivilegeUpdateReEnrollment = "Update Re-enrollment"
+)
+
+// Privileges for Remote Administration
+const (
+	PrivilegeCreateRemoteAdministration = "Create Remote Administration"
+	PrivilegeReadRemoteAdministration   = "Read Remote Administration"
+	PrivilegeUpdateRemoteAdministration = "Update Remote Administration"
+	PrivilegeDeleteRemoteAdministration = "Delete Remote Administration"
+)
+
+// Privileges for Removable MAC Address
+const (
+	PrivilegeCreateRemovableMACAddress = "Create Removable MAC Address"
+	PrivilegeReadRemovableMACAddress   = "Read Removable MAC Address"
+	PrivilegeUpdateRemovableMACAddress = "Update Removable MAC Address"
+	PrivilegeDeleteRemovableMACAddress = "Delete Removable MAC Address"
+)
+
+// Privileges for Restricted Software
+const (
+	PrivilegeCreateRestrictedSoftware = "Create Restricted Software"
+	PrivilegeReadRestrictedSoftware   = "Read Restricted Software"
+	PrivilegeUpdateRestrictedSoftware = "Update Restricted Software"
+	PrivilegeDeleteRestrictedSoftware = "Delete Restricted Software"
+)
+
+// Privileges for Scripts
+const (
+	PrivilegeCreateScripts = "Create Scripts"
+	PrivilegeReadScripts   = "Read Scripts"
+	PrivilegeUpdateScripts = "Update Scripts"
+	PrivilegeDeleteScripts = "Delete Scripts"
+)
+
+// Privileges for Self Service
+const (
+	PrivilegeReadSelfService   = "Read Self Service"
+	PrivilegeUpdateSelfService = "Update Self Service"
+)
+
+// Privileges for Self Service Bookmarks
+const (
+	PrivilegeCreateSelfServiceBookmarks = "Create Self Service Bookmarks"
+	PrivilegeReadSelfServiceBookmarks   = "Read Self Service Bookmarks"
+	PrivilegeUpdateSelfServiceBookmarks = "Update Self Service Bookmarks"
+	PrivilegeDeleteSelfServiceBookmarks = "Delete Self Service Bookmarks"
+)
+
+// Privileges for Sites
+const (
+	PrivilegeCreateSites = "Create Sites"
+	PrivilegeReadSites   = "Read Sites"
+	PrivilegeUpdateSites = "Update Sites"
+	PrivilegeDeleteSites = "Delete Sites"
+)
+
+// Privileges for Smart Computer Groups
+const (
+	PrivilegeCreateSmartComputerGroups = "Create Smart Computer Groups"
+	PrivilegeReadSmartComputerGroups   = "Read Smart Computer Groups"
+	PrivilegeUpdateSmartComputerGroups = "Update Smart Computer Groups"
+	PrivilegeDeleteSmartComputerGroups = "Delete Smart Computer Groups"
+)
+
+// Privileges for Smart Mobile Device Groups
+const (
+	PrivilegeCreateSmartMobileDeviceGroups = "Create Smart Mobile Device Groups"
+	PrivilegeReadSmartMobileDeviceGroups   = "Read Smart Mobile Device Groups"
+	PrivilegeUpdateSmartMobileDeviceGroups = "Update Smart Mobile Device Groups"
+	PrivilegeDeleteSmartMobileDeviceGroups = "Delete Smart Mobile Device Groups"
+)
+
+// Privileges for Smart User Groups
+const (
+	PrivilegeCreateSmartUserGroups = "Create Smart User Groups"
+	PrivilegeReadSmartUserGroups   = "Read Smart User Groups"
+	PrivilegeUpdateSmartUserGroups = "Update Smart User Groups"
+	PrivilegeDeleteSmartUserGroups = "Delete Smart User Groups"
+)
+
+// Privileges for SMTP Server
+const (
+	PrivilegeReadSMTPServer   = "Read SMTP Server"
+	PrivilegeUpdateSMTPServer = "Update SMTP Server"
+)
+
+// Privileges for SSO Settings
+const (
+	PrivilegeReadSSOSettings   = "Read SSO Settings"
+	PrivilegeUpdateSSOSettings = "Update SSO Settings"
+)
+
+// Privileges for Static Computer Groups
+const (
+	PrivilegeCreateStaticComputerGroups = "Create Static Computer Groups"
+	PrivilegeReadStaticComputerGroups   = "Read Static Computer Groups"
+	PrivilegeUpdateStaticComputerGroups = "Update Static Computer Groups"
+	PrivilegeDeleteStaticComputerGroups = "Delete Static Computer Groups"
+)
+
+// Privileges for Static Mobile Device Groups
+const (
+	PrivilegeCreateStaticMobileDeviceGroups = "Create Static Mobile Device Groups"
+	PrivilegeReadStaticMobileDeviceGroups   = "Read Static Mobile Device Groups"
+	PrivilegeUpdateStaticMobileDeviceGroups = "Update Static Mobile Device Groups"
+	PrivilegeDeleteStaticMobileDeviceGroups = "Delete Static Mobile Device Groups"
+)
+
+// Privileges for Static User Groups
+const (
+	PrivilegeCreateStaticUserGroups = "Create Static User Groups"
+	PrivilegeReadStaticUserGroups   = "Read Static User Groups"
+	PrivilegeUpdateStaticUserGroups = "Update Static User Groups"
+	PrivilegeDeleteStaticUserGroups = "Delete Static User Groups"
+)
+
+// Privileges for User Extension Attributes
+const (
+	PrivilegeCreateUserExtensionAttributes = "Create User Extension Attributes"
+	PrivilegeReadUserExtensionAttributes   = "Read User Extension Attributes"
+	PrivilegeUpdateUserExtensionAttributes = "Update User Extension Attributes"
+	PrivilegeDeleteUserExtensionAttributes = "Delete User Extension Attributes"
+)
+
+// Privileges for User-Initiated Enrollment
+const (
+	PrivilegeReadUserInitiatedEnrollment   = "Read User-Initiated Enrollment"
+	PrivilegeUpdateUserInitiatedEnrollment = "Update User-Initiated Enrollment"
+)
+
+// Privileges for Users
+const (
+	PrivilegeCreateUsers = "Create Users"
+	PrivilegeReadUsers   = "Read Users"
+	PrivilegeUpdateUsers = "Update Users"
+	PrivilegeDeleteUsers = "Delete Users"
+)
+
+// Privileges for Volume Purchasing Locations
+const (
+	PrivilegeCreateVolumePurchasingLocations = "Create Volume Purchasing Locations"
+	PrivilegeReadVolumePurchasingLocations   = "Read Volume Purchasing Locations"
+	PrivilegeUpdateVolumePurchasingLocations = "Update Volume Purchasing Locations"
+	PrivilegeDeleteVolumePurchasingLocations = "Delete Volume Purchasing Locations"
+)
+
+// Privileges for VPP Assignments
+const (
+	PrivilegeCreateVPPAssignments = "Create VPP Assignments"
+	PrivilegeReadVPPAssignments   = "Read VPP Assignments"
+	PrivilegeUpdateVPPAssignments = "Update VPP Assignments"
+	PrivilegeDeleteVPPAssignments = "Delete VPP Assignments"
+)
+
+// Privileges for VPP Invitations
+const (
+	PrivilegeCreateVPPInvitations = "Create VPP Invitations"
+	PrivilegeReadVPPInvitations   = "Read VPP Invitations"
+	PrivilegeUpdateVPPInvitations = "Update VPP Invitations"
+	PrivilegeDeleteVPPInvitations = "Delete VPP Invitations"
+)
+
+// Privileges for Webhooks
+const (
+	PrivilegeCreateWebhooks = "Create Webhooks"
+	PrivilegeReadWebhooks   = "Read Webhooks"
+	PrivilegeUpdateWebhooks = "Update Webhooks"
+	PrivilegeDeleteWebhooks = "Delete Webhooks"
+)
+
+// Privileges for commands and sensitive information
+const (
+	PrivilegeAssignUsersToComputers                = "Assign Users to Computers"
+	PrivilegeAssignUsersToMobileDevices            = "Assign Users to Mobile Devices"
+	PrivilegeFlushMDMCommands                      = "Flush MDM Commands"
+	PrivilegeFlushPolicyLogs                       = "Flush Policy Logs"
+	PrivilegeSendComputerBluetoothCommand          = "Send Computer Bluetooth Command"
+	PrivilegeSendComputerRemoteDesktopCommand      = "Send Computer Remote Desktop Command"
+	PrivilegeSendComputerRemoteLockCommand         = "Send Computer Remote Lock Command"
+	PrivilegeSendComputerRemoteWipeCommand         = "Send Computer Remote Wipe Command"
+	PrivilegeSendComputerUnmanageCommand           = "Send Computer Unmanage Command"
+	PrivilegeSendInventoryRequestsToMobileDevices  = "Send Inventory Requests to Mobile Devices"
+	PrivilegeSendMobileDeviceLostModeCommand       = "Send Mobile Device Lost Mode Command"
+	PrivilegeSendMobileDeviceRemoteWipeCommand     = "Send Mobile Device Remote Wipe Command"
+	PrivilegeSendMobileDeviceRestartDeviceCommand  = "Send Mobile Device Restart Device Command"
+	PrivilegeSendMobileDeviceShutDownCommand       = "Send Mobile Device Shut Down Command"
+	PrivilegeViewActivationLockBypassCode          = "View Activation Lock Bypass Code"
+	PrivilegeViewDiskEncryptionRecoveryKey         = "View Disk Encryption Recovery Key"
+	PrivilegeViewEventLogs                         = "View Event Logs"
+	PrivilegeViewLicenseSerialNumbers              = "View License Serial Numbers"
+	PrivilegeViewLocalAdminPassword                = "View Local Admin Password"
+	PrivilegeViewMDMCommandInformationInJamfProAPI = "View MDM command information in Jamf Pro API"
+	PrivilegeViewRecoveryLock                      = "View Recovery Lock"
+)
+
+// AllPrivileges lists every privilege that has a constant, as of when they were generated.
+var AllPrivileges = []string{
+	PrivilegeAssignUsersToComputers,
+	PrivilegeAssignUsersToMobileDevices,
+	PrivilegeCreateAPIIntegrations,
+	PrivilegeCreateAPIRoles,
+	PrivilegeCreateAdvancedComputerSearches,
+	PrivilegeCreateAdvancedMobileDeviceSearches,
+	PrivilegeCreateAdvancedUserSearches,
+	PrivilegeCreateAllowedFileExtension,
+	PrivilegeCreateBuildings,
+	PrivilegeCreateCategories,
+	PrivilegeCreateClasses,
+	PrivilegeCreateComputerEnrollmentInvitations,
+	PrivilegeCreateComputerExtensionAttributes,
+	PrivilegeCreateComputerPreStageEnrollments,
+	PrivilegeCreateComputers,
+	PrivilegeCreateDepartments,
+	PrivilegeCreateDeviceEnrollmentProgramInstances,
+	PrivilegeCreateDirectoryBindings,
+	PrivilegeCreateDiskEncryptionConfigurations,
+	PrivilegeCreateDockItems,
+	PrivilegeCreateEBooks,
+	PrivilegeCreateEnrollmentCustomizations,
+	PrivilegeCreateInventoryPreloadRecords,
+	PrivilegeCreateJamfProUserAccountsGroups,
+	PrivilegeCreateJamfProtectDeployments,
+	PrivilegeCreateLDAPServers,
+	PrivilegeCreateLicensedSoftware,
+	PrivilegeCreateMacApplications,
+	PrivilegeCreateMacOSConfigurationProfiles,
+	PrivilegeCreateMobileDeviceApps,
+	PrivilegeCreateMobileDeviceConfigurationProfiles,
+	PrivilegeCreateMobileDeviceEnrollmentInvitations,
+	PrivilegeCreateMobileDeviceExtensionAttributes,
+	PrivilegeCreateMobileDevicePreStageEnrollments,
+	PrivilegeCreateMobileDevices,
+	PrivilegeCreateNetworkSegments,
+	PrivilegeCreatePackages,
+	PrivilegeCreatePatchManagementSoftwareTitles,
+	PrivilegeCreatePatchPolicies,
+	PrivilegeCreatePolicies,
+	PrivilegeCreatePrinters,
+	PrivilegeCreateProvisioningProfiles,
+	PrivilegeCreatePushCertificates,
+	PrivilegeCreateRemoteAdministration,
+	PrivilegeCreateRemovableMACAddress,
+	PrivilegeCreateRestrictedSoftware,
+	PrivilegeCreateScripts,
+	PrivilegeCreateSelfServiceBookmarks,
+	PrivilegeCreateSites,
+	PrivilegeCreateSmartComputerGroups,
+	PrivilegeCreateSmartMobileDeviceGroups,
+	PrivilegeCreateSmartUserGroups,
+	PrivilegeCreateStaticComputerGroups,
+	PrivilegeCreateStaticMobileDeviceGroups,
+	PrivilegeCreateStaticUserGroups,
+	PrivilegeCreateUserExtensionAttributes,
+	PrivilegeCreateUsers,
+	PrivilegeCreateVPPAssignments,
+	PrivilegeCreateVPPInvitations,
+	PrivilegeCreateVolumePurchasingLocations,
+	PrivilegeCreateWebhooks,
+	PrivilegeDeleteAPIIntegrations,
+	PrivilegeDeleteAPIRoles,
+	PrivilegeDeleteAdvancedComputerSearches,
+	PrivilegeDeleteAdvancedMobileDeviceSearches,
+	PrivilegeDeleteAdvancedUserSearches,
+	PrivilegeDeleteAllowedFileExtension,
+	PrivilegeDeleteBuildings,
+	PrivilegeDeleteCategories,
+	PrivilegeDeleteClasses,
+	PrivilegeDeleteComputerEnrollmentInvitations,
+	PrivilegeDeleteComputerExtensionAttributes,
+	PrivilegeDeleteComputerPreStageEnrollments,
+	PrivilegeDeleteComputers,
+	PrivilegeDeleteDepartments,
+	PrivilegeDeleteDeviceEnrollmentProgramInstances,
+	PrivilegeDeleteDirectoryBindings,
+	PrivilegeDeleteDiskEncryptionConfigurations,
+	PrivilegeDeleteDockItems,
+	PrivilegeDeleteEBooks,
+	PrivilegeDeleteEnrollmentCustomizations,
+	PrivilegeDeleteInventoryPreloadRecords,
+	PrivilegeDeleteJamfProUserAccountsGroups,
+	PrivilegeDeleteJamfProtectDeployments,
+	PrivilegeDeleteLDAPServers,
+	PrivilegeDeleteLicensedSoftware,
+	PrivilegeDeleteMacApplications,
+	PrivilegeDeleteMacOSConfigurationProfiles,
+	PrivilegeDeleteMobileDeviceApps,
+	PrivilegeDeleteMobileDeviceConfigurationProfiles,
+	PrivilegeDeleteMobileDeviceEnrollmentInvitations,
+	PrivilegeDeleteMobileDeviceExtensionAttributes,
+	PrivilegeDeleteMobileDevicePreStageEnrollments,
+	PrivilegeDeleteMobileDevices,
+	PrivilegeDeleteNetworkSegments,
+	PrivilegeDeletePackages,
+	PrivilegeDeletePatchManagementSoftwareTitles,
+	PrivilegeDeletePatchPolicies,
+	PrivilegeDeletePolicies,
+	PrivilegeDeletePrinters,
+	PrivilegeDeleteProvisioningProfiles,
+	PrivilegeDeletePushCertificates,
+	PrivilegeDeleteRemoteAdministration,
+	PrivilegeDeleteRemovableMACAddress,
+	PrivilegeDeleteRestrictedSoftware,
+	PrivilegeDeleteScripts,
+	PrivilegeDeleteSelfServiceBookmarks,
+	PrivilegeDeleteSites,
+	PrivilegeDeleteSmartComputerGroups,
+	PrivilegeDeleteSmartMobileDeviceGroups,
+	PrivilegeDeleteSmartUserGroups,
+	PrivilegeDeleteStaticComputerGroups,
+	PrivilegeDeleteStaticMobileDeviceGroups,
+	PrivilegeDeleteStaticUserGroups,
+	PrivilegeDeleteUserExtensionAttributes,
+	PrivilegeDeleteUsers,
+	PrivilegeDeleteVPPAssignments,
+	PrivilegeDeleteVPPInvitations,
+	PrivilegeDeleteVolumePurchasingLocations,
+	PrivilegeDeleteWebhooks,
+	PrivilegeFlushMDMCommands,
+	PrivilegeFlushPolicyLogs,
+	PrivilegeReadAPIIntegrations,
+	PrivilegeReadAPIRoles,
+	PrivilegeReadActivationCode,
+	PrivilegeReadAdvancedComputerSearches,
+	PrivilegeReadAdvancedMobileDeviceSearches,
+	PrivilegeReadAdvancedUserSearches,
+	PrivilegeReadAllowedFileExtension,
+	PrivilegeReadBuildings,
+	PrivilegeReadCache,
+	PrivilegeReadCategories,
+	PrivilegeReadChangeManagement,
+	PrivilegeReadClasses,
+	PrivilegeReadCloudServicesSettings,
+	PrivilegeReadClustering,
+	PrivilegeReadComputerCheckIn,
+	PrivilegeReadComputerEnrollmentInvitations,
+	PrivilegeReadComputerExtensionAttributes,
+	PrivilegeReadComputerInventoryCollectionSettings,
+	PrivilegeReadComputerPreStageEnrollments,
+	PrivilegeReadComputers,
+	PrivilegeReadDepartments,
+	PrivilegeReadDeviceEnrollmentProgramInstances,
+	PrivilegeReadDirectoryBindings,
+	PrivilegeReadDiskEncryptionConfigurations,
+	PrivilegeReadDockItems,
+	PrivilegeReadEBooks,
+	PrivilegeReadEngageSettings,
+	PrivilegeReadEnrollmentCustomizations,
+	PrivilegeReadGSXConnection,
+	PrivilegeReadInventoryPreloadRecords,
+	PrivilegeReadJamfConnectDeployments,
+	PrivilegeReadJamfProUserAccountsGroups,
+	PrivilegeReadJamfProtectDeployments,
+	PrivilegeReadLDAPServers,
+	PrivilegeReadLicensedSoftware,
+	PrivilegeReadMacApplications,
+	PrivilegeReadMacOSConfigurationProfiles,
+	PrivilegeReadMobileDeviceApps,
+	PrivilegeReadMobileDeviceConfigurationProfiles,
+	PrivilegeReadMobileDeviceEnrollmentInvitations,
+	PrivilegeReadMobileDeviceExtensionAttributes,
+	PrivilegeReadMobileDeviceInventoryCollection,
+	PrivilegeReadMobileDevicePreStageEnrollments,
+	PrivilegeReadMobileDevices,
+	PrivilegeReadNetworkSegments,
+	PrivilegeReadPackages,
+	PrivilegeReadPatchManagementSoftwareTitles,
+	PrivilegeReadPatchPolicies,
+	PrivilegeReadPolicies,
+	PrivilegeReadPrinters,
+	PrivilegeReadProvisioningProfiles,
+	PrivilegeReadPushCertificates,
+	PrivilegeReadReEnrollment,
+	PrivilegeReadRemoteAdministration,
+	PrivilegeReadRemovableMACAddress,
+	PrivilegeReadRestrictedSoftware,
+	PrivilegeReadSMTPServer,
+	PrivilegeReadSSOSettings,
+	PrivilegeReadScripts,
+	PrivilegeReadSelfService,
+	PrivilegeReadSelfServiceBookmarks,
+	PrivilegeReadSites,
+	PrivilegeReadSmartComputerGroups,
+	PrivilegeReadSmartMobileDeviceGroups,
+	PrivilegeReadSmartUserGroups,
+	PrivilegeReadStaticComputerGroups,
+	PrivilegeReadStaticMobileDeviceGroups,
+	PrivilegeReadStaticUserGroups,
+	PrivilegeReadUserExtensionAttributes,
+	PrivilegeReadUserInitiatedEnrollment,
+	PrivilegeReadUsers,
+	PrivilegeReadVPPAssignments,
+	PrivilegeReadVPPInvitations,
+	PrivilegeReadVolumePurchasingLocations,
+	PrivilegeReadWebhooks,
+	PrivilegeSendComputerBluetoothCommand,
+	PrivilegeSendComputerRemoteDesktopCommand,
+	PrivilegeSendComputerRemoteLockCommand,
+	PrivilegeSendComputerRemoteWipeCommand,
+	PrivilegeSendComputerUnmanageCommand,
+	PrivilegeSendInventoryRequestsToMobileDevices,
+	PrivilegeSendMobileDeviceLostModeCommand,
+	PrivilegeSendMobileDeviceRemoteWipeCommand,
+	PrivilegeSendMobileDeviceRestartDeviceCommand,
+	PrivilegeSendMobileDeviceShutDownCommand,
+	PrivilegeUpdateAPIIntegrations,
+	PrivilegeUpdateAPIRoles,
+	PrivilegeUpdateActivationCode,
+	PrivilegeUpdateAdvancedComputerSearches,
+	PrivilegeUpdateAdvancedMobileDeviceSearches,
+	PrivilegeUpdateAdvancedUserSearches,
+	PrivilegeUpdateAllowedFileExtension,
+	PrivilegeUpdateBuildings,
+	PrivilegeUpdateCache,
+	PrivilegeUpdateCategories,
+	PrivilegeUpdateChangeManagement,
+	PrivilegeUpdateClasses,
+	PrivilegeUpdateCloudServicesSettings,
+	PrivilegeUpdateClustering,
+	PrivilegeUpdateComputerCheckIn,
+	PrivilegeUpdateComputerEnrollmentInvitations,
+	PrivilegeUpdateComputerExtensionAttributes,
+	PrivilegeUpdateComputerInventoryCollectionSettings,
+	PrivilegeUpdateComputerPreStageEnrollments,
+	PrivilegeUpdateComputers,
+	PrivilegeUpdateDepartments,
+	PrivilegeUpdateDeviceEnrollmentProgramInstances,
+	PrivilegeUpdateDirectoryBindings,
+	PrivilegeUpdateDiskEncryptionConfigurations,
+	PrivilegeUpdateDockItems,
+	PrivilegeUpdateEBooks,
+	PrivilegeUpdateEngageSettings,
+	PrivilegeUpdateEnrollmentCustomizations,
+	PrivilegeUpdateGSXConnection,
+	PrivilegeUpdateInventoryPreloadRecords,
+	PrivilegeUpdateJamfConnectDeployments,
+	PrivilegeUpdateJamfProUserAccountsGroups,
+	PrivilegeUpdateJamfProtectDeployments,
+	PrivilegeUpdateLDAPServers,
+	PrivilegeUpdateLicensedSoftware,
+	PrivilegeUpdateMacApplications,
+	PrivilegeUpdateMacOSConfigurationProfiles,
+	PrivilegeUpdateMobileDeviceApps,
+	PrivilegeUpdateMobileDeviceConfigurationProfiles,
+	PrivilegeUpdateMobileDeviceEnrollmentInvitations,
+	PrivilegeUpdateMobileDeviceExtensionAttributes,
+	PrivilegeUpdateMobileDeviceInventoryCollection,
+	PrivilegeUpdateMobileDevicePreStageEnrollments,
+	PrivilegeUpdateMobileDevices,
+	PrivilegeUpdateNetworkSegments,
+	PrivilegeUpdatePackages,
+	PrivilegeUpdatePatchManagementSoftwareTitles,
+	PrivilegeUpdatePatchPolicies,
+	PrivilegeUpdatePolicies,
+	PrivilegeUpdatePrinters,
+	PrivilegeUpdateProvisioningProfiles,
+	PrivilegeUpdatePushCertificates,
+	PrivilegeUpdateReEnrollment,
+	PrivilegeUpdateRemoteAdministration,
+	PrivilegeUpdateRemovableMACAddress,
+	PrivilegeUpdateRestrictedSoftware,
+	PrivilegeUpdateSMTPServer,
+	PrivilegeUpdateSSOSettings,
+	PrivilegeUpdateScripts,
+	PrivilegeUpdateSelfService,
+	PrivilegeUpdateSelfServiceBookmarks,
+	PrivilegeUpdateSites,
+	PrivilegeUpdateSmartComputerGroups,
+	PrivilegeUpdateSmartMobileDeviceGroups,
+	PrivilegeUpdateSmartUserGroups,
+	PrivilegeUpdateStaticComputerGroups,
+	PrivilegeUpdateStaticMobileDeviceGroups,
+	PrivilegeUpdateStaticUserGroups,
+	PrivilegeUpdateUserExtensionAttributes,
+	PrivilegeUpdateUserInitiatedEnrollment,
+	PrivilegeUpdateUsers,
+	PrivilegeUpdateVPPAssignments,
+	PrivilegeUpdateVPPInvitations,
+	PrivilegeUpdateVolumePurchasingLocations,
+	PrivilegeUpdateWebhooks,
+	PrivilegeViewActivationLockBypassCode,
+	PrivilegeViewDiskEncryptionRecoveryKey,
+	PrivilegeViewEventLogs,
+	PrivilegeViewLicenseSerialNumbers,
+	PrivilegeViewLocalAdminPassword,
+	PrivilegeViewMDMCommandInformationInJamfProAPI,
+	PrivilegeViewRecoveryLock,
+}
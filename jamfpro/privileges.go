@@ -0,0 +1,390 @@
+package jamfpro
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// operationPrivileges are the privileges each service operation needs, keyed by the Client field of the service and
+// the method name. Operations whose privileges depend on their arguments, such as History.List, MdmCommands.Send or
+// MobileDeviceCommands.Send, are left out.
+var operationPrivileges = mergePrivileges(
+	settingsPrivileges("ActivationCode", PrivilegeReadActivationCode, PrivilegeUpdateActivationCode),
+	crudPrivileges("AdvancedComputerSearches", PrivilegeReadAdvancedComputerSearches, PrivilegeCreateAdvancedComputerSearches, PrivilegeUpdateAdvancedComputerSearches, PrivilegeDeleteAdvancedComputerSearches),
+	map[string][]string{
+		"AdvancedComputerSearches.Execute": {PrivilegeReadAdvancedComputerSearches, PrivilegeReadComputers},
+	},
+	crudPrivileges("AdvancedMobileDeviceSearches", PrivilegeReadAdvancedMobileDeviceSearches, PrivilegeCreateAdvancedMobileDeviceSearches, PrivilegeUpdateAdvancedMobileDeviceSearches, PrivilegeDeleteAdvancedMobileDeviceSearches),
+	map[string][]string{
+		"AdvancedMobileDeviceSearches.Execute": {PrivilegeReadAdvancedMobileDeviceSearches, PrivilegeReadMobileDevices},
+	},
+	crudPrivileges("AdvancedUserSearches", PrivilegeReadAdvancedUserSearches, PrivilegeCreateAdvancedUserSearches, PrivilegeUpdateAdvancedUserSearches, PrivilegeDeleteAdvancedUserSearches),
+	map[string][]string{
+		"AdvancedUserSearches.Execute": {PrivilegeReadAdvancedUserSearches, PrivilegeReadUsers},
+	},
+	map[string][]string{
+		"AllowedFileExtensions.List":           {PrivilegeReadAllowedFileExtension},
+		"AllowedFileExtensions.GetByID":        {PrivilegeReadAllowedFileExtension},
+		"AllowedFileExtensions.GetByExtension": {PrivilegeReadAllowedFileExtension},
+		"AllowedFileExtensions.Create":         {PrivilegeCreateAllowedFileExtension},
+		"AllowedFileExtensions.Delete":         {PrivilegeDeleteAllowedFileExtension},
+	},
+	crudPrivileges("ApiRoles", PrivilegeReadAPIRoles, PrivilegeCreateAPIRoles, PrivilegeUpdateAPIRoles, PrivilegeDeleteAPIRoles),
+	map[string][]string{
+		"ApiRoles.ListAvailablePrivileges": {PrivilegeReadAPIRoles},
+		"AppStoreCountryCodes.List":        {},
+	},
+	crudPrivileges("Buildings", PrivilegeReadBuildings, PrivilegeCreateBuildings, PrivilegeUpdateBuildings, PrivilegeDeleteBuildings),
+	historyPrivileges("Buildings", PrivilegeReadBuildings, PrivilegeUpdateBuildings),
+	map[string][]string{
+		"Buildings.ExistsByName": {PrivilegeReadBuildings},
+		"Buildings.ExportCSV":    {PrivilegeReadBuildings},
+	},
+	crudPrivileges("Categories", PrivilegeReadCategories, PrivilegeCreateCategories, PrivilegeUpdateCategories, PrivilegeDeleteCategories),
+	historyPrivileges("Categories", PrivilegeReadCategories, PrivilegeUpdateCategories),
+	settingsPrivileges("ChangeManagement", PrivilegeReadChangeManagement, PrivilegeUpdateChangeManagement),
+	settingsPrivileges("CheckInSettings", PrivilegeReadComputerCheckIn, PrivilegeUpdateComputerCheckIn),
+	historyPrivileges("CheckInSettings", PrivilegeReadComputerCheckIn, PrivilegeUpdateComputerCheckIn),
+	map[string][]string{
+		"CloudServices.GetConnection":              {PrivilegeReadCloudServicesSettings},
+		"CloudServices.Connect":                    {PrivilegeUpdateCloudServicesSettings},
+		"CloudServices.UpdateConnection":           {PrivilegeUpdateCloudServicesSettings},
+		"CloudServices.Disconnect":                 {PrivilegeUpdateCloudServicesSettings},
+		"CloudServices.GetNotificationSettings":    {PrivilegeReadCloudServicesSettings},
+		"CloudServices.UpdateNotificationSettings": {PrivilegeUpdateCloudServicesSettings},
+	},
+	map[string][]string{
+		"ComputerApplications.GetByName":    {PrivilegeReadComputers},
+		"ComputerApplications.GetUsage":     {PrivilegeReadComputers},
+		"ComputerApplications.ForEachUsage": {PrivilegeReadComputers},
+	},
+	map[string][]string{
+		"ComputerPrestages.GetScope":            {PrivilegeReadComputerPreStageEnrollments},
+		"ComputerPrestages.AssignSerialNumbers": {PrivilegeReadComputerPreStageEnrollments, PrivilegeUpdateComputerPreStageEnrollments},
+		"ComputerPrestages.RemoveSerialNumbers": {PrivilegeReadComputerPreStageEnrollments, PrivilegeUpdateComputerPreStageEnrollments},
+	},
+	map[string][]string{
+		"ComputerInvitations.List":            {PrivilegeReadComputerEnrollmentInvitations},
+		"ComputerInvitations.GetByID":         {PrivilegeReadComputerEnrollmentInvitations},
+		"ComputerInvitations.GetByInvitation": {PrivilegeReadComputerEnrollmentInvitations},
+		"ComputerInvitations.Create":          {PrivilegeCreateComputerEnrollmentInvitations, PrivilegeReadComputerEnrollmentInvitations},
+		"ComputerInvitations.Delete":          {PrivilegeDeleteComputerEnrollmentInvitations},
+		"ComputerInvitations.DeleteExpired":   {PrivilegeReadComputerEnrollmentInvitations, PrivilegeDeleteComputerEnrollmentInvitations},
+		"ComputerInvitations.EnrollmentURL":   {},
+	},
+	settingsPrivileges("ComputerInventoryCollectionSettings", PrivilegeReadComputerInventoryCollectionSettings, PrivilegeUpdateComputerInventoryCollectionSettings),
+	map[string][]string{
+		"ComputerInventoryCollectionSettings.AddCustomPath":    {PrivilegeUpdateComputerInventoryCollectionSettings},
+		"ComputerInventoryCollectionSettings.DeleteCustomPath": {PrivilegeUpdateComputerInventoryCollectionSettings},
+	},
+	crudPrivileges("Computers", PrivilegeReadComputers, PrivilegeCreateComputers, PrivilegeUpdateComputers, PrivilegeDeleteComputers),
+	map[string][]string{
+		"Computers.GetManyByID":            {PrivilegeReadComputers},
+		"Computers.GetBySerialNumber":      {PrivilegeReadComputers},
+		"Computers.ExistsBySerial":         {PrivilegeReadComputers},
+		"Computers.GetInventoryDetail":     {PrivilegeReadComputers},
+		"Computers.ListInventory":          {PrivilegeReadComputers},
+		"Computers.StreamList":             {PrivilegeReadComputers},
+		"Computers.StreamInventory":        {PrivilegeReadComputers},
+		"Computers.UpdateInventoryDetail":  {PrivilegeUpdateComputers},
+		"Computers.UpdatePurchasing":       {PrivilegeUpdateComputers},
+		"Computers.RecalculateSmartGroups": {PrivilegeReadComputers},
+		"Computers.Lock":                   {PrivilegeSendComputerRemoteLockCommand},
+		"Computers.Erase":                  {PrivilegeSendComputerRemoteWipeCommand},
+		"Computers.Unmanage":               {PrivilegeReadComputers, PrivilegeSendComputerUnmanageCommand},
+		"Computers.RemoveMDMProfile":       {PrivilegeReadComputers, PrivilegeSendComputerUnmanageCommand},
+		"Computers.Reenroll":               {PrivilegeReadComputers, PrivilegeUpdateComputers},
+	},
+	map[string][]string{
+		"ComputerGroups.List":                          {PrivilegeReadSmartComputerGroups, PrivilegeReadStaticComputerGroups},
+		"ComputerGroups.GetByID":                       {PrivilegeReadSmartComputerGroups, PrivilegeReadStaticComputerGroups},
+		"ComputerGroups.GetManyByID":                   {PrivilegeReadSmartComputerGroups, PrivilegeReadStaticComputerGroups},
+		"ComputerGroups.GetByName":                     {PrivilegeReadSmartComputerGroups, PrivilegeReadStaticComputerGroups},
+		"ComputerGroups.Create":                        {PrivilegeCreateSmartComputerGroups, PrivilegeCreateStaticComputerGroups, PrivilegeReadSmartComputerGroups, PrivilegeReadStaticComputerGroups},
+		"ComputerGroups.Update":                        {PrivilegeUpdateSmartComputerGroups, PrivilegeUpdateStaticComputerGroups, PrivilegeReadSmartComputerGroups, PrivilegeReadStaticComputerGroups},
+		"ComputerGroups.Delete":                        {PrivilegeDeleteSmartComputerGroups, PrivilegeDeleteStaticComputerGroups, PrivilegeReadSmartComputerGroups, PrivilegeReadStaticComputerGroups},
+		"ComputerGroups.DeleteByName":                  {PrivilegeDeleteSmartComputerGroups, PrivilegeDeleteStaticComputerGroups, PrivilegeReadSmartComputerGroups, PrivilegeReadStaticComputerGroups},
+		"ComputerGroups.Recalculate":                   {PrivilegeReadSmartComputerGroups},
+		"ComputerGroups.UpdateMembership":              {PrivilegeUpdateStaticComputerGroups},
+		"ComputerGroups.AddComputersBySerialNumber":    {PrivilegeUpdateStaticComputerGroups},
+		"ComputerGroups.RemoveComputersBySerialNumber": {PrivilegeUpdateStaticComputerGroups},
+		"ComputerGroups.AddComputersByUDID":            {PrivilegeUpdateStaticComputerGroups},
+		"ComputerGroups.RemoveComputersByUDID":         {PrivilegeUpdateStaticComputerGroups},
+	},
+	map[string][]string{
+		"ConnectionTests.GSX":  {PrivilegeReadGSXConnection},
+		"ConnectionTests.LDAP": {PrivilegeReadLDAPServers},
+		"ConnectionTests.SMTP": {PrivilegeReadSMTPServer},
+	},
+	map[string][]string{
+		"DeclarativeManagement.ListStatusItems":  {PrivilegeReadComputers, PrivilegeReadMobileDevices},
+		"DeclarativeManagement.GetStatusItem":    {PrivilegeReadComputers, PrivilegeReadMobileDevices},
+		"DeclarativeManagement.ListDeclarations": {PrivilegeReadComputers, PrivilegeReadMobileDevices},
+	},
+	crudPrivileges("Departments", PrivilegeReadDepartments, PrivilegeCreateDepartments, PrivilegeUpdateDepartments, PrivilegeDeleteDepartments),
+	historyPrivileges("Departments", PrivilegeReadDepartments, PrivilegeUpdateDepartments),
+	map[string][]string{
+		"Departments.ExportCSV": {PrivilegeReadDepartments},
+	},
+	map[string][]string{
+		"DeviceAssignments.AssignComputer":       {PrivilegeUpdateComputers, PrivilegeReadUsers},
+		"DeviceAssignments.UnassignComputer":     {PrivilegeUpdateComputers},
+		"DeviceAssignments.AssignMobileDevice":   {PrivilegeUpdateMobileDevices, PrivilegeReadUsers, PrivilegeReadDepartments, PrivilegeReadBuildings},
+		"DeviceAssignments.UnassignMobileDevice": {PrivilegeUpdateMobileDevices},
+	},
+	crudPrivileges("DirectoryBindings", PrivilegeReadDirectoryBindings, PrivilegeCreateDirectoryBindings, PrivilegeUpdateDirectoryBindings, PrivilegeDeleteDirectoryBindings),
+	crudPrivileges("DiskEncryptionConfigurations", PrivilegeReadDiskEncryptionConfigurations, PrivilegeCreateDiskEncryptionConfigurations, PrivilegeUpdateDiskEncryptionConfigurations, PrivilegeDeleteDiskEncryptionConfigurations),
+	crudPrivileges("DockItems", PrivilegeReadDockItems, PrivilegeCreateDockItems, PrivilegeUpdateDockItems, PrivilegeDeleteDockItems),
+	crudPrivileges("Ebooks", PrivilegeReadEBooks, PrivilegeCreateEBooks, PrivilegeUpdateEBooks, PrivilegeDeleteEBooks),
+	settingsPrivileges("EngageSettings", PrivilegeReadEngageSettings, PrivilegeUpdateEngageSettings),
+	historyPrivileges("EngageSettings", PrivilegeReadEngageSettings, PrivilegeUpdateEngageSettings),
+	settingsPrivileges("GSXConnection", PrivilegeReadGSXConnection, PrivilegeUpdateGSXConnection),
+	historyPrivileges("GSXConnection", PrivilegeReadGSXConnection, PrivilegeUpdateGSXConnection),
+	crudPrivileges("GroupAccounts", PrivilegeReadJamfProUserAccountsGroups, PrivilegeCreateJamfProUserAccountsGroups, PrivilegeUpdateJamfProUserAccountsGroups, PrivilegeDeleteJamfProUserAccountsGroups),
+	map[string][]string{
+		"GroupAccounts.AddPrivileges":    {PrivilegeReadJamfProUserAccountsGroups, PrivilegeUpdateJamfProUserAccountsGroups},
+		"GroupAccounts.RemovePrivileges": {PrivilegeReadJamfProUserAccountsGroups, PrivilegeUpdateJamfProUserAccountsGroups},
+	},
+	historyPrivileges("InventoryPreload", PrivilegeReadInventoryPreloadRecords, PrivilegeUpdateInventoryPreloadRecords),
+	map[string][]string{
+		// Files are usually attached to computers and mobile devices; attaching them to other records needs the
+		// Update privilege of those records too
+		"FileUploads.Upload":                     {PrivilegeUpdateComputers, PrivilegeUpdateMobileDevices},
+		"FileUploads.DownloadComputerAttachment": {PrivilegeReadComputers},
+		"FileUploads.DeleteComputerAttachment":   {PrivilegeUpdateComputers},
+	},
+	map[string][]string{
+		"InventoryPreload.List":                          {PrivilegeReadInventoryPreloadRecords},
+		"InventoryPreload.GetByID":                       {PrivilegeReadInventoryPreloadRecords},
+		"InventoryPreload.Create":                        {PrivilegeCreateInventoryPreloadRecords},
+		"InventoryPreload.Update":                        {PrivilegeUpdateInventoryPreloadRecords},
+		"InventoryPreload.Delete":                        {PrivilegeDeleteInventoryPreloadRecords},
+		"InventoryPreload.DeleteAll":                     {PrivilegeDeleteInventoryPreloadRecords},
+		"InventoryPreload.UploadCSV":                     {PrivilegeCreateInventoryPreloadRecords},
+		"InventoryPreload.ValidateCSV":                   {PrivilegeReadInventoryPreloadRecords},
+		"InventoryPreload.DownloadCSVTemplate":           {PrivilegeReadInventoryPreloadRecords},
+		"InventoryPreload.ExportCSV":                     {PrivilegeReadInventoryPreloadRecords},
+		"InventoryPreload.ListExtensionAttributeColumns": {PrivilegeReadInventoryPreloadRecords},
+	},
+	map[string][]string{
+		"JamfConnect.ListConfigProfiles":   {PrivilegeReadJamfConnectDeployments},
+		"JamfConnect.UpdateConfigProfile":  {PrivilegeUpdateJamfConnectDeployments},
+		"JamfConnect.ListDeploymentTasks":  {PrivilegeReadJamfConnectDeployments},
+		"JamfConnect.RetryDeploymentTasks": {PrivilegeUpdateJamfConnectDeployments},
+		"JamfConnect.ListHistory":          {PrivilegeReadJamfConnectDeployments},
+		"JamfConnect.AddHistoryNote":       {PrivilegeUpdateJamfConnectDeployments},
+	},
+	map[string][]string{
+		"LogFlush.FlushPolicyLogs": {PrivilegeFlushPolicyLogs},
+		"LogFlush.Flush":           {PrivilegeFlushPolicyLogs},
+	},
+	crudPrivileges("MacOSConfigurationProfiles", PrivilegeReadMacOSConfigurationProfiles, PrivilegeCreateMacOSConfigurationProfiles, PrivilegeUpdateMacOSConfigurationProfiles, PrivilegeDeleteMacOSConfigurationProfiles),
+	map[string][]string{
+		"MacOSConfigurationProfiles.UpdatePayloads": {PrivilegeReadMacOSConfigurationProfiles, PrivilegeUpdateMacOSConfigurationProfiles},
+	},
+	map[string][]string{
+		"MdmCommands.List":                     {PrivilegeViewMDMCommandInformationInJamfProAPI},
+		"MdmCommands.GetByUUID":                {PrivilegeViewMDMCommandInformationInJamfProAPI},
+		"MdmCommands.WaitForCommandCompletion": {PrivilegeViewMDMCommandInformationInJamfProAPI},
+	},
+	map[string][]string{
+		"MobileDeviceApps.List":                    {PrivilegeReadMobileDeviceApps},
+		"MobileDeviceApps.GetByID":                 {PrivilegeReadMobileDeviceApps},
+		"MobileDeviceApps.GetByName":               {PrivilegeReadMobileDeviceApps},
+		"MobileDeviceApps.GetByBundleID":           {PrivilegeReadMobileDeviceApps},
+		"MobileDeviceApps.GetByBundleIDAndVersion": {PrivilegeReadMobileDeviceApps},
+	},
+	map[string][]string{
+		"MobileDeviceCommands.ListHistory": {PrivilegeReadMobileDevices},
+	},
+	crudPrivileges("MobileDeviceConfigurationProfiles", PrivilegeReadMobileDeviceConfigurationProfiles, PrivilegeCreateMobileDeviceConfigurationProfiles, PrivilegeUpdateMobileDeviceConfigurationProfiles, PrivilegeDeleteMobileDeviceConfigurationProfiles),
+	map[string][]string{
+		"MobileDeviceConfigurationProfiles.UpdatePayloads": {PrivilegeReadMobileDeviceConfigurationProfiles, PrivilegeUpdateMobileDeviceConfigurationProfiles},
+	},
+	map[string][]string{
+		"MobileDeviceInvitations.List":            {PrivilegeReadMobileDeviceEnrollmentInvitations},
+		"MobileDeviceInvitations.GetByID":         {PrivilegeReadMobileDeviceEnrollmentInvitations},
+		"MobileDeviceInvitations.GetByInvitation": {PrivilegeReadMobileDeviceEnrollmentInvitations},
+		"MobileDeviceInvitations.Create":          {PrivilegeCreateMobileDeviceEnrollmentInvitations, PrivilegeReadMobileDeviceEnrollmentInvitations},
+		"MobileDeviceInvitations.Delete":          {PrivilegeDeleteMobileDeviceEnrollmentInvitations},
+		"MobileDeviceInvitations.DeleteExpired":   {PrivilegeReadMobileDeviceEnrollmentInvitations, PrivilegeDeleteMobileDeviceEnrollmentInvitations},
+		"MobileDeviceInvitations.EnrollmentURL":   {},
+	},
+	map[string][]string{
+		"MobileDevicesInventory.List":             {PrivilegeReadMobileDevices},
+		"MobileDevicesInventory.Stream":           {PrivilegeReadMobileDevices},
+		"MobileDevicesInventory.GetByID":          {PrivilegeReadMobileDevices},
+		"MobileDevicesInventory.Update":           {PrivilegeUpdateMobileDevices},
+		"MobileDevicesInventory.UpdatePurchasing": {PrivilegeUpdateMobileDevices},
+	},
+	crudPrivileges("Policies", PrivilegeReadPolicies, PrivilegeCreatePolicies, PrivilegeUpdatePolicies, PrivilegeDeletePolicies),
+	map[string][]string{
+		"Policies.GetManyByID": {PrivilegeReadPolicies},
+	},
+	map[string][]string{
+		"PushCertificate.Get":                  {PrivilegeReadPushCertificates},
+		"PushCertificate.Verify":               {PrivilegeReadPushCertificates},
+		"PushCertificate.ListClientPushStatus": {PrivilegeReadPushCertificates},
+	},
+	map[string][]string{
+		"RemoteAdministration.List":                      {PrivilegeReadRemoteAdministration},
+		"RemoteAdministration.GetByID":                   {PrivilegeReadRemoteAdministration},
+		"RemoteAdministration.Create":                    {PrivilegeCreateRemoteAdministration},
+		"RemoteAdministration.Update":                    {PrivilegeUpdateRemoteAdministration},
+		"RemoteAdministration.Delete":                    {PrivilegeDeleteRemoteAdministration},
+		"RemoteAdministration.GetStatus":                 {PrivilegeReadRemoteAdministration},
+		"RemoteAdministration.ListSessions":              {PrivilegeReadRemoteAdministration},
+		"RemoteAdministration.GetSession":                {PrivilegeReadRemoteAdministration},
+		"RemoteAdministration.GetSessionStatus":          {PrivilegeReadRemoteAdministration},
+		"RemoteAdministration.CreateSession":             {PrivilegeCreateRemoteAdministration},
+		"RemoteAdministration.CloseSession":              {PrivilegeUpdateRemoteAdministration},
+		"RemoteAdministration.ResendSessionNotification": {PrivilegeUpdateRemoteAdministration},
+	},
+	settingsPrivileges("ReenrollmentSettings", PrivilegeReadReEnrollment, PrivilegeUpdateReEnrollment),
+	historyPrivileges("ReenrollmentSettings", PrivilegeReadReEnrollment, PrivilegeUpdateReEnrollment),
+	crudPrivileges("RemovableMacAddresses", PrivilegeReadRemovableMACAddress, PrivilegeCreateRemovableMACAddress, PrivilegeUpdateRemovableMACAddress, PrivilegeDeleteRemovableMACAddress),
+	crudPrivileges("Scripts", PrivilegeReadScripts, PrivilegeCreateScripts, PrivilegeUpdateScripts, PrivilegeDeleteScripts),
+	map[string][]string{
+		// Take reads the DefaultSettingsSections unless given others
+		"SettingsSnapshot.Take": {
+			PrivilegeReadComputerCheckIn, PrivilegeReadComputerInventoryCollectionSettings, PrivilegeReadReEnrollment,
+			PrivilegeReadEngageSettings, PrivilegeReadChangeManagement, PrivilegeReadSSOSettings,
+			PrivilegeReadUserInitiatedEnrollment, PrivilegeReadSelfService,
+		},
+	},
+	map[string][]string{
+		"Sites.List":      {PrivilegeReadSites},
+		"Sites.GetByName": {PrivilegeReadSites},
+	},
+	crudPrivileges("Webhooks", PrivilegeReadWebhooks, PrivilegeCreateWebhooks, PrivilegeUpdateWebhooks, PrivilegeDeleteWebhooks),
+)
+
+// crudPrivileges maps the List, GetByID, GetByName, Update and Delete methods of a service to the privileges of the
+// object it manages, Create and GetOrCreate to both creating and reading it, as the created object is fetched back,
+// and DeleteByName to both reading and deleting it.
+func crudPrivileges(service, read, create, update, del string) map[string][]string {
+	return map[string][]string{
+		service + ".List":         {read},
+		service + ".GetByID":      {read},
+		service + ".GetByName":    {read},
+		service + ".Create":       {create, read},
+		service + ".Update":       {update},
+		service + ".Delete":       {del},
+		service + ".GetOrCreate":  {read, create},
+		service + ".DeleteByName": {read, del},
+	}
+}
+
+// settingsPrivileges maps the Get and Update methods of a settings service to the privileges of the settings.
+func settingsPrivileges(service, read, update string) map[string][]string {
+	return map[string][]string{
+		service + ".Get":    {read},
+		service + ".Update": {update},
+	}
+}
+
+// historyPrivileges maps the ListHistory and AddHistoryNote methods of a service to the privileges of the object
+// whose history they manage.
+func historyPrivileges(service, read, update string) map[string][]string {
+	return map[string][]string{
+		service + ".ListHistory":    {read},
+		service + ".AddHistoryNote": {update},
+	}
+}
+
+func mergePrivileges(maps ...map[string][]string) map[string][]string {
+	merged := make(map[string][]string)
+	for _, m := range maps {
+		for operation, privileges := range m {
+			merged[operation] = privileges
+		}
+	}
+	return merged
+}
+
+// RequiredPrivileges returns the sorted privileges an API role needs for a program to call operations. Operations
+// are named after the Client field of the service and the method, e.g. Categories.Create, and Categories.* stands
+// for every method of the service. Operations whose privileges depend on their arguments, such as History.List,
+// MdmCommands.Send or MobileDeviceCommands.Send, are not known.
+func RequiredPrivileges(operations ...string) ([]string, error) {
+	set := make(map[string]bool)
+	var unknown []string
+	for _, operation := range operations {
+		matched := false
+		if service, ok := strings.CutSuffix(operation, ".*"); ok {
+			for known, privileges := range operationPrivileges {
+				if strings.HasPrefix(known, service+".") {
+					matched = true
+					for _, privilege := range privileges {
+						set[privilege] = true
+					}
+				}
+			}
+		} else if privileges, ok := operationPrivileges[operation]; ok {
+			matched = true
+			for _, privilege := range privileges {
+				set[privilege] = true
+			}
+		}
+		if !matched {
+			unknown = append(unknown, operation)
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, NewArgError("operations", "the privileges of "+strings.Join(unknown, ", ")+" are not known")
+	}
+
+	privileges := make([]string, 0, len(set))
+	for privilege := range set {
+		privileges = append(privileges, privilege)
+	}
+	sort.Strings(privileges)
+	return privileges, nil
+}
+
+// EnsureApiRole makes sure the API role displayName exists with exactly the privileges needed for operations, as
+// returned by RequiredPrivileges, creating the role or replacing its privileges as necessary.
+func EnsureApiRole(ctx context.Context, roles ApiRolesService, displayName string, operations ...string) (*ApiRole, *Response, error) {
+	if roles == nil {
+		return nil, nil, NewArgError("roles", "cannot be nil")
+	} else if displayName == "" {
+		return nil, nil, NewArgError("displayName", "cannot be empty")
+	}
+
+	privileges, err := RequiredPrivileges(operations...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existing, resp, err := roles.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	for _, role := range existing {
+		if role.GetDisplayName() != displayName {
+			continue
+		}
+		if equalPrivileges(role.GetPrivileges(), privileges) {
+			return &role, resp, nil
+		}
+		return roles.Update(ctx, role.GetId().Int(), &ApiRoleUpdateRequest{DisplayName: displayName, Privileges: privileges})
+	}
+
+	return roles.Create(ctx, &ApiRoleCreateRequest{DisplayName: displayName, Privileges: privileges})
+}
+
+// equalPrivileges reports whether have holds the same privileges as the sorted want
+func equalPrivileges(have, want []string) bool {
+	if len(have) != len(want) {
+		return false
+	}
+	sorted := append([]string(nil), have...)
+	sort.Strings(sorted)
+	for i := range sorted {
+		if sorted[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
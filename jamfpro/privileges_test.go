@@ -0,0 +1,30 @@
+package jamfpro
+
+import (
+	"reflect"
+	"testing"
+)
+
+// unmappedOperations are the service operations left out of operationPrivileges on purpose, as the privileges they
+// need depend on their arguments. A service name stands for all of its methods.
+var unmappedOperations = map[string]bool{
+	"History":                   true,
+	"MdmCommands.Send":          true,
+	"MobileDeviceCommands.Send": true,
+}
+
+func TestEveryOperationHasPrivileges(t *testing.T) {
+	clientType := reflect.TypeOf(Client{})
+	for i := 0; i < clientType.NumField(); i++ {
+		field := clientType.Field(i)
+		if !field.IsExported() || field.Type.Kind() != reflect.Interface || unmappedOperations[field.Name] {
+			continue
+		}
+		for j := 0; j < field.Type.NumMethod(); j++ {
+			operation := field.Name + "." + field.Type.Method(j).Name
+			if _, ok := operationPrivileges[operation]; !ok && !unmappedOperations[operation] {
+				t.Errorf("the privileges of %s are not known", operation)
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package jamfpro
+
+import "sync"
+
+// ProgressReporter is told how a long operation over many items, such as a bulk run or an inventory export,
+// progresses, e.g. to draw a progress bar. The operations of this module call it through SyncProgress, so its
+// methods are never called concurrently and the reporter need not be safe for concurrent use itself.
+type ProgressReporter interface {
+	// OnStart is called once, before any item is reported, with the number of items the operation covers.
+	OnStart(total int)
+	// OnItem is called when an item succeeded, with the number of items finished so far, failures included.
+	OnItem(done int)
+	// OnError is called instead of OnItem when an item failed, with the number of items finished so far and why it
+	// failed.
+	OnError(done int, err error)
+	// OnFinish is called once, last, with the error that ended the operation early, or nil. It is called even if
+	// the operation failed before OnStart.
+	OnFinish(err error)
+}
+
+// SyncProgress returns a ProgressReporter that passes the calls on to r one at a time, so operations whose items
+// finish on several goroutines can report them without r being safe for concurrent use. A nil r is returned as a
+// reporter that ignores every call.
+func SyncProgress(r ProgressReporter) ProgressReporter {
+	if r == nil {
+		return noProgress{}
+	}
+	if _, ok := r.(*syncProgress); ok {
+		return r
+	}
+	return &syncProgress{r: r}
+}
+
+type syncProgress struct {
+	mu sync.Mutex
+	r  ProgressReporter
+}
+
+func (s *syncProgress) OnStart(total int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.r.OnStart(total)
+}
+
+func (s *syncProgress) OnItem(done int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.r.OnItem(done)
+}
+
+func (s *syncProgress) OnError(done int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.r.OnError(done, err)
+}
+
+func (s *syncProgress) OnFinish(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.r.OnFinish(err)
+}
+
+type noProgress struct{}
+
+func (noProgress) OnStart(int)        {}
+func (noProgress) OnItem(int)         {}
+func (noProgress) OnError(int, error) {}
+func (noProgress) OnFinish(error)     {}
@@ -0,0 +1,126 @@
+// Package prometheus exports the metrics of a jamfpro.Client to Prometheus.
+//
+//	metrics, err := prometheus.NewMetrics(prometheus.Options{Registerer: prom.DefaultRegisterer})
+//	client, err := jamfpro.NewClient(clientId, clientSecret, instance, "", jamfpro.WithMetrics(metrics))
+package prometheus
+
+import (
+	"strconv"
+
+	"github.com/jc0b/go-jamfpro-api/jamfpro"
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+const defaultNamespace = "jamfpro"
+
+// Options configures Metrics. The zero value names the metrics jamfpro_* and does not register them.
+type Options struct {
+	// Namespace prefixes the name of every metric. It defaults to jamfpro.
+	Namespace string
+
+	// Registerer, if set, is used to register the metrics.
+	Registerer prom.Registerer
+
+	// Buckets are the request latency histogram buckets, in seconds. They default to prometheus.DefBuckets.
+	Buckets []float64
+}
+
+// Metrics is a jamfpro.Metrics recording Prometheus metrics. It is a prometheus.Collector, so it can be registered
+// after it has been created as well.
+type Metrics struct {
+	requests       *prom.CounterVec
+	errors         *prom.CounterVec
+	latency        *prom.HistogramVec
+	tokenRefreshes prom.Counter
+	rateLimited    *prom.CounterVec
+}
+
+var (
+	_ jamfpro.Metrics = &Metrics{}
+	_ prom.Collector  = &Metrics{}
+)
+
+// NewMetrics creates the Prometheus metrics of a client, registering them if opts has a Registerer.
+func NewMetrics(opts Options) (*Metrics, error) {
+	namespace := opts.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	buckets := opts.Buckets
+	if buckets == nil {
+		buckets = prom.DefBuckets
+	}
+
+	m := &Metrics{
+		requests: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "API calls made, by resource, method and status code.",
+		}, []string{"resource", "method", "code"}),
+		errors: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: namespace,
+			Name:      "request_errors_total",
+			Help:      "API calls that failed, by resource and method.",
+		}, []string{"resource", "method"}),
+		latency: prom.NewHistogramVec(prom.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of API calls, by resource and method.",
+			Buckets:   buckets,
+		}, []string{"resource", "method"}),
+		tokenRefreshes: prom.NewCounter(prom.CounterOpts{
+			Namespace: namespace,
+			Name:      "token_refreshes_total",
+			Help:      "Bearer tokens issued to the client.",
+		}),
+		rateLimited: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: namespace,
+			Name:      "rate_limited_total",
+			Help:      "API calls rejected because of rate limiting, by resource.",
+		}, []string{"resource"}),
+	}
+
+	if opts.Registerer != nil {
+		if err := opts.Registerer.Register(m); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func (m *Metrics) ObserveRequest(observation jamfpro.RequestObservation) {
+	code := "none"
+	if observation.StatusCode != 0 {
+		code = strconv.Itoa(observation.StatusCode)
+	}
+
+	m.requests.WithLabelValues(observation.Resource, observation.Method, code).Inc()
+	m.latency.WithLabelValues(observation.Resource, observation.Method).Observe(observation.Duration.Seconds())
+	if observation.Err != nil {
+		m.errors.WithLabelValues(observation.Resource, observation.Method).Inc()
+	}
+}
+
+func (m *Metrics) TokenRefreshed() {
+	m.tokenRefreshes.Inc()
+}
+
+func (m *Metrics) RateLimited(resource string) {
+	m.rateLimited.WithLabelValues(resource).Inc()
+}
+
+func (m *Metrics) Describe(ch chan<- *prom.Desc) {
+	m.requests.Describe(ch)
+	m.errors.Describe(ch)
+	m.latency.Describe(ch)
+	m.tokenRefreshes.Describe(ch)
+	m.rateLimited.Describe(ch)
+}
+
+func (m *Metrics) Collect(ch chan<- prom.Metric) {
+	m.requests.Collect(ch)
+	m.errors.Collect(ch)
+	m.latency.Collect(ch)
+	m.tokenRefreshes.Collect(ch)
+	m.rateLimited.Collect(ch)
+}
@@ -0,0 +1,112 @@
+package jamfpro
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// ErrProtectedInstance is returned for a destructive request, such as a delete or an erase command, that was refused
+// because the instance is not on the allow-list given to WithRequireConfirmation.
+type ErrProtectedInstance struct {
+	Instance string
+	Method   string
+	Path     string
+}
+
+func (e *ErrProtectedInstance) Error() string {
+	return fmt.Sprintf("jamfpro: refusing %s %s on %s, which is not allowed destructive operations", e.Method, e.Path, e.Instance)
+}
+
+// ErrReadOnlyClient is returned for a request that would change the instance, such as a create, update or delete,
+// made with a client created with WithReadOnly.
+type ErrReadOnlyClient struct {
+	Method string
+	Path   string
+}
+
+func (e *ErrReadOnlyClient) Error() string {
+	return fmt.Sprintf("jamfpro: refusing %s %s, as the client is read-only", e.Method, e.Path)
+}
+
+// ReadOnly reports whether the client was created with WithReadOnly, and so refuses requests that change the instance.
+func (c *Client) ReadOnly() bool {
+	return c.readOnly
+}
+
+type readKey struct{}
+
+// withRead marks the requests made with ctx as reads, for requests that use POST without changing anything, such as
+// exports and connection tests, so that read-only clients still send them.
+func withRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readKey{}, true)
+}
+
+// checkReadOnly refuses the requests of a read-only client that are not reads.
+func (c *Client) checkReadOnly(ctx context.Context, req *http.Request) error {
+	if !c.readOnly {
+		return nil
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return nil
+	}
+	if ctx.Value(readKey{}) != nil {
+		return nil
+	}
+	return &ErrReadOnlyClient{Method: req.Method, Path: req.URL.Path}
+}
+
+type destructiveKey struct{}
+
+// withDestructive marks the requests made with ctx as destructive, for requests that are not deletes but cannot be
+// undone either, such as erasing a device.
+func withDestructive(ctx context.Context) context.Context {
+	return context.WithValue(ctx, destructiveKey{}, true)
+}
+
+type confirmedKey struct{}
+
+// ContextWithConfirmation returns a copy of ctx whose destructive requests are sent even if the instance is protected
+// by WithRequireConfirmation, for operations a person has explicitly confirmed.
+func ContextWithConfirmation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, confirmedKey{}, true)
+}
+
+// instanceGuard refuses destructive requests to instances whose host matches none of its patterns. A nil guard
+// allows every request.
+type instanceGuard struct {
+	patterns []string
+}
+
+func newInstanceGuard(patterns []string) (*instanceGuard, error) {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, NewArgError("patterns", fmt.Sprintf("%q is not a valid pattern", pattern))
+		}
+	}
+	return &instanceGuard{patterns: patterns}, nil
+}
+
+func (g *instanceGuard) check(ctx context.Context, instance *url.URL, req *http.Request) error {
+	if g == nil {
+		return nil
+	}
+	if req.Method != http.MethodDelete && ctx.Value(destructiveKey{}) == nil {
+		return nil
+	}
+	if ctx.Value(confirmedKey{}) != nil {
+		return nil
+	}
+
+	host := strings.ToLower(instance.Host)
+	for _, pattern := range g.patterns {
+		if ok, _ := path.Match(strings.ToLower(pattern), host); ok {
+			return nil
+		}
+	}
+	return &ErrProtectedInstance{Instance: instance.String(), Method: req.Method, Path: req.URL.Path}
+}
@@ -0,0 +1,122 @@
+package jamfpro
+
+import (
+	"context"
+	"time"
+)
+
+// purchasingDateFormat is the format purchase, warranty and lease dates are sent to the Jamf Pro API in
+const purchasingDateFormat = "2006-01-02"
+
+// Purchasing is the purchasing information of a computer or mobile device, as kept by procurement and asset
+// management systems. Nil fields are left unchanged by UpdatePurchasing.
+type Purchasing struct {
+	Purchased         *bool
+	Leased            *bool
+	PoNumber          *string
+	PoDate            *time.Time
+	Vendor            *string
+	AppleCareId       *string
+	PurchasePrice     *string
+	PurchasingAccount *string
+	PurchasingContact *string
+	WarrantyExpires   *time.Time
+	LeaseExpires      *time.Time
+	LifeExpectancy    *int
+}
+
+// UpdatePurchasing updates the purchasing information of a computer, and returns the updated inventory record.
+func (c *ComputersServiceOp) UpdatePurchasing(ctx context.Context, id int, purchasing Purchasing) (*ComputerInventory, *Response, error) {
+	return c.UpdateInventoryDetail(ctx, id, &ComputerInventoryUpdateRequest{
+		Purchasing: &ComputerInventoryPurchasingUpdate{
+			Purchased:         purchasing.Purchased,
+			Leased:            purchasing.Leased,
+			PoNumber:          purchasing.PoNumber,
+			PoDate:            formatPurchasingDate(purchasing.PoDate),
+			Vendor:            purchasing.Vendor,
+			AppleCareId:       purchasing.AppleCareId,
+			PurchasePrice:     purchasing.PurchasePrice,
+			PurchasingAccount: purchasing.PurchasingAccount,
+			PurchasingContact: purchasing.PurchasingContact,
+			WarrantyDate:      formatPurchasingDate(purchasing.WarrantyExpires),
+			LeaseDate:         formatPurchasingDate(purchasing.LeaseExpires),
+			LifeExpectancy:    purchasing.LifeExpectancy,
+		},
+	})
+}
+
+// UpdatePurchasing updates the purchasing information of a mobile device, and returns the updated inventory record.
+func (m *MobileDevicesInventoryServiceOp) UpdatePurchasing(ctx context.Context, id int, purchasing Purchasing) (*MobileDeviceInventory, *Response, error) {
+	return m.Update(ctx, id, &MobileDeviceInventoryUpdateRequest{
+		Purchasing: &MobileDeviceInventoryPurchasingUpdate{
+			Purchased:           purchasing.Purchased,
+			Leased:              purchasing.Leased,
+			PoNumber:            purchasing.PoNumber,
+			PoDate:              formatPurchasingDate(purchasing.PoDate),
+			Vendor:              purchasing.Vendor,
+			AppleCareId:         purchasing.AppleCareId,
+			PurchasePrice:       purchasing.PurchasePrice,
+			PurchasingAccount:   purchasing.PurchasingAccount,
+			PurchasingContact:   purchasing.PurchasingContact,
+			WarrantyExpiresDate: formatPurchasingDate(purchasing.WarrantyExpires),
+			LeaseExpiresDate:    formatPurchasingDate(purchasing.LeaseExpires),
+			LifeExpectancy:      purchasing.LifeExpectancy,
+		},
+	})
+}
+
+// Purchasing returns the purchasing information of a computer. Dates the server returned in an unexpected format
+// are left nil.
+func (p *ComputerInventoryPurchasing) Purchasing() Purchasing {
+	return Purchasing{
+		Purchased:         Bool(p.Purchased),
+		Leased:            Bool(p.Leased),
+		PoNumber:          String(p.PoNumber),
+		PoDate:            parsePurchasingDate(p.PoDate),
+		Vendor:            String(p.Vendor),
+		AppleCareId:       String(p.AppleCareId),
+		PurchasePrice:     String(p.PurchasePrice),
+		PurchasingAccount: String(p.PurchasingAccount),
+		PurchasingContact: String(p.PurchasingContact),
+		WarrantyExpires:   parsePurchasingDate(p.WarrantyDate),
+		LeaseExpires:      parsePurchasingDate(p.LeaseDate),
+		LifeExpectancy:    Int(p.LifeExpectancy),
+	}
+}
+
+// Purchasing returns the purchasing information of a mobile device. Dates the server returned in an unexpected format
+// are left nil.
+func (p *MobileDeviceInventoryPurchasing) Purchasing() Purchasing {
+	return Purchasing{
+		Purchased:         Bool(p.Purchased),
+		Leased:            Bool(p.Leased),
+		PoNumber:          String(p.PoNumber),
+		PoDate:            parsePurchasingDate(p.PoDate),
+		Vendor:            String(p.Vendor),
+		AppleCareId:       String(p.AppleCareId),
+		PurchasePrice:     String(p.PurchasePrice),
+		PurchasingAccount: String(p.PurchasingAccount),
+		PurchasingContact: String(p.PurchasingContact),
+		WarrantyExpires:   parsePurchasingDate(p.WarrantyExpiresDate),
+		LeaseExpires:      parsePurchasingDate(p.LeaseExpiresDate),
+		LifeExpectancy:    Int(p.LifeExpectancy),
+	}
+}
+
+func formatPurchasingDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	return String(t.Format(purchasingDateFormat))
+}
+
+func parsePurchasingDate(s string) *time.Time {
+	if len(s) < len(purchasingDateFormat) {
+		return nil
+	}
+	t, err := time.Parse(purchasingDateFormat, s[:len(purchasingDateFormat)])
+	if err != nil {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,123 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const (
+	pushCertificateBasePath      = "uapi/v1/push-certificate"
+	apnsClientPushStatusBasePath = "uapi/v1/apns-client-push-status"
+)
+
+type PushCertificateService interface {
+	Get(context.Context) (*PushCertificate, *Response, error)
+	Verify(context.Context) (*PushCertificateVerification, *Response, error)
+	ListClientPushStatus(context.Context, *ListOptions) ([]ApnsClientPushStatus, *Response, error)
+}
+
+// PushCertificateServiceOp handles communication with the APNs push certificate
+// methods of the Jamf Pro API.
+type PushCertificateServiceOp struct {
+	client *Client
+}
+
+var _ PushCertificateService = &PushCertificateServiceOp{}
+
+// PushCertificate represents the Apple Push Notification service certificate Jamf Pro uses to reach managed devices.
+// Devices stop receiving MDM commands when it expires, and renewing it with a different Apple ID forces every device
+// to re-enroll, so AppleId should be recorded alongside it.
+type PushCertificate struct {
+	Subject        string    `json:"subject"`
+	Topic          string    `json:"topic"`
+	AppleId        string    `json:"appleId"`
+	SerialNumber   string    `json:"serialNumber"`
+	ExpirationDate time.Time `json:"expirationDate"`
+}
+
+// ExpiresWithin reports whether the certificate expires within d from now, or has already expired.
+func (p *PushCertificate) ExpiresWithin(d time.Duration) bool {
+	return time.Until(p.ExpirationDate) <= d
+}
+
+// Expired reports whether the certificate has expired.
+func (p *PushCertificate) Expired() bool {
+	return p.ExpiresWithin(0)
+}
+
+// PushCertificateVerification is the result of asking Jamf Pro to verify its push certificate with Apple.
+type PushCertificateVerification struct {
+	Valid  bool     `json:"valid"`
+	Errors []string `json:"errors"`
+}
+
+// ApnsClientPushStatus reports whether Jamf Pro can send push notifications to a device, typically false when the
+// device's push token is missing or invalid.
+type ApnsClientPushStatus struct {
+	DeviceType   string `json:"deviceType"`
+	DeviceId     ID     `json:"deviceId"`
+	Status       string `json:"status"`
+	StatusReason string `json:"statusReason"`
+}
+
+// ApnsClientPushStatusListResponse represents the raw API response to getting the push status of devices
+type ApnsClientPushStatusListResponse struct {
+	TotalCount int                    `json:"totalCount"`
+	Results    []ApnsClientPushStatus `json:"results"`
+}
+
+func (p *PushCertificateServiceOp) Get(ctx context.Context) (*PushCertificate, *Response, error) {
+	req, err := p.client.NewRequest(ctx, http.MethodGet, pushCertificateBasePath, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var certificate PushCertificate
+	resp, err := p.client.Do(ctx, req, &certificate)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &certificate, resp, err
+}
+
+// Verify has Jamf Pro check its push certificate with Apple, which catches certificates that were revoked or renewed
+// with a different Apple ID before devices stop responding.
+func (p *PushCertificateServiceOp) Verify(ctx context.Context) (*PushCertificateVerification, *Response, error) {
+	path := pushCertificateBasePath + "/verify"
+
+	req, err := p.client.NewRequest(ctx, http.MethodPost, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var verification PushCertificateVerification
+	resp, err := p.client.Do(ctx, req, &verification)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &verification, resp, err
+}
+
+// ListClientPushStatus returns the devices Jamf Pro cannot currently send push notifications to.
+func (p *PushCertificateServiceOp) ListClientPushStatus(ctx context.Context, opts *ListOptions) ([]ApnsClientPushStatus, *Response, error) {
+	path, err := addOptions(apnsClientPushStatusBasePath, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := p.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var statusResponse ApnsClientPushStatusListResponse
+	resp, err := p.client.Do(ctx, req, &statusResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return statusResponse.Results, resp, err
+}
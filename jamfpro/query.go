@@ -0,0 +1,101 @@
+package jamfpro
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// QueryEncoder is implemented by the option structs of list and export methods, which add their parameters to the
+// query string of the request. A nil pointer adds nothing.
+type QueryEncoder interface {
+	EncodeQuery(*QueryParams)
+}
+
+// QueryParams builds a query string. Parameters are encoded in the order they were added, so the same options always
+// produce the same URL. The String, Int and Strings methods leave out zero values, like an omitempty tag would.
+type QueryParams struct {
+	params []queryParam
+}
+
+type queryParam struct {
+	key   string
+	value string
+}
+
+// Set adds the parameter key with value, even if value is empty.
+func (q *QueryParams) Set(key, value string) *QueryParams {
+	q.params = append(q.params, queryParam{key: key, value: value})
+	return q
+}
+
+// String adds the parameter key with value, unless value is empty.
+func (q *QueryParams) String(key, value string) *QueryParams {
+	if value == "" {
+		return q
+	}
+	return q.Set(key, value)
+}
+
+// Int adds the parameter key with value, unless value is 0.
+func (q *QueryParams) Int(key string, value int) *QueryParams {
+	if value == 0 {
+		return q
+	}
+	return q.Set(key, strconv.Itoa(value))
+}
+
+// Strings adds the parameter key once for every value, as Jamf Pro expects for lists such as the inventory
+// sections.
+func (q *QueryParams) Strings(key string, values []string) *QueryParams {
+	for _, value := range values {
+		q.Set(key, value)
+	}
+	return q
+}
+
+// Len returns the number of parameters added.
+func (q *QueryParams) Len() int {
+	return len(q.params)
+}
+
+// Encode returns the parameters in URL encoded form, e.g. "page=1&page-size=100".
+func (q *QueryParams) Encode() string {
+	if len(q.params) == 0 {
+		return ""
+	}
+
+	size := len(q.params) - 1
+	for _, p := range q.params {
+		size += len(p.key) + len(p.value) + 1
+	}
+	var b strings.Builder
+	b.Grow(size)
+	for i, p := range q.params {
+		if i > 0 {
+			b.WriteByte('&')
+		}
+		b.WriteString(url.QueryEscape(p.key))
+		b.WriteByte('=')
+		b.WriteString(url.QueryEscape(p.value))
+	}
+	return b.String()
+}
+
+// addOptions appends the query parameters of opt to the path s.
+func addOptions(s string, opt QueryEncoder) (string, error) {
+	if opt == nil {
+		return s, nil
+	}
+
+	var q QueryParams
+	opt.EncodeQuery(&q)
+	if q.Len() == 0 {
+		return s, nil
+	}
+
+	if strings.Contains(s, "?") {
+		return s + "&" + q.Encode(), nil
+	}
+	return s + "?" + q.Encode(), nil
+}
@@ -0,0 +1,90 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+)
+
+const reenrollmentBasePath = "uapi/v1/reenrollment"
+
+// What happens to the MDM command queue of a device when it re-enrolls
+const (
+	ReenrollmentFlushMdmQueueDeleteNothing                      = "DELETE_NOTHING"
+	ReenrollmentFlushMdmQueueDeleteErrors                       = "DELETE_ERRORS"
+	ReenrollmentFlushMdmQueueDeleteEverythingExceptAcknowledged = "DELETE_EVERYTHING_EXCEPT_ACKNOWLEDGED"
+	ReenrollmentFlushMdmQueueDeleteEverything                   = "DELETE_EVERYTHING"
+)
+
+type ReenrollmentSettingsService interface {
+	Get(context.Context) (*ReenrollmentSettings, *Response, error)
+	Update(context.Context, *ReenrollmentSettings) (*ReenrollmentSettings, *Response, error)
+	ListHistory(context.Context, *ListOptions) ([]HistoryEntry, *Response, error)
+	AddHistoryNote(context.Context, string) (*HistoryNoteResponse, *Response, error)
+}
+
+// ReenrollmentSettingsServiceOp handles communication with the re-enrollment settings
+// methods of the Jamf Pro API.
+type ReenrollmentSettingsServiceOp struct {
+	client *Client
+}
+
+var _ ReenrollmentSettingsService = &ReenrollmentSettingsServiceOp{}
+
+// ReenrollmentSettings represents what Jamf Pro clears from a device record when the device re-enrolls
+type ReenrollmentSettings struct {
+	IsFlushPolicyHistoryEnabled              bool   `json:"isFlushPolicyHistoryEnabled"`
+	IsFlushLocationInformationEnabled        bool   `json:"isFlushLocationInformationEnabled"`
+	IsFlushLocationInformationHistoryEnabled bool   `json:"isFlushLocationInformationHistoryEnabled"`
+	IsFlushExtensionAttributesEnabled        bool   `json:"isFlushExtensionAttributesEnabled"`
+	FlushMdmQueue                            string `json:"flushMDMQueue"`
+}
+
+func (r *ReenrollmentSettingsServiceOp) Get(ctx context.Context) (*ReenrollmentSettings, *Response, error) {
+	req, err := r.client.NewRequest(ctx, http.MethodGet, reenrollmentBasePath, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var settings ReenrollmentSettings
+	resp, err := r.client.Do(ctx, req, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &settings, resp, err
+}
+
+func (r *ReenrollmentSettingsServiceOp) Update(ctx context.Context, settings *ReenrollmentSettings) (*ReenrollmentSettings, *Response, error) {
+	if settings == nil {
+		return nil, nil, NewArgError("settings", "cannot be nil")
+	}
+	switch settings.FlushMdmQueue {
+	case ReenrollmentFlushMdmQueueDeleteNothing, ReenrollmentFlushMdmQueueDeleteErrors,
+		ReenrollmentFlushMdmQueueDeleteEverythingExceptAcknowledged, ReenrollmentFlushMdmQueueDeleteEverything:
+	default:
+		return nil, nil, NewArgError("FlushMdmQueue", "must be one of the ReenrollmentFlushMdmQueue constants")
+	}
+
+	req, err := r.client.NewRequest(ctx, http.MethodPut, reenrollmentBasePath, settings, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated ReenrollmentSettings
+	resp, err := r.client.Do(ctx, req, &updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &updated, resp, err
+}
+
+// ListHistory returns the change history of the re-enrollment settings.
+func (r *ReenrollmentSettingsServiceOp) ListHistory(ctx context.Context, opts *ListOptions) ([]HistoryEntry, *Response, error) {
+	return listHistory(ctx, r.client, reenrollmentBasePath+"/history", opts)
+}
+
+// AddHistoryNote adds a note to the change history of the re-enrollment settings.
+func (r *ReenrollmentSettingsServiceOp) AddHistoryNote(ctx context.Context, note string) (*HistoryNoteResponse, *Response, error) {
+	return addHistoryNote(ctx, r.client, reenrollmentBasePath+"/history", note)
+}
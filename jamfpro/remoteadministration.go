@@ -0,0 +1,383 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+)
+
+const (
+	remoteAdministrationBasePath = "uapi/v1/remote-administration-configurations"
+	teamViewerBasePath           = remoteAdministrationBasePath + "/team-viewer"
+)
+
+// Remote administration configuration types
+const (
+	RemoteAdministrationTypeTeamViewer = "TEAM_VIEWER"
+)
+
+// Remote administration session device types
+const (
+	RemoteAdministrationDeviceComputer     = "COMPUTER"
+	RemoteAdministrationDeviceMobileDevice = "MOBILE_DEVICE"
+)
+
+// RemoteAdministrationService manages the TeamViewer remote administration configurations that Jamf Remote Assist
+// sessions are started through, and the sessions themselves.
+type RemoteAdministrationService interface {
+	List(context.Context, *ListOptions) ([]RemoteAdministrationConfiguration, *Response, error)
+	GetByID(context.Context, string) (*TeamViewerConfiguration, *Response, error)
+	Create(context.Context, *TeamViewerConfigurationCreateRequest) (*TeamViewerConfiguration, *Response, error)
+	Update(context.Context, string, *TeamViewerConfigurationUpdateRequest) (*TeamViewerConfiguration, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+	GetStatus(context.Context, string) (*TeamViewerConfigurationStatus, *Response, error)
+	ListSessions(context.Context, string, *ListOptions) ([]TeamViewerSession, *Response, error)
+	GetSession(context.Context, string, string) (*TeamViewerSession, *Response, error)
+	CreateSession(context.Context, string, *TeamViewerSessionCreateRequest) (*TeamViewerSession, *Response, error)
+	CloseSession(context.Context, string, string) (*Response, error)
+	ResendSessionNotification(context.Context, string, string) (*Response, error)
+	GetSessionStatus(context.Context, string, string) (*TeamViewerSessionStatus, *Response, error)
+}
+
+// RemoteAdministrationServiceOp handles communication with the remote administration related
+// methods of the Jamf Pro API.
+type RemoteAdministrationServiceOp struct {
+	client *Client
+}
+
+var _ RemoteAdministrationService = &RemoteAdministrationServiceOp{}
+
+// RemoteAdministrationConfiguration is a summary of a remote administration configuration of any type
+type RemoteAdministrationConfiguration struct {
+	Id          ID     `json:"id"`
+	SiteId      ID     `json:"siteId"`
+	DisplayName string `json:"displayName"`
+	Type        string `json:"type"`
+}
+
+// RemoteAdministrationConfigurationListResponse represents the raw API response to listing remote administration
+// configurations
+type RemoteAdministrationConfigurationListResponse struct {
+	TotalCount     *int64                               `json:"totalCount"`
+	Configurations *[]RemoteAdministrationConfiguration `json:"results"`
+}
+
+// TeamViewerConfiguration is a connection between Jamf Pro and a TeamViewer account
+type TeamViewerConfiguration struct {
+	Id             ID     `json:"id"`
+	SiteId         ID     `json:"siteId"`
+	DisplayName    string `json:"displayName"`
+	Enabled        bool   `json:"enabled"`
+	SessionTimeout int    `json:"sessionTimeout"`
+	ScriptToken    string `json:"scriptToken,omitempty"`
+}
+
+// TeamViewerConfigurationCreateRequest represents a request to connect a TeamViewer account.
+type TeamViewerConfigurationCreateRequest struct {
+	SiteId         ID     `json:"siteId"`
+	DisplayName    string `json:"displayName"`
+	ScriptToken    string `json:"scriptToken"`
+	Enabled        bool   `json:"enabled"`
+	SessionTimeout int    `json:"sessionTimeout"`
+}
+
+// TeamViewerConfigurationUpdateRequest represents a request to change a TeamViewer configuration. Nil fields are left
+// unchanged, so setting only Enabled toggles whether Remote Assist sessions can be started.
+type TeamViewerConfigurationUpdateRequest struct {
+	DisplayName    *string `json:"displayName,omitempty"`
+	ScriptToken    *string `json:"scriptToken,omitempty"`
+	Enabled        *bool   `json:"enabled,omitempty"`
+	SessionTimeout *int    `json:"sessionTimeout,omitempty"`
+}
+
+// TeamViewerConfigurationStatus reports whether Jamf Pro can reach TeamViewer with a configuration
+type TeamViewerConfigurationStatus struct {
+	ConnectionStatus       string `json:"connectionStatus"`
+	ConnectionStatusReason string `json:"connectionStatusReason"`
+}
+
+// TeamViewerSession is a remote administration session with a computer or mobile device
+type TeamViewerSession struct {
+	Id              ID     `json:"id"`
+	Code            string `json:"code"`
+	Description     string `json:"description"`
+	SupporterLink   string `json:"supporterLink"`
+	DeviceId        ID     `json:"deviceId"`
+	DeviceName      string `json:"deviceName"`
+	DeviceType      string `json:"deviceType"`
+	State           string `json:"state"`
+	CreatorId       ID     `json:"creatorId"`
+	CreatorName     string `json:"creatorName"`
+	ConfigurationId ID     `json:"configurationId"`
+	CreatedAt       string `json:"createdAt,omitempty"`
+	ClosedAt        string `json:"closedAt,omitempty"`
+}
+
+// TeamViewerSessionListResponse represents the raw API response to listing the sessions of a TeamViewer configuration
+type TeamViewerSessionListResponse struct {
+	TotalCount *int64               `json:"totalCount"`
+	Sessions   *[]TeamViewerSession `json:"results"`
+}
+
+// TeamViewerSessionCreateRequest represents a request to start a remote administration session with a device.
+type TeamViewerSessionCreateRequest struct {
+	DeviceId    ID     `json:"deviceId"`
+	DeviceType  string `json:"deviceType"`
+	Description string `json:"description,omitempty"`
+	// NotifyEndUser asks the device to show a notification inviting the user to join the session.
+	NotifyEndUser bool `json:"notifyEndUser"`
+}
+
+// TeamViewerSessionStatus is the state of a remote administration session
+type TeamViewerSessionStatus struct {
+	State string `json:"state"`
+}
+
+func (r *RemoteAdministrationServiceOp) List(ctx context.Context, opts *ListOptions) ([]RemoteAdministrationConfiguration, *Response, error) {
+	path, err := addOptions(remoteAdministrationBasePath, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := r.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var configurationResponse RemoteAdministrationConfigurationListResponse
+	resp, err := r.client.Do(ctx, req, &configurationResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if configurationResponse.Configurations == nil {
+		return nil, resp, err
+	}
+
+	return *configurationResponse.Configurations, resp, err
+}
+
+func (r *RemoteAdministrationServiceOp) GetByID(ctx context.Context, id string) (*TeamViewerConfiguration, *Response, error) {
+	if id == "" {
+		return nil, nil, NewArgError("id", "cannot be empty")
+	}
+
+	req, err := r.client.NewRequest(ctx, http.MethodGet, pathJoin(teamViewerBasePath, id), nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var configuration TeamViewerConfiguration
+	resp, err := r.client.Do(ctx, req, &configuration)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &configuration, resp, err
+}
+
+func (r *RemoteAdministrationServiceOp) Create(ctx context.Context, request *TeamViewerConfigurationCreateRequest) (*TeamViewerConfiguration, *Response, error) {
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	req, err := r.client.NewRequest(ctx, http.MethodPost, teamViewerBasePath, request, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var configuration TeamViewerConfiguration
+	resp, err := r.client.Do(ctx, req, &configuration)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &configuration, resp, err
+}
+
+// Update changes the fields of a TeamViewer configuration that are set in request.
+func (r *RemoteAdministrationServiceOp) Update(ctx context.Context, id string, request *TeamViewerConfigurationUpdateRequest) (*TeamViewerConfiguration, *Response, error) {
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if id == "" {
+		return nil, nil, NewArgError("id", "cannot be empty")
+	}
+
+	req, err := r.client.NewRequest(ctx, http.MethodPatch, pathJoin(teamViewerBasePath, id), request, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var configuration TeamViewerConfiguration
+	resp, err := r.client.Do(ctx, req, &configuration)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &configuration, resp, err
+}
+
+func (r *RemoteAdministrationServiceOp) Delete(ctx context.Context, id string) (*Response, error) {
+	if id == "" {
+		return nil, NewArgError("id", "cannot be empty")
+	}
+
+	req, err := r.client.NewRequest(ctx, http.MethodDelete, pathJoin(teamViewerBasePath, id), nil, "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// GetStatus checks the connection between Jamf Pro and TeamViewer for a configuration.
+func (r *RemoteAdministrationServiceOp) GetStatus(ctx context.Context, id string) (*TeamViewerConfigurationStatus, *Response, error) {
+	if id == "" {
+		return nil, nil, NewArgError("id", "cannot be empty")
+	}
+
+	req, err := r.client.NewRequest(ctx, http.MethodGet, pathJoin(teamViewerBasePath, id, "status"), nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var status TeamViewerConfigurationStatus
+	resp, err := r.client.Do(ctx, req, &status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &status, resp, err
+}
+
+// ListSessions lists the sessions started through a TeamViewer configuration, for auditing who connected to which
+// device and when.
+func (r *RemoteAdministrationServiceOp) ListSessions(ctx context.Context, configurationId string, opts *ListOptions) ([]TeamViewerSession, *Response, error) {
+	if configurationId == "" {
+		return nil, nil, NewArgError("configurationId", "cannot be empty")
+	}
+
+	path, err := addOptions(teamViewerSessionsPath(configurationId), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := r.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sessionResponse TeamViewerSessionListResponse
+	resp, err := r.client.Do(ctx, req, &sessionResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if sessionResponse.Sessions == nil {
+		return nil, resp, err
+	}
+
+	return *sessionResponse.Sessions, resp, err
+}
+
+func (r *RemoteAdministrationServiceOp) GetSession(ctx context.Context, configurationId, sessionId string) (*TeamViewerSession, *Response, error) {
+	if configurationId == "" {
+		return nil, nil, NewArgError("configurationId", "cannot be empty")
+	} else if sessionId == "" {
+		return nil, nil, NewArgError("sessionId", "cannot be empty")
+	}
+
+	req, err := r.client.NewRequest(ctx, http.MethodGet, pathJoin(teamViewerSessionsPath(configurationId), sessionId), nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var session TeamViewerSession
+	resp, err := r.client.Do(ctx, req, &session)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &session, resp, err
+}
+
+// CreateSession starts a remote administration session with a device through a TeamViewer configuration.
+func (r *RemoteAdministrationServiceOp) CreateSession(ctx context.Context, configurationId string, request *TeamViewerSessionCreateRequest) (*TeamViewerSession, *Response, error) {
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	} else if configurationId == "" {
+		return nil, nil, NewArgError("configurationId", "cannot be empty")
+	}
+
+	req, err := r.client.NewRequest(ctx, http.MethodPost, teamViewerSessionsPath(configurationId), request, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var session TeamViewerSession
+	resp, err := r.client.Do(ctx, req, &session)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &session, resp, err
+}
+
+// CloseSession ends a remote administration session.
+func (r *RemoteAdministrationServiceOp) CloseSession(ctx context.Context, configurationId, sessionId string) (*Response, error) {
+	return r.sessionAction(ctx, configurationId, sessionId, "close")
+}
+
+// ResendSessionNotification shows the invitation to join a session on the device again.
+func (r *RemoteAdministrationServiceOp) ResendSessionNotification(ctx context.Context, configurationId, sessionId string) (*Response, error) {
+	return r.sessionAction(ctx, configurationId, sessionId, "resend-notification")
+}
+
+func (r *RemoteAdministrationServiceOp) GetSessionStatus(ctx context.Context, configurationId, sessionId string) (*TeamViewerSessionStatus, *Response, error) {
+	if configurationId == "" {
+		return nil, nil, NewArgError("configurationId", "cannot be empty")
+	} else if sessionId == "" {
+		return nil, nil, NewArgError("sessionId", "cannot be empty")
+	}
+
+	req, err := r.client.NewRequest(ctx, http.MethodGet, pathJoin(teamViewerSessionsPath(configurationId), sessionId, "status"), nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var status TeamViewerSessionStatus
+	resp, err := r.client.Do(ctx, req, &status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &status, resp, err
+}
+
+func (r *RemoteAdministrationServiceOp) sessionAction(ctx context.Context, configurationId, sessionId, action string) (*Response, error) {
+	if configurationId == "" {
+		return nil, NewArgError("configurationId", "cannot be empty")
+	} else if sessionId == "" {
+		return nil, NewArgError("sessionId", "cannot be empty")
+	}
+
+	req, err := r.client.NewRequest(ctx, http.MethodPost, pathJoin(teamViewerSessionsPath(configurationId), sessionId, action), nil, "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+func teamViewerSessionsPath(configurationId string) string {
+	return pathJoin(teamViewerBasePath, configurationId, "sessions")
+}
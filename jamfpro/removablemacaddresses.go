@@ -0,0 +1,168 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+)
+
+const removableMacAddressesBasePath = "JSSResource/removablemacaddresses"
+
+type RemovableMacAddressesService interface {
+	List(context.Context) ([]RemovableMacAddressListItem, *Response, error)
+	GetByID(context.Context, int) (*RemovableMacAddress, *Response, error)
+	GetByName(context.Context, string) (*RemovableMacAddress, *Response, error)
+	Create(context.Context, *RemovableMacAddressRequest) (*RemovableMacAddress, *Response, error)
+	Update(context.Context, int, *RemovableMacAddressRequest) (*RemovableMacAddress, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+}
+
+// RemovableMacAddressesServiceOp handles communication with the removable MAC address-related
+// methods of the Jamf Pro API.
+type RemovableMacAddressesServiceOp struct {
+	client *Client
+}
+
+var _ RemovableMacAddressesService = &RemovableMacAddressesServiceOp{}
+
+// RemovableMacAddress represents a Jamf Pro removable MAC address, i.e. a MAC address (such as a USB Ethernet adapter) that is excluded from device matching
+type RemovableMacAddress struct {
+	XMLName xml.Name `xml:"removable_mac_address"`
+	Id      ID       `xml:"id"`
+	Name    string   `xml:"name"`
+}
+
+// RemovableMacAddressListItem is the summary of a removable MAC address returned when listing all removable MAC addresses
+type RemovableMacAddressListItem struct {
+	Id   ID     `xml:"id"`
+	Name string `xml:"name"`
+}
+
+// RemovableMacAddressListResponse represents the raw API response to getting all removable MAC addresses
+type RemovableMacAddressListResponse struct {
+	XMLName               xml.Name                      `xml:"removable_mac_addresses"`
+	Size                  int                           `xml:"size"`
+	RemovableMacAddresses []RemovableMacAddressListItem `xml:"removable_mac_address"`
+}
+
+// RemovableMacAddressRequest represents a request to create or update a removable MAC address.
+type RemovableMacAddressRequest struct {
+	XMLName xml.Name `xml:"removable_mac_address"`
+	Name    string   `xml:"name"`
+}
+
+type RemovableMacAddressResponse struct {
+	Id ID `xml:"id"`
+}
+
+func (r *RemovableMacAddressesServiceOp) List(ctx context.Context) ([]RemovableMacAddressListItem, *Response, error) {
+	return r.list(ctx)
+}
+
+func (r *RemovableMacAddressesServiceOp) GetByID(ctx context.Context, id int) (*RemovableMacAddress, *Response, error) {
+	path := removableMacAddressesBasePath + "/id/" + strconv.Itoa(id)
+	return r.get(ctx, path)
+}
+
+func (r *RemovableMacAddressesServiceOp) GetByName(ctx context.Context, name string) (*RemovableMacAddress, *Response, error) {
+	path := pathJoin(removableMacAddressesBasePath, "name", name)
+	return r.get(ctx, path)
+}
+
+// Create creates a removable MAC address in Jamf Pro and returns the record as stored by the server.
+func (r *RemovableMacAddressesServiceOp) Create(ctx context.Context, request *RemovableMacAddressRequest) (*RemovableMacAddress, *Response, error) {
+	path := removableMacAddressesBasePath + "/id/0"
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	req, err := r.client.NewRequest(ctx, http.MethodPost, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	removableMacAddressCreation := new(RemovableMacAddressResponse)
+	resp, err := r.client.Do(ctx, req, removableMacAddressCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, removableMacAddressCreation.Id, r.GetByID)
+}
+
+// Update updates a removable MAC address in Jamf Pro and returns the record as stored by the server.
+func (r *RemovableMacAddressesServiceOp) Update(ctx context.Context, id int, request *RemovableMacAddressRequest) (*RemovableMacAddress, *Response, error) {
+	path := removableMacAddressesBasePath + "/id/" + strconv.Itoa(id)
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if id == 0 {
+		return nil, nil, NewArgError("removable MAC address ID", "cannot be 0")
+	}
+
+	req, err := r.client.NewRequest(ctx, http.MethodPut, path, request, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	removableMacAddressUpdate := new(RemovableMacAddressResponse)
+	resp, err := r.client.Do(ctx, req, removableMacAddressUpdate)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+func (r *RemovableMacAddressesServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := removableMacAddressesBasePath + "/id/" + strconv.Itoa(id)
+
+	req, err := r.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DeleteByName deletes the removable MAC address called name. The error matches ErrNotFound if there is none.
+func (r *RemovableMacAddressesServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "removable MAC address", name, r.GetByName, func(a *RemovableMacAddress) ID { return a.Id }, r.Delete)
+}
+
+func (r *RemovableMacAddressesServiceOp) get(ctx context.Context, path string) (*RemovableMacAddress, *Response, error) {
+	req, err := r.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var removableMacAddress RemovableMacAddress
+	resp, err := r.client.Do(ctx, req, &removableMacAddress)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &removableMacAddress, resp, err
+}
+
+func (r *RemovableMacAddressesServiceOp) list(ctx context.Context) ([]RemovableMacAddressListItem, *Response, error) {
+	path := removableMacAddressesBasePath
+	req, err := r.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var removableMacAddressResponse RemovableMacAddressListResponse
+	resp, err := r.client.Do(ctx, req, &removableMacAddressResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return removableMacAddressResponse.RemovableMacAddresses, resp, err
+}
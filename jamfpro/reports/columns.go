@@ -0,0 +1,117 @@
+package reports
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jc0b/go-jamfpro-api/jamfpro"
+)
+
+// Columns for the commonly exported inventory fields. A section Jamf Pro did not return leaves its columns empty.
+var (
+	ComputerID = Column{Name: "id", Value: func(c *jamfpro.ComputerInventory) string {
+		return c.Id.String()
+	}}
+	UDID = Column{Name: "udid", Value: func(c *jamfpro.ComputerInventory) string {
+		return c.Udid
+	}}
+	ComputerName = generalColumn("name", func(g *jamfpro.ComputerInventoryGeneral) string {
+		return g.Name
+	})
+	AssetTag = generalColumn("asset_tag", func(g *jamfpro.ComputerInventoryGeneral) string {
+		return g.AssetTag
+	})
+	LastContactTime = generalColumn("last_contact_time", func(g *jamfpro.ComputerInventoryGeneral) string {
+		return g.LastContactTime
+	})
+	LastIPAddress = generalColumn("last_ip_address", func(g *jamfpro.ComputerInventoryGeneral) string {
+		return g.LastIpAddress
+	})
+	Managed = generalColumn("managed", func(g *jamfpro.ComputerInventoryGeneral) string {
+		return strconv.FormatBool(g.RemoteManagement.Managed)
+	})
+	Site = generalColumn("site", func(g *jamfpro.ComputerInventoryGeneral) string {
+		return g.Site.Name
+	})
+	SerialNumber = hardwareColumn("serial_number", func(h *jamfpro.ComputerInventoryHardware) string {
+		return h.SerialNumber
+	})
+	Model = hardwareColumn("model", func(h *jamfpro.ComputerInventoryHardware) string {
+		return h.Model
+	})
+	ModelIdentifier = hardwareColumn("model_identifier", func(h *jamfpro.ComputerInventoryHardware) string {
+		return h.ModelIdentifier
+	})
+	OSVersion = operatingSystemColumn("os_version", func(o *jamfpro.ComputerInventoryOperatingSystem) string {
+		return o.Version
+	})
+	OSBuild = operatingSystemColumn("os_build", func(o *jamfpro.ComputerInventoryOperatingSystem) string {
+		return o.Build
+	})
+	Username = userAndLocationColumn("username", func(u *jamfpro.ComputerInventoryUserAndLocation) string {
+		return u.Username
+	})
+	FileVaultStatus = operatingSystemColumn("filevault_status", func(o *jamfpro.ComputerInventoryOperatingSystem) string {
+		return o.FileVault2Status
+	})
+)
+
+// DefaultColumns are exported when Options has no Columns
+var DefaultColumns = []Column{ComputerID, ComputerName, SerialNumber, Model, OSVersion, Username, LastContactTime}
+
+// ExtensionAttributeColumn is a column holding the value of the extension attribute called name. Multiple values are
+// separated by commas.
+func ExtensionAttributeColumn(name string) Column {
+	return Column{
+		Name:     name,
+		Sections: []string{jamfpro.ComputerInventorySectionExtensionAttributes},
+		Value: func(c *jamfpro.ComputerInventory) string {
+			for _, attribute := range c.ExtensionAttributes {
+				if attribute.Name == name {
+					return strings.Join(attribute.Values, ",")
+				}
+			}
+			return ""
+		},
+	}
+}
+
+func generalColumn(name string, value func(*jamfpro.ComputerInventoryGeneral) string) Column {
+	return Column{Name: name, Sections: []string{jamfpro.ComputerInventorySectionGeneral},
+		Value: func(c *jamfpro.ComputerInventory) string {
+			if c.General == nil {
+				return ""
+			}
+			return value(c.General)
+		}}
+}
+
+func hardwareColumn(name string, value func(*jamfpro.ComputerInventoryHardware) string) Column {
+	return Column{Name: name, Sections: []string{jamfpro.ComputerInventorySectionHardware},
+		Value: func(c *jamfpro.ComputerInventory) string {
+			if c.Hardware == nil {
+				return ""
+			}
+			return value(c.Hardware)
+		}}
+}
+
+func operatingSystemColumn(name string, value func(*jamfpro.ComputerInventoryOperatingSystem) string) Column {
+	return Column{Name: name, Sections: []string{jamfpro.ComputerInventorySectionOperatingSystem},
+		Value: func(c *jamfpro.ComputerInventory) string {
+			if c.OperatingSystem == nil {
+				return ""
+			}
+			return value(c.OperatingSystem)
+		}}
+}
+
+func userAndLocationColumn(name string, value func(*jamfpro.ComputerInventoryUserAndLocation) string) Column {
+	return Column{Name: name, Sections: []string{jamfpro.ComputerInventorySectionUserAndLocation},
+		Value: func(c *jamfpro.ComputerInventory) string {
+			if c.UserAndLocation == nil {
+				return ""
+			}
+			return value(c.UserAndLocation)
+		}}
+}
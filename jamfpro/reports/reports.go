@@ -0,0 +1,256 @@
+// Package reports exports Jamf Pro inventory to CSV or JSON lines, streaming it page by page so that fleets of any
+// size can be exported with constant memory.
+//
+//	err := reports.ExportComputers(ctx, client.Computers, os.Stdout, reports.Options{
+//		Format:  reports.CSV,
+//		Columns: []reports.Column{reports.ComputerName, reports.SerialNumber, reports.OSVersion},
+//	})
+package reports
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jc0b/go-jamfpro-api/jamfpro"
+)
+
+const (
+	defaultPageSize    = 200
+	defaultConcurrency = 4
+)
+
+// Format is the file format of an export
+type Format string
+
+const (
+	// CSV writes a header row with the column names, followed by a row per computer.
+	CSV Format = "csv"
+	// JSONLines writes a JSON object per computer and line, keyed by column name.
+	JSONLines Format = "jsonl"
+)
+
+// Column is a column of an export. Value is only passed the inventory sections listed in Sections.
+type Column struct {
+	Name     string
+	Sections []string
+	Value    func(*jamfpro.ComputerInventory) string
+}
+
+// Options configures an export. The zero value writes the DefaultColumns as CSV.
+type Options struct {
+	Format  Format
+	Columns []Column
+
+	// Filter restricts the export to the computers matching an RSQL expression, e.g. general.platform=="Mac".
+	Filter string
+
+	// PageSize is the number of computers fetched per request. It defaults to 200.
+	PageSize int
+
+	// Concurrency is the number of pages fetched at the same time. It defaults to 4.
+	Concurrency int
+
+	// Progress is told about every computer once it has been exported or summarized.
+	Progress jamfpro.ProgressReporter
+}
+
+// ExportComputers writes the inventory of every computer to w. Pages are fetched concurrently, but the computers are
+// written in the order Jamf Pro lists them in.
+func ExportComputers(ctx context.Context, computers jamfpro.ComputersService, w io.Writer, opts Options) error {
+	if computers == nil {
+		return jamfpro.NewArgError("computers", "cannot be nil")
+	} else if w == nil {
+		return jamfpro.NewArgError("w", "cannot be nil")
+	}
+	opts = opts.withDefaults()
+
+	writer, err := newRowWriter(w, opts.Format, opts.Columns)
+	if err != nil {
+		return err
+	}
+
+	err = forEachPage(ctx, computers, opts, sections(opts.Columns), writer.write)
+	if err != nil {
+		return err
+	}
+
+	return writer.flush()
+}
+
+// forEachPage lists the inventory sections of every computer matching opts.Filter, and calls fn with each page in the
+// order Jamf Pro lists them in. Pages are fetched concurrently.
+func forEachPage(ctx context.Context, computers jamfpro.ComputersService, opts Options, sections []string, fn func([]jamfpro.ComputerInventory) error) (err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	progress := jamfpro.SyncProgress(opts.Progress)
+	defer func() { progress.OnFinish(err) }()
+	done := 0
+	handle := func(page []jamfpro.ComputerInventory) error {
+		if err := fn(page); err != nil {
+			return err
+		}
+		for range page {
+			done++
+			progress.OnItem(done)
+		}
+		return nil
+	}
+
+	fetch := func(page int) ([]jamfpro.ComputerInventory, *jamfpro.Response, error) {
+		return computers.ListInventory(ctx, &jamfpro.ComputerInventoryListOptions{
+			ListOptions: jamfpro.ListOptions{Page: page, PageSize: opts.PageSize, Sort: "id:asc", Filter: opts.Filter},
+			Sections:    sections,
+		})
+	}
+
+	// The first page tells how many pages there are.
+	first, resp, err := fetch(0)
+	if err != nil {
+		return err
+	}
+	progress.OnStart(resp.TotalCount)
+	if err := handle(first); err != nil {
+		return err
+	}
+	pages := (resp.TotalCount + opts.PageSize - 1) / opts.PageSize
+
+	// Fetch the remaining pages with at most Concurrency requests in flight, and as many pages held in memory.
+	type result struct {
+		computers []jamfpro.ComputerInventory
+		err       error
+	}
+	results := make(chan chan result, opts.Concurrency)
+	go func() {
+		defer close(results)
+		for page := 1; page < pages; page++ {
+			pageResult := make(chan result, 1)
+			select {
+			case results <- pageResult:
+			case <-ctx.Done():
+				return
+			}
+			go func(page int) {
+				computers, _, err := fetch(page)
+				pageResult <- result{computers: computers, err: err}
+			}(page)
+		}
+	}()
+
+	for pageResult := range results {
+		r := <-pageResult
+		if r.err != nil {
+			return r.err
+		}
+		if err := handle(r.computers); err != nil {
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
+func (o Options) withDefaults() Options {
+	if o.Format == "" {
+		o.Format = CSV
+	}
+	if len(o.Columns) == 0 {
+		o.Columns = DefaultColumns
+	}
+	if o.PageSize <= 0 {
+		o.PageSize = defaultPageSize
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = defaultConcurrency
+	}
+	return o
+}
+
+// sections returns the inventory sections the columns need
+func sections(columns []Column) []string {
+	seen := make(map[string]bool)
+	var sections []string
+	for _, column := range columns {
+		for _, section := range column.Sections {
+			if !seen[section] {
+				seen[section] = true
+				sections = append(sections, section)
+			}
+		}
+	}
+	return sections
+}
+
+// rowWriter writes computers as rows of an export
+type rowWriter struct {
+	columns []Column
+	csv     *csv.Writer
+	json    io.Writer
+}
+
+func newRowWriter(w io.Writer, format Format, columns []Column) (*rowWriter, error) {
+	for _, column := range columns {
+		if column.Name == "" || column.Value == nil {
+			return nil, jamfpro.NewArgError("Columns", "every column needs a Name and a Value")
+		}
+	}
+
+	switch format {
+	case CSV:
+		writer := &rowWriter{columns: columns, csv: csv.NewWriter(w)}
+		header := make([]string, len(columns))
+		for i, column := range columns {
+			header[i] = column.Name
+		}
+		return writer, writer.csv.Write(header)
+	case JSONLines:
+		return &rowWriter{columns: columns, json: w}, nil
+	}
+	return nil, jamfpro.NewArgError("Format", fmt.Sprintf("%q is not a supported format", format))
+}
+
+func (r *rowWriter) write(computers []jamfpro.ComputerInventory) error {
+	for i := range computers {
+		values := make([]string, len(r.columns))
+		for j, column := range r.columns {
+			values[j] = column.Value(&computers[i])
+		}
+
+		if r.csv != nil {
+			if err := r.csv.Write(values); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Build the object by hand, as encoding a map would not keep the columns in order.
+		var line strings.Builder
+		line.WriteByte('{')
+		for j, column := range r.columns {
+			if j > 0 {
+				line.WriteByte(',')
+			}
+			key, _ := json.Marshal(column.Name)
+			value, _ := json.Marshal(values[j])
+			line.Write(key)
+			line.WriteByte(':')
+			line.Write(value)
+		}
+		line.WriteString("}\n")
+		if _, err := io.WriteString(r.json, line.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *rowWriter) flush() error {
+	if r.csv == nil {
+		return nil
+	}
+	r.csv.Flush()
+	return r.csv.Error()
+}
@@ -0,0 +1,69 @@
+package reports
+
+import (
+	"context"
+
+	"github.com/jc0b/go-jamfpro-api/jamfpro"
+)
+
+// SecuritySummary counts the security posture of a fleet of computers.
+type SecuritySummary struct {
+	Computers       int
+	Encrypted       int
+	SIPEnabled      int
+	FirewallEnabled int
+	MdmCapable      int
+
+	// FileVaultStates and XProtectVersions count the computers per boot partition FileVault 2 state and per XProtect
+	// version, for spotting computers that are left behind.
+	FileVaultStates  map[string]int
+	XProtectVersions map[string]int
+
+	// Unencrypted lists the computers whose boot partition is not fully encrypted.
+	Unencrypted []jamfpro.ComputerSecurityPosture
+}
+
+// SummarizeSecurity fetches the security posture of every computer matching opts.Filter and counts it. Pages are
+// fetched concurrently; the Format and Columns of opts are not used.
+func SummarizeSecurity(ctx context.Context, computers jamfpro.ComputersService, opts Options) (*SecuritySummary, error) {
+	if computers == nil {
+		return nil, jamfpro.NewArgError("computers", "cannot be nil")
+	}
+	opts = opts.withDefaults()
+
+	summary := &SecuritySummary{
+		FileVaultStates:  make(map[string]int),
+		XProtectVersions: make(map[string]int),
+	}
+	err := forEachPage(ctx, computers, opts, jamfpro.ComputerSecuritySections, func(page []jamfpro.ComputerInventory) error {
+		for i := range page {
+			summary.add(page[i].SecurityPosture())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+func (s *SecuritySummary) add(posture jamfpro.ComputerSecurityPosture) {
+	s.Computers++
+	if posture.Encrypted() {
+		s.Encrypted++
+	} else {
+		s.Unencrypted = append(s.Unencrypted, posture)
+	}
+	if posture.SIPEnabled {
+		s.SIPEnabled++
+	}
+	if posture.FirewallEnabled {
+		s.FirewallEnabled++
+	}
+	if posture.MdmCapable {
+		s.MdmCapable++
+	}
+	s.FileVaultStates[posture.FileVaultState]++
+	s.XProtectVersions[posture.XProtectVersion]++
+}
@@ -0,0 +1,50 @@
+package jamfpro
+
+// ClassicReference is the {id, name} pair the Classic API uses whenever one object refers to another, e.g. the
+// category or site of a policy.
+type ClassicReference struct {
+	Id   ID     `xml:"id"`
+	Name string `xml:"name,omitempty"`
+}
+
+// Scope represents the scope block shared by Classic API objects that can be deployed to devices and users, such
+// as policies, eBooks and mobile device apps.
+type Scope struct {
+	AllComputers       bool               `xml:"all_computers"`
+	AllMobileDevices   bool               `xml:"all_mobile_devices,omitempty"`
+	AllJssUsers        bool               `xml:"all_jss_users,omitempty"`
+	Computers          []ClassicReference `xml:"computers>computer,omitempty"`
+	ComputerGroups     []ClassicReference `xml:"computer_groups>computer_group,omitempty"`
+	MobileDevices      []ClassicReference `xml:"mobile_devices>mobile_device,omitempty"`
+	MobileDeviceGroups []ClassicReference `xml:"mobile_device_groups>mobile_device_group,omitempty"`
+	Buildings          []ClassicReference `xml:"buildings>building,omitempty"`
+	Departments        []ClassicReference `xml:"departments>department,omitempty"`
+	JssUsers           []ClassicReference `xml:"jss_users>user,omitempty"`
+	JssUserGroups      []ClassicReference `xml:"jss_user_groups>user_group,omitempty"`
+	Limitations        ScopeLimitations   `xml:"limitations"`
+	Exclusions         ScopeExclusions    `xml:"exclusions"`
+}
+
+// ScopeLimitations narrows a Scope down to particular users or network locations.
+type ScopeLimitations struct {
+	Users           []ClassicReference `xml:"users>user,omitempty"`
+	UserGroups      []ClassicReference `xml:"user_groups>user_group,omitempty"`
+	NetworkSegments []ClassicReference `xml:"network_segments>network_segment,omitempty"`
+	IBeacons        []ClassicReference `xml:"ibeacons>ibeacon,omitempty"`
+}
+
+// ScopeExclusions removes targets from a Scope.
+type ScopeExclusions struct {
+	Computers          []ClassicReference `xml:"computers>computer,omitempty"`
+	ComputerGroups     []ClassicReference `xml:"computer_groups>computer_group,omitempty"`
+	MobileDevices      []ClassicReference `xml:"mobile_devices>mobile_device,omitempty"`
+	MobileDeviceGroups []ClassicReference `xml:"mobile_device_groups>mobile_device_group,omitempty"`
+	Buildings          []ClassicReference `xml:"buildings>building,omitempty"`
+	Departments        []ClassicReference `xml:"departments>department,omitempty"`
+	Users              []ClassicReference `xml:"users>user,omitempty"`
+	UserGroups         []ClassicReference `xml:"user_groups>user_group,omitempty"`
+	NetworkSegments    []ClassicReference `xml:"network_segments>network_segment,omitempty"`
+	IBeacons           []ClassicReference `xml:"ibeacons>ibeacon,omitempty"`
+	JssUsers           []ClassicReference `xml:"jss_users>user,omitempty"`
+	JssUserGroups      []ClassicReference `xml:"jss_user_groups>user_group,omitempty"`
+}
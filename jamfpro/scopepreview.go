@@ -0,0 +1,263 @@
+package jamfpro
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// ScopePreview is the set of computers a Scope targets, as resolved by PreviewScope.
+type ScopePreview struct {
+	// ComputerIds are the computers the scope targets, sorted by ID.
+	ComputerIds []ID
+	// Targeted is the number of computers the scope targets before its limitations and exclusions are applied.
+	Targeted int
+	// Unresolved describes the parts of the scope that depend on the state of the computer when the policy runs,
+	// such as network segments, and so were not applied. The computers in ComputerIds may not all be targeted then.
+	Unresolved []string
+}
+
+// Count returns the number of computers the scope targets.
+func (p *ScopePreview) Count() int {
+	return len(p.ComputerIds)
+}
+
+// PreviewScope resolves the computers a Scope targets, expanding its computer groups, buildings and departments and
+// applying its user limitations and exclusions, e.g. to show how many computers a change to a policy affects before
+// it is applied. Groups are resolved from their current membership, so smart groups are not recalculated.
+func PreviewScope(ctx context.Context, client *Client, scope *Scope) (*ScopePreview, error) {
+	if scope == nil {
+		return nil, NewArgError("scope", "cannot be nil")
+	}
+
+	r := &scopeResolver{client: client}
+
+	included := make(map[ID]bool)
+	if scope.AllComputers || len(scope.Buildings) > 0 || len(scope.Departments) > 0 || len(scope.Limitations.Users) > 0 ||
+		len(scope.Exclusions.Buildings) > 0 || len(scope.Exclusions.Departments) > 0 || len(scope.Exclusions.Users) > 0 {
+		if err := r.loadInventory(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if scope.AllComputers {
+		for id := range r.inventory {
+			included[id] = true
+		}
+	} else {
+		if err := r.addComputers(ctx, included, scope.Computers); err != nil {
+			return nil, err
+		}
+		if err := r.addComputerGroups(ctx, included, scope.ComputerGroups); err != nil {
+			return nil, err
+		}
+		if err := r.addByLocation(ctx, included, scope.Buildings, scope.Departments); err != nil {
+			return nil, err
+		}
+	}
+
+	preview := &ScopePreview{Targeted: len(included)}
+
+	if len(scope.Limitations.Users) > 0 {
+		users := referenceNames(scope.Limitations.Users)
+		for id := range included {
+			if !users[r.username(id)] {
+				delete(included, id)
+			}
+		}
+	}
+
+	excluded := make(map[ID]bool)
+	if err := r.addComputers(ctx, excluded, scope.Exclusions.Computers); err != nil {
+		return nil, err
+	}
+	if err := r.addComputerGroups(ctx, excluded, scope.Exclusions.ComputerGroups); err != nil {
+		return nil, err
+	}
+	if err := r.addByLocation(ctx, excluded, scope.Exclusions.Buildings, scope.Exclusions.Departments); err != nil {
+		return nil, err
+	}
+	if len(scope.Exclusions.Users) > 0 {
+		users := referenceNames(scope.Exclusions.Users)
+		for id := range included {
+			if users[r.username(id)] {
+				excluded[id] = true
+			}
+		}
+	}
+
+	for id := range included {
+		if !excluded[id] {
+			preview.ComputerIds = append(preview.ComputerIds, id)
+		}
+	}
+	sort.Slice(preview.ComputerIds, func(i, j int) bool { return preview.ComputerIds[i].Int() < preview.ComputerIds[j].Int() })
+
+	unresolved := []struct {
+		what       string
+		references []ClassicReference
+	}{
+		{"limitation to user groups", scope.Limitations.UserGroups},
+		{"limitation to network segments", scope.Limitations.NetworkSegments},
+		{"limitation to iBeacons", scope.Limitations.IBeacons},
+		{"exclusion of user groups", scope.Exclusions.UserGroups},
+		{"exclusion of network segments", scope.Exclusions.NetworkSegments},
+		{"exclusion of iBeacons", scope.Exclusions.IBeacons},
+	}
+	for _, u := range unresolved {
+		if len(u.references) > 0 {
+			preview.Unresolved = append(preview.Unresolved, fmt.Sprintf("%s (%d)", u.what, len(u.references)))
+		}
+	}
+
+	return preview, nil
+}
+
+// scopeResolver looks up the computers the parts of a Scope refer to, fetching the inventory of all computers at
+// most once.
+type scopeResolver struct {
+	client    *Client
+	inventory map[ID]*ComputerInventoryUserAndLocation
+}
+
+// loadInventory fetches the user and location of every computer.
+func (r *scopeResolver) loadInventory(ctx context.Context) error {
+	if r.inventory != nil {
+		return nil
+	}
+
+	r.inventory = make(map[ID]*ComputerInventoryUserAndLocation)
+	opts := &ComputerInventoryListOptions{
+		ListOptions: ListOptions{PageSize: defaultPageSize, Sort: "id:asc"},
+		Sections:    []string{ComputerInventorySectionUserAndLocation},
+	}
+	for {
+		page, resp, err := r.client.Computers.ListInventory(ctx, opts)
+		if err != nil {
+			return err
+		}
+		for i := range page {
+			r.inventory[page[i].Id] = page[i].UserAndLocation
+		}
+		if len(page) < opts.PageSize || (resp.TotalCount > 0 && (opts.Page+1)*opts.PageSize >= resp.TotalCount) {
+			return nil
+		}
+		opts.Page++
+	}
+}
+
+func (r *scopeResolver) addComputers(ctx context.Context, into map[ID]bool, computers []ClassicReference) error {
+	for _, computer := range computers {
+		if !computer.Id.IsZero() {
+			into[computer.Id] = true
+			continue
+		}
+		found, _, err := r.client.Computers.GetByName(ctx, computer.Name)
+		if err != nil {
+			return fmt.Errorf("jamfpro: resolving computer %q of the scope: %w", computer.Name, err)
+		}
+		into[found.Id] = true
+	}
+	return nil
+}
+
+func (r *scopeResolver) addComputerGroups(ctx context.Context, into map[ID]bool, groups []ClassicReference) error {
+	for _, reference := range groups {
+		var group *ComputerGroup
+		var err error
+		if !reference.Id.IsZero() {
+			group, _, err = r.client.ComputerGroups.GetByID(ctx, reference.Id.Int())
+		} else {
+			group, _, err = r.client.ComputerGroups.GetByName(ctx, reference.Name)
+		}
+		if err != nil {
+			return fmt.Errorf("jamfpro: resolving computer group %s of the scope: %w", describeReference(reference), err)
+		}
+		for _, computer := range group.Computers {
+			into[computer.Id] = true
+		}
+	}
+	return nil
+}
+
+func (r *scopeResolver) addByLocation(ctx context.Context, into map[ID]bool, buildings, departments []ClassicReference) error {
+	if len(buildings) == 0 && len(departments) == 0 {
+		return nil
+	}
+
+	buildingIds, err := r.resolveIds(buildings, func(name string) (ID, error) {
+		building, _, err := r.client.Buildings.GetByName(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		if building.Id == nil {
+			return "", notFoundByName("building", name)
+		}
+		return *building.Id, nil
+	})
+	if err != nil {
+		return fmt.Errorf("jamfpro: resolving a building of the scope: %w", err)
+	}
+	departmentIds, err := r.resolveIds(departments, func(name string) (ID, error) {
+		department, _, err := r.client.Departments.GetByName(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		return department.Id, nil
+	})
+	if err != nil {
+		return fmt.Errorf("jamfpro: resolving a department of the scope: %w", err)
+	}
+
+	for id, location := range r.inventory {
+		if location == nil {
+			continue
+		}
+		if buildingIds[location.BuildingId] || departmentIds[location.DepartmentId] {
+			into[id] = true
+		}
+	}
+	return nil
+}
+
+// resolveIds returns the IDs of references, looking up those that only have a name.
+func (r *scopeResolver) resolveIds(references []ClassicReference, byName func(string) (ID, error)) (map[ID]bool, error) {
+	ids := make(map[ID]bool, len(references))
+	for _, reference := range references {
+		if !reference.Id.IsZero() {
+			ids[reference.Id] = true
+			continue
+		}
+		id, err := byName(reference.Name)
+		if err != nil {
+			return nil, err
+		}
+		ids[id] = true
+	}
+	return ids, nil
+}
+
+func (r *scopeResolver) username(id ID) string {
+	if location := r.inventory[id]; location != nil {
+		return location.Username
+	}
+	return ""
+}
+
+// referenceNames returns the set of names of references, leaving out empty ones.
+func referenceNames(references []ClassicReference) map[string]bool {
+	names := make(map[string]bool, len(references))
+	for _, reference := range references {
+		if reference.Name != "" {
+			names[reference.Name] = true
+		}
+	}
+	return names
+}
+
+func describeReference(reference ClassicReference) string {
+	if reference.Id.IsZero() {
+		return fmt.Sprintf("%q", reference.Name)
+	}
+	return fmt.Sprintf("%d", reference.Id.Int())
+}
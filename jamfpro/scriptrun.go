@@ -0,0 +1,141 @@
+package jamfpro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ScriptRunOptions configures a one-off policy created by StartScriptRun.
+type ScriptRunOptions struct {
+	// Computers are the IDs of the computers to run the script on.
+	Computers []int
+
+	// Parameters are passed to the script as parameters 4 onwards.
+	Parameters []string
+
+	// Trigger is the custom trigger the policy runs on, with `jamf policy -event Trigger`. A unique one is made up if
+	// it is empty.
+	Trigger string
+
+	// CheckIn also runs the policy on the next recurring check-in of each computer, once, so the script runs without
+	// anyone calling the custom trigger. Without it the policy runs every time the trigger is called.
+	CheckIn bool
+
+	// Category is the name of the category to file the policy under.
+	Category string
+
+	// UpdateInventory makes computers submit an inventory update once they have run the script.
+	UpdateInventory bool
+
+	// FlushLogs flushes the logs of the policy when it is torn down, so the one-off runs do not clutter the policy
+	// logs of the computers.
+	FlushLogs bool
+}
+
+// ScriptRun is a one-off policy running a script on a set of computers. Stop tears it down.
+type ScriptRun struct {
+	Policy  *Policy
+	Trigger string
+
+	client    *Client
+	flushLogs bool
+}
+
+// StartScriptRun creates a policy scoped to opts.Computers that runs the script with the given ID when they call the
+// custom trigger returned in ScriptRun.Trigger, or on their next check-in if opts.CheckIn is set. The policy stays
+// until Stop is called; RunScript tears it down automatically.
+func StartScriptRun(ctx context.Context, client *Client, scriptId int, opts ScriptRunOptions) (*ScriptRun, *Response, error) {
+	if client == nil {
+		return nil, nil, NewArgError("client", "cannot be nil")
+	} else if scriptId == 0 {
+		return nil, nil, NewArgError("script ID", "cannot be 0")
+	} else if len(opts.Computers) == 0 {
+		return nil, nil, NewArgError("Computers", "must contain at least one computer")
+	}
+
+	script, resp, err := client.Scripts.GetByID(ctx, scriptId)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	trigger := opts.Trigger
+	if trigger == "" {
+		trigger = "run-script-" + strconv.Itoa(scriptId) + "-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+
+	scope := Scope{}
+	for _, id := range opts.Computers {
+		if id == 0 {
+			return nil, nil, NewArgError("Computers", "cannot contain 0")
+		}
+		scope.Computers = append(scope.Computers, ClassicReference{Id: FromInt(id)})
+	}
+
+	builder := NewPolicy(fmt.Sprintf("Run %s (%s)", script.Name, trigger)).
+		Scope(scope).
+		CustomTrigger(trigger).
+		Frequency(PolicyFrequencyOngoing).
+		AddScript(scriptId, opts.Parameters...)
+	if opts.CheckIn {
+		builder.Trigger(PolicyTriggerCheckin).Frequency(PolicyFrequencyOncePerComputer)
+	}
+	if opts.Category != "" {
+		builder.Category(opts.Category)
+	}
+	if opts.UpdateInventory {
+		builder.UpdateInventory()
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy, resp, err := client.Policies.Create(ctx, request)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &ScriptRun{Policy: policy, Trigger: trigger, client: client, flushLogs: opts.FlushLogs}, resp, nil
+}
+
+// Stop tears the policy down: it flushes its logs if ScriptRunOptions.FlushLogs was set, and deletes it. Computers
+// that have not run the script yet no longer will.
+func (r *ScriptRun) Stop(ctx context.Context) (*Response, error) {
+	id := r.Policy.General.Id.Int()
+
+	if r.flushLogs {
+		if resp, err := r.client.LogFlush.FlushPolicyLogs(ctx, id, LogFlushIntervalZeroDays); err != nil && err.Error() != "EOF" {
+			return resp, err
+		}
+	}
+
+	resp, err := r.client.Policies.Delete(ctx, id)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+	return resp, nil
+}
+
+// RunScript runs the script with the given ID on opts.Computers: it starts a ScriptRun, calls run with it, e.g. to
+// call the trigger on each computer and wait for the results, and then stops the run, even if run failed or ctx was
+// cancelled. The errors of run and of tearing down are returned together.
+func RunScript(ctx context.Context, client *Client, scriptId int, opts ScriptRunOptions, run func(context.Context, *ScriptRun) error) error {
+	if run == nil {
+		return NewArgError("run", "cannot be nil")
+	}
+
+	scriptRun, _, err := StartScriptRun(ctx, client, scriptId, opts)
+	if err != nil {
+		return err
+	}
+
+	runErr := run(ctx, scriptRun)
+	_, stopErr := scriptRun.Stop(context.WithoutCancel(ctx))
+	if stopErr != nil {
+		stopErr = fmt.Errorf("jamfpro: tearing down policy %d: %w", scriptRun.Policy.General.Id.Int(), stopErr)
+	}
+	return errors.Join(runErr, stopErr)
+}
@@ -0,0 +1,207 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+const scriptsBasePath = "uapi/v1/scripts"
+
+// Script priorities, deciding when a policy runs a script relative to its other actions
+const (
+	ScriptPriorityBefore   = "BEFORE"
+	ScriptPriorityAfter    = "AFTER"
+	ScriptPriorityAtReboot = "AT_REBOOT"
+)
+
+type ScriptsService interface {
+	List(context.Context, *ListOptions) ([]Script, *Response, error)
+	GetByID(context.Context, int) (*Script, *Response, error)
+	GetByName(context.Context, string) (*Script, *Response, error)
+	Create(context.Context, *Script) (*Script, *Response, error)
+	Update(context.Context, int, *Script) (*Script, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+}
+
+// ScriptsServiceOp handles communication with the script related
+// methods of the Jamf Pro API. If validate is set, scripts are checked with ValidateScript before they are sent.
+type ScriptsServiceOp struct {
+	client   *Client
+	validate *ScriptValidationOptions
+}
+
+var _ ScriptsService = &ScriptsServiceOp{}
+
+// Script represents a Jamf Pro script. Parameter4 to Parameter11 are the labels of the parameters policies can pass
+// to the script.
+type Script struct {
+	Id             ID     `json:"id,omitempty"`
+	Name           string `json:"name"`
+	Info           string `json:"info,omitempty"`
+	Notes          string `json:"notes,omitempty"`
+	Priority       string `json:"priority,omitempty"`
+	CategoryId     ID     `json:"categoryId,omitempty"`
+	CategoryName   string `json:"categoryName,omitempty"`
+	Parameter4     string `json:"parameter4,omitempty"`
+	Parameter5     string `json:"parameter5,omitempty"`
+	Parameter6     string `json:"parameter6,omitempty"`
+	Parameter7     string `json:"parameter7,omitempty"`
+	Parameter8     string `json:"parameter8,omitempty"`
+	Parameter9     string `json:"parameter9,omitempty"`
+	Parameter10    string `json:"parameter10,omitempty"`
+	Parameter11    string `json:"parameter11,omitempty"`
+	OsRequirements string `json:"osRequirements,omitempty"`
+	ScriptContents string `json:"scriptContents"`
+}
+
+// ScriptListResponse represents the raw API response to listing scripts
+type ScriptListResponse struct {
+	TotalCount *int64    `json:"totalCount"`
+	Scripts    *[]Script `json:"results"`
+}
+
+// ScriptCreateResponse represents an API response to creating a script
+type ScriptCreateResponse struct {
+	Id   ID     `json:"id"`
+	Href string `json:"href"`
+}
+
+func (s *ScriptsServiceOp) List(ctx context.Context, opts *ListOptions) ([]Script, *Response, error) {
+	path, err := addOptions(scriptsBasePath, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var scriptResponse ScriptListResponse
+	resp, err := s.client.Do(ctx, req, &scriptResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if scriptResponse.Scripts == nil {
+		return nil, resp, err
+	}
+
+	return *scriptResponse.Scripts, resp, err
+}
+
+func (s *ScriptsServiceOp) GetByID(ctx context.Context, id int) (*Script, *Response, error) {
+	path := scriptsBasePath + "/" + strconv.Itoa(id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var script Script
+	resp, err := s.client.Do(ctx, req, &script)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &script, resp, err
+}
+
+func (s *ScriptsServiceOp) GetByName(ctx context.Context, name string) (*Script, *Response, error) {
+	scripts, resp, err := s.List(ctx, &ListOptions{Filter: nameFilter(name)})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	// RSQL treats * as a wildcard, so the filter can match more than the one name asked for.
+	for i := range scripts {
+		if scripts[i].Name == name {
+			return &scripts[i], resp, nil
+		}
+	}
+
+	return nil, resp, notFoundByName("script", name)
+}
+
+// Create uploads a new script. If the client was created with WithScriptValidation, a script with validation errors
+// is not sent, and the *ScriptValidationReport is returned as the error.
+func (s *ScriptsServiceOp) Create(ctx context.Context, script *Script) (*Script, *Response, error) {
+	if script == nil {
+		return nil, nil, NewArgError("script", "cannot be nil")
+	}
+	if err := s.check(script); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, scriptsBasePath, script, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var scriptCreation ScriptCreateResponse
+	resp, err := s.client.Do(ctx, req, &scriptCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, scriptCreation.Id, s.GetByID)
+}
+
+// Update replaces a script. It is validated like a script passed to Create.
+func (s *ScriptsServiceOp) Update(ctx context.Context, id int, script *Script) (*Script, *Response, error) {
+	path := scriptsBasePath + "/" + strconv.Itoa(id)
+
+	if script == nil {
+		return nil, nil, NewArgError("script", "cannot be nil")
+	}
+	if err := s.check(script); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, script, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated Script
+	resp, err := s.client.Do(ctx, req, &updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &updated, resp, err
+}
+
+func (s *ScriptsServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := scriptsBasePath + "/" + strconv.Itoa(id)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DeleteByName deletes the script called name. The error matches ErrNotFound if there is none.
+func (s *ScriptsServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "script", name, s.GetByName, func(script *Script) ID { return script.Id }, s.Delete)
+}
+
+// check validates script if the service was configured to.
+func (s *ScriptsServiceOp) check(script *Script) error {
+	if s.validate == nil {
+		return nil
+	}
+	if report := ValidateScript(script, *s.validate); report.HasErrors() {
+		return report
+	}
+	return nil
+}
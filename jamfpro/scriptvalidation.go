@@ -0,0 +1,207 @@
+package jamfpro
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// defaultMaxScriptSize is the size above which ValidateScript reports a script by default. Jamf Pro keeps script
+// contents in its database and sends them to every computer running the script, so large payloads belong in packages.
+const defaultMaxScriptSize = 1 << 20
+
+// Severities of the issues in a ScriptValidationReport
+const (
+	ScriptIssueError   = "error"
+	ScriptIssueWarning = "warning"
+)
+
+// ScriptValidationOptions configures ValidateScript. The zero value applies the default limits.
+type ScriptValidationOptions struct {
+	// MaxSize is the largest script, in bytes, that passes validation. It defaults to 1 MiB.
+	MaxSize int
+}
+
+// ScriptIssue is a problem found in a script. Line is the 1-based line it was found on, or 0 if it concerns the
+// script as a whole.
+type ScriptIssue struct {
+	Severity string
+	Line     int
+	Message  string
+}
+
+func (i ScriptIssue) String() string {
+	if i.Line == 0 {
+		return i.Severity + ": " + i.Message
+	}
+	return fmt.Sprintf("%s: line %d: %s", i.Severity, i.Line, i.Message)
+}
+
+// ScriptValidationReport lists the issues ValidateScript found in a script. It is returned as the error of
+// Scripts.Create and Scripts.Update when it has errors and validation is enabled.
+type ScriptValidationReport struct {
+	// Interpreter is the program named by the shebang line, such as /bin/zsh, or empty if there is none.
+	Interpreter string
+
+	// Parameters lists the script parameters, from 4 to 11, that the script reads.
+	Parameters []int
+
+	Issues []ScriptIssue
+}
+
+// HasErrors reports whether any of the issues is an error rather than a warning.
+func (r *ScriptValidationReport) HasErrors() bool {
+	for _, issue := range r.Issues {
+		if issue.Severity == ScriptIssueError {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *ScriptValidationReport) Error() string {
+	lines := make([]string, len(r.Issues))
+	for i, issue := range r.Issues {
+		lines[i] = issue.String()
+	}
+	return "jamfpro: script failed validation:\n" + strings.Join(lines, "\n")
+}
+
+var (
+	shellParameter  = regexp.MustCompile(`\$(?:\{([0-9]+)\}|([0-9]+))`)
+	pythonParameter = regexp.MustCompile(`sys\.argv\[([0-9]+)\]`)
+	shellVariable   = regexp.MustCompile(`\$\{?([A-Z][A-Z0-9_]*)\}?`)
+)
+
+// profileVariables are the payload variables Jamf Pro substitutes in configuration profiles. They are not set when
+// a script runs, which is an easy mistake to make when moving a setting from a profile to a script.
+var profileVariables = map[string]bool{
+	"COMPUTERNAME": true, "SITENAME": true, "SITEID": true, "UDID": true, "SERIALNUMBER": true, "DEVICENAME": true,
+	"DEVICEID": true, "MACADDRESS": true, "JSSID": true, "PROFILEJSSID": true, "USERNAME": true, "FULLNAME": true,
+	"REALNAME": true, "EMAIL": true, "PHONE": true, "POSITION": true, "DEPARTMENTNAME": true, "DEPARTMENTID": true,
+	"BUILDINGNAME": true, "BUILDINGID": true, "ROOM": true, "MANAGEMENTID": true,
+}
+
+// shellInterpreters are the interpreters whose scripts read their arguments as $1, $2 and so on
+var shellInterpreters = map[string]bool{"sh": true, "bash": true, "zsh": true, "ksh": true, "dash": true}
+
+// ValidateScript checks a script before it is uploaded: that it starts with a shebang line, is not too large, only
+// reads the parameters policies can pass (4 to 11), and has labels for those it reads. It also warns about variables
+// that are not set when Jamf Pro runs a script, such as the configuration profile payload variables.
+func ValidateScript(script *Script, opts ScriptValidationOptions) *ScriptValidationReport {
+	report := &ScriptValidationReport{}
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = defaultMaxScriptSize
+	}
+	contents := script.ScriptContents
+
+	if strings.TrimSpace(script.Name) == "" {
+		report.add(ScriptIssueError, 0, "the script has no name")
+	}
+	if strings.TrimSpace(contents) == "" {
+		report.add(ScriptIssueError, 0, "the script is empty")
+		return report
+	}
+	if len(contents) > opts.MaxSize {
+		report.add(ScriptIssueError, 0, fmt.Sprintf("the script is %d bytes, more than the limit of %d", len(contents), opts.MaxSize))
+	}
+	if strings.Contains(contents, "\r\n") {
+		report.add(ScriptIssueWarning, 0, "the script has Windows line endings, which break the shebang line")
+	}
+
+	lines := strings.Split(contents, "\n")
+	if strings.HasPrefix(lines[0], "#!") {
+		fields := strings.Fields(strings.TrimPrefix(lines[0], "#!"))
+		if len(fields) > 0 {
+			report.Interpreter = fields[0]
+			// #!/usr/bin/env zsh names the interpreter in its argument.
+			if path.Base(fields[0]) == "env" && len(fields) > 1 {
+				report.Interpreter = fields[1]
+			}
+		}
+	}
+	if report.Interpreter == "" {
+		report.add(ScriptIssueError, 1, "the script does not start with a shebang line such as #!/bin/zsh")
+	}
+
+	interpreter := path.Base(report.Interpreter)
+	shell := shellInterpreters[interpreter]
+	python := strings.HasPrefix(interpreter, "python")
+	parameter := shellParameter
+	if python {
+		parameter = pythonParameter
+	}
+
+	used := make(map[int]int)
+	for i, line := range lines {
+		lineNumber := i + 1
+		if i == 0 || strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+
+		if shell || python {
+			for _, match := range parameter.FindAllStringSubmatch(line, -1) {
+				n, err := strconv.Atoi(strings.Join(match[1:], ""))
+				if err != nil {
+					continue
+				}
+				// Outside zsh, $10 is $1 followed by a 0; only ${10} reads parameter 10.
+				if shell && interpreter != "zsh" && n > 9 && match[2] != "" {
+					report.add(ScriptIssueError, lineNumber, fmt.Sprintf("$%d is read as $%c followed by %q; use ${%d}", n, match[2][0], match[2][1:], n))
+					continue
+				}
+				switch {
+				case n >= 4 && n <= 11:
+					if _, ok := used[n]; !ok {
+						used[n] = lineNumber
+					}
+				case n > 11:
+					report.add(ScriptIssueError, lineNumber, fmt.Sprintf("parameter %d is never set, as policies only pass parameters 4 to 11", n))
+				}
+			}
+		}
+
+		if shell {
+			for _, match := range shellVariable.FindAllStringSubmatch(line, -1) {
+				switch name := match[1]; {
+				case profileVariables[name]:
+					report.add(ScriptIssueWarning, lineNumber, fmt.Sprintf("$%s is a configuration profile variable, which Jamf Pro does not set for scripts", name))
+				case name == "USER":
+					report.add(ScriptIssueWarning, lineNumber, "$USER is root when Jamf Pro runs a script; use $3 for the logged in user")
+				}
+			}
+		}
+	}
+
+	labels := script.parameterLabels()
+	for n := 4; n <= 11; n++ {
+		line, ok := used[n]
+		switch {
+		case ok && labels[n] == "":
+			report.add(ScriptIssueWarning, line, fmt.Sprintf("parameter %d is read but has no label, so policies will not know what to pass", n))
+		case !ok && labels[n] != "" && (shell || python):
+			report.add(ScriptIssueWarning, 0, fmt.Sprintf("parameter %d is labelled %q but never read", n, labels[n]))
+		}
+		if ok {
+			report.Parameters = append(report.Parameters, n)
+		}
+	}
+
+	sort.SliceStable(report.Issues, func(i, j int) bool { return report.Issues[i].Line < report.Issues[j].Line })
+	return report
+}
+
+func (r *ScriptValidationReport) add(severity string, line int, message string) {
+	r.Issues = append(r.Issues, ScriptIssue{Severity: severity, Line: line, Message: message})
+}
+
+// parameterLabels returns the labels of parameters 4 to 11, indexed by parameter number
+func (s *Script) parameterLabels() map[int]string {
+	return map[int]string{
+		4: s.Parameter4, 5: s.Parameter5, 6: s.Parameter6, 7: s.Parameter7,
+		8: s.Parameter8, 9: s.Parameter9, 10: s.Parameter10, 11: s.Parameter11,
+	}
+}
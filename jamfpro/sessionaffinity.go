@@ -0,0 +1,141 @@
+package jamfpro
+
+import "net/http"
+
+// Cookies Jamf Cloud load balancers use to route a session to the same Jamf Pro node. Which one an instance uses
+// depends on its region.
+const (
+	cookieJamfProIngress = "jpro-ingress"
+	cookieAPBalanceID    = "APBALANCEID"
+)
+
+type sessionAffinityMode int
+
+const (
+	sessionAffinityFromFirstResponse sessionAffinityMode = iota
+	sessionAffinityDisabled
+	sessionAffinityPinned
+)
+
+// SessionAffinity decides how a client keeps its requests on one Jamf Pro node of a clustered instance, which avoids
+// reading stale data while changes replicate between nodes. Pass one to WithSessionAffinity.
+type SessionAffinity struct {
+	mode  sessionAffinityMode
+	value string
+}
+
+var (
+	// SessionAffinityFromFirstResponse sends back the affinity cookie of the first response that sets one, the token
+	// response in practice, and follows later responses that rotate or expire it. This is what clients do unless
+	// configured otherwise.
+	SessionAffinityFromFirstResponse = SessionAffinity{mode: sessionAffinityFromFirstResponse}
+
+	// SessionAffinityDisabled sends no affinity cookie, so the load balancer can spread requests over every node,
+	// e.g. to test failover.
+	SessionAffinityDisabled = SessionAffinity{mode: sessionAffinityDisabled}
+)
+
+// SessionAffinityPinned sends value as both affinity cookies, pinning the client to the node it names whatever the
+// responses set. It is what passing a session token to NewClient does.
+func SessionAffinityPinned(value string) SessionAffinity {
+	return SessionAffinity{mode: sessionAffinityPinned, value: value}
+}
+
+// JamfProIngress returns the jpro-ingress cookie the client sends, or an empty string if it sends none.
+func (c *Client) JamfProIngress() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.jamfProIngress
+}
+
+// APBalanceID returns the APBALANCEID cookie the client sends, or an empty string if it sends none.
+func (c *Client) APBalanceID() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.apBalanceId
+}
+
+// setSessionAffinity applies a to the client's cookies. It must be called with c.mu held, or before the client is
+// shared.
+func (c *Client) setSessionAffinity(a SessionAffinity) {
+	c.affinity = a
+	switch a.mode {
+	case sessionAffinityPinned:
+		c.jamfProIngress, c.apBalanceId = a.value, a.value
+	default:
+		c.jamfProIngress, c.apBalanceId = "", ""
+	}
+}
+
+// SessionAffinityChange reports that a response replaced, or removed, the value of an affinity cookie the client
+// sends, e.g. because the load balancer rotated it.
+type SessionAffinityChange struct {
+	// Cookie is the name of the cookie, jpro-ingress or APBALANCEID.
+	Cookie string
+
+	// Old and New are the values before and after the response. New is empty if the response expired the cookie.
+	Old, New string
+}
+
+// updateSessionAffinity takes the affinity cookies resp sets, if the client takes its affinity from the responses,
+// and returns the changes it made. It must be called with c.mu held.
+func (c *Client) updateSessionAffinity(resp *http.Response) []SessionAffinityChange {
+	if c.affinity.mode != sessionAffinityFromFirstResponse {
+		return nil
+	}
+
+	var changes []SessionAffinityChange
+	for _, cookie := range resp.Cookies() {
+		var stored *string
+		switch cookie.Name {
+		case cookieJamfProIngress:
+			stored = &c.jamfProIngress
+		case cookieAPBalanceID:
+			stored = &c.apBalanceId
+		default:
+			continue
+		}
+
+		value := cookie.Value
+		if cookie.MaxAge < 0 {
+			value = ""
+		}
+		if value != *stored {
+			changes = append(changes, SessionAffinityChange{Cookie: cookie.Name, Old: *stored, New: value})
+			*stored = value
+		}
+	}
+	return changes
+}
+
+// trackSessionAffinity updates the affinity cookies from resp and reports the changes to the client's hook.
+func (c *Client) trackSessionAffinity(resp *http.Response) {
+	c.mu.Lock()
+	changes := c.updateSessionAffinity(resp)
+	c.mu.Unlock()
+
+	c.notifySessionAffinity(changes)
+}
+
+// notifySessionAffinity passes changes to the hook set with WithSessionAffinityHook. It must be called without c.mu
+// held, so that the hook can use the client.
+func (c *Client) notifySessionAffinity(changes []SessionAffinityChange) {
+	if c.affinityHook == nil {
+		return
+	}
+	for _, change := range changes {
+		c.affinityHook(change)
+	}
+}
+
+// addSessionAffinity adds the client's affinity cookies to request.
+func addSessionAffinity(request *http.Request, jamfProIngress, apBalanceId string) {
+	if jamfProIngress != "" {
+		request.AddCookie(&http.Cookie{Name: cookieJamfProIngress, Value: jamfProIngress})
+	}
+	if apBalanceId != "" {
+		request.AddCookie(&http.Cookie{Name: cookieAPBalanceID, Value: apBalanceId})
+	}
+}
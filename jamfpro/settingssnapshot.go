@@ -0,0 +1,124 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+)
+
+const (
+	ssoSettingsBasePath         = "uapi/v2/sso"
+	enrollmentSettingsBasePath  = "uapi/v4/enrollment"
+	selfServiceSettingsBasePath = "uapi/v1/self-service/settings"
+)
+
+// SettingsSection names a group of global settings a SettingsSnapshot can hold
+type SettingsSection string
+
+const (
+	SettingsCheckIn             SettingsSection = "check-in"
+	SettingsInventoryCollection SettingsSection = "inventory-collection"
+	SettingsReenrollment        SettingsSection = "reenrollment"
+	SettingsEngage              SettingsSection = "engage"
+	SettingsChangeManagement    SettingsSection = "change-management"
+	SettingsSSO                 SettingsSection = "sso"
+	SettingsEnrollment          SettingsSection = "enrollment"
+	SettingsSelfService         SettingsSection = "self-service"
+)
+
+// DefaultSettingsSections are the sections a snapshot holds when none are given.
+var DefaultSettingsSections = []SettingsSection{
+	SettingsCheckIn,
+	SettingsInventoryCollection,
+	SettingsReenrollment,
+	SettingsEngage,
+	SettingsChangeManagement,
+	SettingsSSO,
+	SettingsEnrollment,
+	SettingsSelfService,
+}
+
+// SettingsSnapshotService collects global settings of an instance into a SettingsSnapshot, so that the configuration
+// of two instances, such as staging and production, can be serialized and compared with DiffSettings.
+type SettingsSnapshotService interface {
+	Take(context.Context, ...SettingsSection) (*SettingsSnapshot, *Response, error)
+}
+
+// SettingsSnapshotServiceOp collects settings through the settings services of its client.
+type SettingsSnapshotServiceOp struct {
+	client *Client
+}
+
+var _ SettingsSnapshotService = &SettingsSnapshotServiceOp{}
+
+// SettingsSnapshot holds the global settings of an instance. Sections that were not requested are nil. The SSO,
+// enrollment and self-service settings are kept as raw JSON objects, as this package has no types for them yet.
+type SettingsSnapshot struct {
+	CheckIn             *CheckInSettings                     `json:"checkIn,omitempty"`
+	InventoryCollection *ComputerInventoryCollectionSettings `json:"inventoryCollection,omitempty"`
+	Reenrollment        *ReenrollmentSettings                `json:"reenrollment,omitempty"`
+	Engage              *EngageSettings                      `json:"engage,omitempty"`
+	ChangeManagement    *ChangeManagement                    `json:"changeManagement,omitempty"`
+	SSO                 map[string]interface{}               `json:"sso,omitempty"`
+	Enrollment          map[string]interface{}               `json:"enrollment,omitempty"`
+	SelfService         map[string]interface{}               `json:"selfService,omitempty"`
+}
+
+// Take reads the given sections of the global settings, or the DefaultSettingsSections if none are given. The
+// returned Response is that of the last request made.
+func (s *SettingsSnapshotServiceOp) Take(ctx context.Context, sections ...SettingsSection) (*SettingsSnapshot, *Response, error) {
+	if len(sections) == 0 {
+		sections = DefaultSettingsSections
+	}
+
+	snapshot := &SettingsSnapshot{}
+	var resp *Response
+	var err error
+	for _, section := range sections {
+		switch section {
+		case SettingsCheckIn:
+			snapshot.CheckIn, resp, err = s.client.CheckInSettings.Get(ctx)
+		case SettingsInventoryCollection:
+			snapshot.InventoryCollection, resp, err = s.client.ComputerInventoryCollectionSettings.Get(ctx)
+		case SettingsReenrollment:
+			snapshot.Reenrollment, resp, err = s.client.ReenrollmentSettings.Get(ctx)
+		case SettingsEngage:
+			snapshot.Engage, resp, err = s.client.EngageSettings.Get(ctx)
+		case SettingsChangeManagement:
+			snapshot.ChangeManagement, resp, err = s.client.ChangeManagement.Get(ctx)
+		case SettingsSSO:
+			snapshot.SSO, resp, err = s.getRaw(ctx, ssoSettingsBasePath)
+		case SettingsEnrollment:
+			snapshot.Enrollment, resp, err = s.getRaw(ctx, enrollmentSettingsBasePath)
+		case SettingsSelfService:
+			snapshot.SelfService, resp, err = s.getRaw(ctx, selfServiceSettingsBasePath)
+		default:
+			return nil, nil, NewArgError("sections", "contains the unknown section "+string(section))
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	return snapshot, resp, nil
+}
+
+func (s *SettingsSnapshotServiceOp) getRaw(ctx context.Context, path string) (map[string]interface{}, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var settings map[string]interface{}
+	resp, err := s.client.Do(ctx, req, &settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settings, resp, err
+}
+
+// DiffSettings compares the settings of two instances. Only sections present in both snapshots are compared in
+// detail; a section taken from only one of them shows up as changed in its entirety.
+func DiffSettings(planned, actual *SettingsSnapshot) Changes {
+	return Diff(planned, actual)
+}
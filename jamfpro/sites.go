@@ -0,0 +1,57 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+)
+
+const sitesBasePath = "uapi/v1/sites"
+
+type SitesService interface {
+	List(context.Context) ([]Site, *Response, error)
+	GetByName(context.Context, string) (*Site, *Response, error)
+}
+
+// SitesServiceOp handles communication with the sites-related
+// methods of the Jamf Pro API.
+type SitesServiceOp struct {
+	client *Client
+}
+
+var _ SitesService = &SitesServiceOp{}
+
+// Site represents a Jamf Pro Site
+type Site struct {
+	Id   ID     `json:"id"`
+	Name string `json:"name"`
+}
+
+func (s *SitesServiceOp) List(ctx context.Context) ([]Site, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, sitesBasePath, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sites []Site
+	resp, err := s.client.Do(ctx, req, &sites)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sites, resp, err
+}
+
+// GetByName returns the site called name. Sites cannot be looked up by ID in the Jamf Pro API, so this lists them all.
+func (s *SitesServiceOp) GetByName(ctx context.Context, name string) (*Site, *Response, error) {
+	sites, resp, err := s.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for i := range sites {
+		if sites[i].Name == name {
+			return &sites[i], resp, nil
+		}
+	}
+	return nil, resp, notFoundByName("site", name)
+}
@@ -0,0 +1,180 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// StreamList calls fn with every computer of the Classic API list as it is decoded, while the response is still
+// downloading, so the full list is never held in memory. It stops at the first error fn returns, and returns it.
+func (c *ComputersServiceOp) StreamList(ctx context.Context, fn func(Computer) error) (*Response, error) {
+	req, err := c.client.NewRequest(ctx, http.MethodGet, computersBasePath, nil, "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	_, resp, err := streamArray(ctx, c.client, req, "computers", fn)
+	return resp, err
+}
+
+// StreamInventory calls fn with the inventory of every computer matching opts, page after page, decoding each record
+// while its page is still downloading. Fewer, larger pages are then cheap: set opts.PageSize to a few thousand for
+// large fleets. Response.TotalCount of the returned response holds the number of computers. It stops at the first
+// error fn returns, and returns it.
+func (c *ComputersServiceOp) StreamInventory(ctx context.Context, opts *ComputerInventoryListOptions, fn func(ComputerInventory) error) (*Response, error) {
+	listOpts := ComputerInventoryListOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	return streamPages(ctx, c.client, computersInventoryBasePath, "id:asc", &listOpts.ListOptions, &listOpts, fn)
+}
+
+// Stream calls fn with the inventory of every mobile device matching opts, page after page, decoding each record
+// while its page is still downloading. Response.TotalCount of the returned response holds the number of devices. It
+// stops at the first error fn returns, and returns it.
+func (m *MobileDevicesInventoryServiceOp) Stream(ctx context.Context, opts *MobileDeviceInventoryListOptions, fn func(MobileDeviceInventory) error) (*Response, error) {
+	listOpts := MobileDeviceInventoryListOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	return streamPages(ctx, m.client, mobileDevicesInventoryBasePath+"/detail", "mobileDeviceId:asc", &listOpts.ListOptions, &listOpts, fn)
+}
+
+// streamPages streams the results of every page of a Jamf Pro API list endpoint to fn, starting at the page in page.
+// query encodes the options, page included, of each request. Pages are sorted by idSort unless page has a Sort, as
+// sorting by ID keeps the pages from shifting while they are read.
+func streamPages[T any](ctx context.Context, client *Client, path, idSort string, page *ListOptions, query QueryEncoder, fn func(T) error) (*Response, error) {
+	if fn == nil {
+		return nil, NewArgError("fn", "cannot be nil")
+	}
+	if page.PageSize == 0 {
+		page.PageSize = defaultPageSize
+	}
+	if page.Sort == "" {
+		page.Sort = idSort
+	}
+
+	streamed := 0
+	for {
+		pagePath, err := addOptions(path, query)
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := client.NewRequest(ctx, http.MethodGet, pagePath, nil, "application/json")
+		if err != nil {
+			return nil, err
+		}
+
+		count := 0
+		total, resp, err := streamArray(ctx, client, req, "results", func(item T) error {
+			count++
+			return fn(item)
+		})
+		if err != nil {
+			return resp, err
+		}
+		streamed += count
+		if resp != nil {
+			resp.TotalCount = total
+			resp.Page = page.Page
+			resp.PageSize = page.PageSize
+		}
+
+		if count < page.PageSize || (total > 0 && streamed >= total) {
+			return resp, nil
+		}
+		page.Page++
+	}
+}
+
+// streamArray sends req and decodes the array under key in the JSON object of the response one element at a time,
+// as the body downloads, calling fn with each. It returns the totalCount of the object, if it has one.
+func streamArray[T any](ctx context.Context, client *Client, req *http.Request, key string, fn func(T) error) (int, *Response, error) {
+	body, w := io.Pipe()
+
+	type result struct {
+		resp *Response
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		resp, err := client.Do(ctx, req, w)
+		w.CloseWithError(err)
+		done <- result{resp: resp, err: err}
+	}()
+
+	total, decodeErr := decodeArray(body, key, fn)
+	if decodeErr == nil {
+		// Read what follows the object, such as a trailing newline, so Do can finish writing.
+		_, decodeErr = io.Copy(io.Discard, body)
+	}
+	// Stop the download if decoding ended early, and have Do return the error.
+	body.CloseWithError(decodeErr)
+
+	r := <-done
+	if r.err != nil {
+		return total, r.resp, r.err
+	}
+	return total, r.resp, decodeErr
+}
+
+// decodeArray reads a JSON object from r, calling fn with every element of its array under key, and returns its
+// totalCount. Other members are skipped.
+func decodeArray[T any](r io.Reader, key string, fn func(T) error) (int, error) {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return total, err
+		}
+		switch token {
+		case "totalCount":
+			if err := dec.Decode(&total); err != nil {
+				return total, err
+			}
+		case key:
+			if err := expectDelim(dec, '['); err != nil {
+				return total, err
+			}
+			for dec.More() {
+				var item T
+				if err := dec.Decode(&item); err != nil {
+					return total, err
+				}
+				if err := fn(item); err != nil {
+					return total, err
+				}
+			}
+			if err := expectDelim(dec, ']'); err != nil {
+				return total, err
+			}
+		default:
+			var skipped json.RawMessage
+			if err := dec.Decode(&skipped); err != nil {
+				return total, err
+			}
+		}
+	}
+
+	return total, expectDelim(dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("jamfpro: expected %v in the JSON response, got %v", delim, token)
+	}
+	return nil
+}
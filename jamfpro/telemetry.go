@@ -0,0 +1,128 @@
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/jc0b/go-jamfpro-api/jamfpro"
+
+// Attributes set on the span of every API call, in addition to the OpenTelemetry HTTP semantic conventions.
+const (
+	attributeResource   = attribute.Key("jamfpro.resource")
+	attributeOperation  = attribute.Key("jamfpro.operation")
+	attributeRetryCount = attribute.Key("jamfpro.retry_count")
+	attributeRequestID  = attribute.Key("jamfpro.request_id")
+)
+
+// classicLookupKeys are the Classic API path segments that are followed by the value a record is looked up by.
+var classicLookupKeys = map[string]bool{
+	"id": true, "name": true, "serialnumber": true, "udid": true, "macaddress": true, "bundleid": true,
+	"version": true, "uuid": true, "username": true, "email": true,
+}
+
+type retryCountKey struct{}
+
+// withRetryCount marks the requests made with ctx as the nth retry of an earlier request.
+func withRetryCount(ctx context.Context, n int) context.Context {
+	return context.WithValue(ctx, retryCountKey{}, n)
+}
+
+func retryCountFromContext(ctx context.Context) int {
+	n, _ := ctx.Value(retryCountKey{}).(int)
+	return n
+}
+
+// startSpan starts the span of an API call if the client has a tracer, returning the context to send req with.
+func (c *Client) startSpan(ctx context.Context, req *http.Request) (context.Context, trace.Span) {
+	if c.tracer == nil {
+		return ctx, nil
+	}
+
+	resource, route := apiRoute(req.URL.Path)
+	return c.tracer.Start(ctx, req.Method+" "+route,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("url.full", req.URL.Redacted()),
+			attribute.String("server.address", req.URL.Hostname()),
+			attributeResource.String(resource),
+			attributeOperation.String(apiOperation(req.Method)),
+			attributeRetryCount.Int(retryCountFromContext(ctx)),
+		),
+	)
+}
+
+// endSpan records the outcome of an API call on span and ends it. A nil span is ignored.
+func endSpan(span trace.Span, resp *Response, err error) {
+	if span == nil {
+		return
+	}
+	defer span.End()
+
+	if resp != nil && resp.Response != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		if resp.RequestID != "" {
+			span.SetAttributes(attributeRequestID.String(resp.RequestID))
+		}
+	}
+	if err != nil && err.Error() != "EOF" {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// apiRoute returns the resource a request path belongs to, and the path with the values identifying a record
+// replaced by placeholders, e.g. computergroups and JSSResource/computergroups/id/{id} for
+// /JSSResource/computergroups/id/7.
+func apiRoute(path string) (string, string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	resourceIndex := 0
+	classic := false
+	switch {
+	case len(segments) > 1 && segments[0] == "JSSResource":
+		resourceIndex, classic = 1, true
+	case len(segments) > 2 && (segments[0] == "uapi" || segments[0] == "api") && isAPIVersion(segments[1]):
+		resourceIndex = 2
+	}
+
+	for i := resourceIndex + 1; i < len(segments); i++ {
+		if classic && classicLookupKeys[segments[i-1]] {
+			segments[i] = "{" + segments[i-1] + "}"
+		} else if _, err := strconv.Atoi(segments[i]); err == nil {
+			segments[i] = "{id}"
+		}
+	}
+
+	return segments[resourceIndex], strings.Join(segments, "/")
+}
+
+func isAPIVersion(segment string) bool {
+	if !strings.HasPrefix(segment, "v") {
+		return false
+	}
+	_, err := strconv.Atoi(segment[1:])
+	return err == nil
+}
+
+// apiOperation names the kind of operation a request performs
+func apiOperation(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return "read"
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut, http.MethodPatch:
+		return "update"
+	case http.MethodDelete:
+		return "delete"
+	}
+	return strings.ToLower(method)
+}
@@ -0,0 +1,378 @@
+package jamfpro
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// AttributeType is the Terraform type of a resource attribute.
+type AttributeType string
+
+const (
+	AttributeTypeString AttributeType = "string"
+	AttributeTypeInt    AttributeType = "int"
+	AttributeTypeFloat  AttributeType = "float"
+	AttributeTypeBool   AttributeType = "bool"
+	// AttributeTypeList is a list of scalars; ElemType holds their type.
+	AttributeTypeList AttributeType = "list"
+	// AttributeTypeBlock is a nested block, encoded as a list of maps; Elem describes the attributes of each map.
+	// Blocks for struct fields rather than slices have MaxItems 1.
+	AttributeTypeBlock AttributeType = "block"
+	AttributeTypeMap   AttributeType = "map"
+)
+
+// Attribute describes one attribute of a resource as returned by Schema.
+type Attribute struct {
+	Type     AttributeType
+	ElemType AttributeType
+	Elem     map[string]*Attribute
+	MaxItems int
+	// Optional is set for pointer and omitempty fields, which Jamf Pro does not require.
+	Optional bool
+	// Computed is set for attributes assigned by Jamf Pro, such as Id and Href.
+	Computed bool
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// MarshalResourceMap converts a resource struct, or a pointer to one, to a map keyed by stable snake_case attribute
+// names derived from its JSON or XML field names. Nested structs become single-element lists of maps and slices of
+// structs lists of maps, the shape Terraform uses for nested blocks; nil pointers are left out. The result can be
+// passed to ResourceData.Set attribute by attribute, or converted to cty values.
+func MarshalResourceMap(v interface{}) (map[string]interface{}, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, NewArgError("v", "cannot be nil")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, NewArgError("v", fmt.Sprintf("must be a struct, not %s", rv.Kind()))
+	}
+	return marshalStruct(rv), nil
+}
+
+// UnmarshalResourceMap sets the fields of the struct v points to from a map in the shape MarshalResourceMap
+// produces. Keys without a matching field are ignored, and numbers may be given as any integer or float type.
+func UnmarshalResourceMap(m map[string]interface{}, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return NewArgError("v", "must be a non-nil pointer to a struct")
+	}
+	return unmarshalStruct("", m, rv.Elem())
+}
+
+// Schema describes the attributes MarshalResourceMap produces for a resource struct, so a Terraform provider can
+// build its schema from the same source.
+func Schema(v interface{}) (map[string]*Attribute, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, NewArgError("v", "must be a struct or a pointer to one")
+	}
+	return structSchema(t), nil
+}
+
+type mapField struct {
+	index     int
+	key       string
+	omitEmpty bool
+}
+
+// mapFields returns the fields of t that take part in resource maps
+func mapFields(t reflect.Type) []mapField {
+	var fields []mapField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || field.Anonymous || field.Name == "XMLName" {
+			continue
+		}
+
+		name, omitEmpty, skip := fieldName(field)
+		if skip {
+			continue
+		}
+		fields = append(fields, mapField{index: i, key: snakeCase(name), omitEmpty: omitEmpty})
+	}
+	return fields
+}
+
+func fieldName(field reflect.StructField) (name string, omitEmpty, skip bool) {
+	for _, tagName := range []string{"json", "xml"} {
+		tag, ok := field.Tag.Lookup(tagName)
+		if !ok {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		if parts[0] == "-" {
+			return "", false, true
+		}
+		for _, option := range parts[1:] {
+			if option == "omitempty" {
+				omitEmpty = true
+			}
+		}
+		if parts[0] != "" {
+			// For XML paths such as computers>computer the outer element names the list.
+			return strings.Split(parts[0], ">")[0], omitEmpty, false
+		}
+	}
+	return field.Name, omitEmpty, false
+}
+
+// snakeCase converts names such as streetAddress1, serial_number and SerialNumber to street_address1,
+// serial_number and serial_number
+func snakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case r == '-' || r == ' ':
+			b.WriteRune('_')
+		case unicode.IsUpper(r):
+			if i > 0 && runes[i-1] != '_' && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteRune('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func marshalStruct(v reflect.Value) map[string]interface{} {
+	m := map[string]interface{}{}
+	for _, field := range mapFields(v.Type()) {
+		if value, ok := marshalValue(v.Field(field.index)); ok {
+			m[field.key] = value
+		}
+	}
+	return m
+}
+
+func marshalValue(v reflect.Value) (interface{}, bool) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, false
+		}
+		return marshalValue(v.Elem())
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface().(time.Time).Format(time.RFC3339), true
+		}
+		return []interface{}{marshalStruct(v)}, true
+	case reflect.Slice, reflect.Array:
+		list := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			if value, ok := marshalValue(v.Index(i)); ok {
+				if block, isBlock := value.([]interface{}); isBlock && v.Index(i).Kind() == reflect.Struct {
+					value = block[0]
+				}
+				list = append(list, value)
+			}
+		}
+		return list, true
+	case reflect.Map:
+		m := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			if value, ok := marshalValue(iter.Value()); ok {
+				m[fmt.Sprint(iter.Key().Interface())] = value
+			}
+		}
+		return m, true
+	case reflect.String:
+		return v.String(), true
+	case reflect.Bool:
+		return v.Bool(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return nil, false
+}
+
+func unmarshalStruct(path string, m map[string]interface{}, v reflect.Value) error {
+	for _, field := range mapFields(v.Type()) {
+		value, ok := m[field.key]
+		if !ok || value == nil {
+			continue
+		}
+		fieldPath := field.key
+		if path != "" {
+			fieldPath = path + "." + field.key
+		}
+		if err := unmarshalValue(fieldPath, value, v.Field(field.index)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unmarshalValue(path string, value interface{}, v reflect.Value) error {
+	mismatch := func() error {
+		return NewArgError(path, fmt.Sprintf("cannot set %T into a %s field", value, v.Type()))
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(v.Type().Elem())
+		if err := unmarshalValue(path, value, elem.Elem()); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+
+	case reflect.Struct:
+		if v.Type() == timeType {
+			s, ok := value.(string)
+			if !ok {
+				return mismatch()
+			}
+			t, err := time.Parse(time.RFC3339, s)
+			if err != nil {
+				return NewArgError(path, err.Error())
+			}
+			v.Set(reflect.ValueOf(t))
+			return nil
+		}
+		if list, ok := value.([]interface{}); ok {
+			if len(list) == 0 {
+				return nil
+			}
+			value = list[0]
+		}
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return mismatch()
+		}
+		return unmarshalStruct(path, m, v)
+
+	case reflect.Slice:
+		list, ok := value.([]interface{})
+		if !ok {
+			return mismatch()
+		}
+		slice := reflect.MakeSlice(v.Type(), len(list), len(list))
+		for i, item := range list {
+			if err := unmarshalValue(fmt.Sprintf("%s.%d", path, i), item, slice.Index(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+		return nil
+
+	case reflect.Map:
+		m, ok := value.(map[string]interface{})
+		if !ok || v.Type().Key().Kind() != reflect.String {
+			return mismatch()
+		}
+		out := reflect.MakeMapWithSize(v.Type(), len(m))
+		for key, item := range m {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if err := unmarshalValue(path+"."+key, item, elem); err != nil {
+				return err
+			}
+			out.SetMapIndex(reflect.ValueOf(key).Convert(v.Type().Key()), elem)
+		}
+		v.Set(out)
+		return nil
+
+	case reflect.String:
+		s, ok := value.(string)
+		if !ok {
+			return mismatch()
+		}
+		v.SetString(s)
+		return nil
+
+	case reflect.Bool:
+		b, ok := value.(bool)
+		if !ok {
+			return mismatch()
+		}
+		v.SetBool(b)
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		n := reflect.ValueOf(value)
+		switch n.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			v.Set(n.Convert(v.Type()))
+			return nil
+		}
+		return mismatch()
+	}
+	return mismatch()
+}
+
+func structSchema(t reflect.Type) map[string]*Attribute {
+	schema := map[string]*Attribute{}
+	for _, field := range mapFields(t) {
+		structField := t.Field(field.index)
+		attribute := typeSchema(structField.Type)
+		if attribute == nil {
+			continue
+		}
+		attribute.Optional = attribute.Optional || field.omitEmpty
+		attribute.Computed = structField.Name == "Href" || (structField.Name == "Id" && attribute.Optional)
+		schema[field.key] = attribute
+	}
+	return schema
+}
+
+func typeSchema(t reflect.Type) *Attribute {
+	switch t.Kind() {
+	case reflect.Ptr:
+		attribute := typeSchema(t.Elem())
+		if attribute != nil {
+			attribute.Optional = true
+		}
+		return attribute
+	case reflect.Struct:
+		if t == timeType {
+			return &Attribute{Type: AttributeTypeString}
+		}
+		return &Attribute{Type: AttributeTypeBlock, Elem: structSchema(t), MaxItems: 1}
+	case reflect.Slice, reflect.Array:
+		elem := typeSchema(t.Elem())
+		if elem == nil {
+			return nil
+		}
+		if elem.Type == AttributeTypeBlock {
+			return &Attribute{Type: AttributeTypeBlock, Elem: elem.Elem}
+		}
+		return &Attribute{Type: AttributeTypeList, ElemType: elem.Type}
+	case reflect.Map:
+		elem := typeSchema(t.Elem())
+		if elem == nil {
+			return nil
+		}
+		return &Attribute{Type: AttributeTypeMap, ElemType: elem.Type}
+	case reflect.String:
+		return &Attribute{Type: AttributeTypeString}
+	case reflect.Bool:
+		return &Attribute{Type: AttributeTypeBool}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Attribute{Type: AttributeTypeInt}
+	case reflect.Float32, reflect.Float64:
+		return &Attribute{Type: AttributeTypeFloat}
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+#!/bin/zsh
+# Writes the login banner unless the user has one already.
+banner="/Library/Security/PolicyBanner.rtf"
+if [[ ! -f "$banner" && -d /Library/Security ]]; then
+	cat > "$banner" <<'END'
+{\rtf1\ansi Authorized use only <R&D>]]>}
+END
+fi
+[[ $(sw_vers -productVersion | cut -d. -f1) -lt 13 ]] && echo "macOS < 13" >&2
+exit 0
@@ -0,0 +1,201 @@
+package jamfpro
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Token is a bearer token together with the time it expires.
+type Token struct {
+	AccessToken string    `json:"access_token"`
+	Expiration  time.Time `json:"expiration"`
+}
+
+// tokenExpirySkew is how long before it expires a bearer token is renewed, so that a request sent with it does not
+// reach the server after it expired, or find a server whose clock is ahead of ours already refusing it
+const tokenExpirySkew = 30 * time.Second
+
+// Valid reports whether the token is set and has more than 30 seconds left before it expires.
+func (t *Token) Valid() bool {
+	return t != nil && t.AccessToken != "" && tokenUsable(t.Expiration)
+}
+
+// tokenUsable reports whether a token that expires at expiration is far enough from expiring to be sent.
+func tokenUsable(expiration time.Time) bool {
+	return time.Now().Add(tokenExpirySkew).Before(expiration)
+}
+
+// TokenStore persists bearer tokens so they can be shared between clients, e.g. across short-lived CLI invocations.
+// Tokens are keyed by instance URL and client ID, so a single store can hold tokens for several instances.
+type TokenStore interface {
+	// Load returns the token stored for key, or nil if there is none.
+	Load(key string) (*Token, error)
+	// Save stores token under key, replacing any previous token.
+	Save(key string, token *Token) error
+	// Delete removes the token stored under key, if any.
+	Delete(key string) error
+}
+
+// MemoryTokenStore is a TokenStore that keeps tokens in memory. It is safe for concurrent use.
+type MemoryTokenStore struct {
+	mu     sync.Mutex
+	tokens map[string]Token
+}
+
+var _ TokenStore = &MemoryTokenStore{}
+
+// NewMemoryTokenStore returns an empty MemoryTokenStore.
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{tokens: make(map[string]Token)}
+}
+
+func (m *MemoryTokenStore) Load(key string) (*Token, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	token, ok := m.tokens[key]
+	if !ok {
+		return nil, nil
+	}
+	return &token, nil
+}
+
+func (m *MemoryTokenStore) Save(key string, token *Token) error {
+	if token == nil {
+		return NewArgError("token", "cannot be nil")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.tokens[key] = *token
+	return nil
+}
+
+func (m *MemoryTokenStore) Delete(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.tokens, key)
+	return nil
+}
+
+// FileTokenStore is a TokenStore that keeps tokens in a JSON file readable only by the current user. Writes replace
+// the file atomically, so concurrent processes never observe a partially written file.
+type FileTokenStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+var _ TokenStore = &FileTokenStore{}
+
+// NewFileTokenStore returns a FileTokenStore backed by the file at path. The file is created on the first Save.
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{path: path}
+}
+
+func (f *FileTokenStore) Load(key string) (*Token, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	tokens, err := f.read()
+	if err != nil {
+		return nil, err
+	}
+
+	token, ok := tokens[key]
+	if !ok {
+		return nil, nil
+	}
+	return &token, nil
+}
+
+func (f *FileTokenStore) Save(key string, token *Token) error {
+	if token == nil {
+		return NewArgError("token", "cannot be nil")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	tokens, err := f.read()
+	if err != nil {
+		return err
+	}
+
+	// Drop anything that has expired so the file doesn't grow forever
+	for k, t := range tokens {
+		if !t.Valid() {
+			delete(tokens, k)
+		}
+	}
+	tokens[key] = *token
+
+	return f.write(tokens)
+}
+
+func (f *FileTokenStore) Delete(key string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	tokens, err := f.read()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := tokens[key]; !ok {
+		return nil
+	}
+	delete(tokens, key)
+
+	return f.write(tokens)
+}
+
+func (f *FileTokenStore) write(tokens map[string]Token) error {
+	data, err := json.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(f.path, data)
+}
+
+// writeFileAtomic replaces the file at path with data, by writing data to a temporary file next to it, readable only
+// by the current user, and renaming that over path.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (f *FileTokenStore) read() (map[string]Token, error) {
+	tokens := make(map[string]Token)
+
+	data, err := os.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return tokens, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return tokens, nil
+	}
+	if err := json.Unmarshal(data, &tokens); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
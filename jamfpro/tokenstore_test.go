@@ -0,0 +1,73 @@
+package jamfpro
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// unwritableTokenStore is a TokenStore that has no tokens and fails to save any.
+type unwritableTokenStore struct{}
+
+func (unwritableTokenStore) Load(string) (*Token, error) { return nil, nil }
+func (unwritableTokenStore) Save(string, *Token) error   { return errors.New("read-only file system") }
+func (unwritableTokenStore) Delete(string) error         { return nil }
+
+func TestTokenStoreSaveFailureIsLogged(t *testing.T) {
+	var logs bytes.Buffer
+	client, _ := newTestClient(t, http.NotFoundHandler(), WithTokenStore(unwritableTokenStore{}), WithLogger(slog.New(slog.NewTextHandler(&logs, nil))))
+
+	if !client.hasToken() {
+		t.Error("the client has no token after failing to store it")
+	}
+	if !strings.Contains(logs.String(), "read-only file system") {
+		t.Errorf("the save error was not logged:\n%s", logs.String())
+	}
+}
+
+func TestTokenStoreLoadFailureIsLogged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens.json")
+	if err := os.WriteFile(path, []byte(`{"https://jamf.example.com`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var logs bytes.Buffer
+	client, _ := newTestClient(t, http.NotFoundHandler(), WithTokenStore(NewFileTokenStore(path)), WithLogger(slog.New(slog.NewTextHandler(&logs, nil))))
+
+	if !client.hasToken() {
+		t.Error("the client has no token after failing to load one")
+	}
+	if !strings.Contains(logs.String(), "Could not load the bearer token") || !strings.Contains(logs.String(), "unexpected end of JSON input") {
+		t.Errorf("the corrupt token file was not reported:\n%s", logs.String())
+	}
+}
+
+func TestTokenStoreFailuresWithoutLogger(t *testing.T) {
+	client, _ := newTestClient(t, http.NotFoundHandler(), WithTokenStore(unwritableTokenStore{}))
+
+	if !client.hasToken() {
+		t.Error("the client has no token after failing to store it")
+	}
+}
+
+func TestTokenValidRenewsBeforeExpiry(t *testing.T) {
+	for _, tt := range []struct {
+		expiresIn time.Duration
+		want      bool
+	}{
+		{-time.Minute, false},
+		{tokenExpirySkew / 2, false},
+		{tokenExpirySkew + time.Minute, true},
+	} {
+		token := &Token{AccessToken: "token", Expiration: time.Now().Add(tt.expiresIn)}
+		if got := token.Valid(); got != tt.want {
+			t.Errorf("Valid() of a token expiring in %s = %t, want %t", tt.expiresIn, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package jamfpro
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	// defaultConnectTimeout bounds how long connecting to Jamf Pro, including the TLS handshake, may take
+	defaultConnectTimeout = 30 * time.Second
+
+	// defaultReadTimeout bounds how long Jamf Pro may take to start responding once a request has been sent
+	defaultReadTimeout = 60 * time.Second
+)
+
+// httpTransport returns the transport of the client's http.Client for an option to configure. The shared
+// http.DefaultClient and http.DefaultTransport are replaced with copies first, so configuring one Client never
+// affects another, or the rest of the program.
+func (c *Client) httpTransport() (*http.Transport, error) {
+	if c.client == http.DefaultClient {
+		client := *http.DefaultClient
+		c.client = &client
+	}
+
+	switch transport := c.client.Transport.(type) {
+	case nil:
+		clone := http.DefaultTransport.(*http.Transport).Clone()
+		c.client.Transport = clone
+		return clone, nil
+	case *http.Transport:
+		if transport == http.DefaultTransport {
+			clone := transport.Clone()
+			c.client.Transport = clone
+			return clone, nil
+		}
+		return transport, nil
+	default:
+		return nil, fmt.Errorf("cannot configure a transport of type %T, only *http.Transport", transport)
+	}
+}
+
+// setTimeouts gives the client's transport its connect and read timeouts. A zero timeout leaves that phase unbounded.
+func (c *Client) setTimeouts() error {
+	transport, err := c.httpTransport()
+	if err != nil {
+		return err
+	}
+
+	transport.DialContext = (&net.Dialer{Timeout: c.connectTimeout, KeepAlive: 30 * time.Second}).DialContext
+	transport.TLSHandshakeTimeout = c.connectTimeout
+	transport.ResponseHeaderTimeout = c.readTimeout
+	return nil
+}
+
+// tlsConfig returns the TLS configuration of the client's transport, creating it if there is none.
+func (c *Client) tlsConfig() (*tls.Config, error) {
+	transport, err := c.httpTransport()
+	if err != nil {
+		return nil, err
+	}
+
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	return transport.TLSClientConfig, nil
+}
+
+// appendCACert adds the PEM encoded certificates in pem to the root CAs of config, on top of the system roots.
+func appendCACert(config *tls.Config, pem []byte) error {
+	if config.RootCAs == nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		config.RootCAs = pool
+	}
+
+	if !config.RootCAs.AppendCertsFromPEM(pem) {
+		return NewArgError("pem", "it contains no PEM encoded certificates")
+	}
+	return nil
+}
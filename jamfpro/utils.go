@@ -1,7 +1,11 @@
 package jamfpro
 
+// AreGroupsEquivalent reports whether actual matches planned. Only the name, ID, members and criteria are compared,
+// and only the members and criteria planned sets: actual may have more.
+//
+// Deprecated: use DiffComputerGroups, which reports which fields differ.
 func AreGroupsEquivalent(planned, actual *ComputerGroup) bool {
-	if actual == nil {
+	if planned == nil || actual == nil {
 		return false
 	}
 
@@ -11,6 +15,9 @@ func AreGroupsEquivalent(planned, actual *ComputerGroup) bool {
 	if planned.Id != actual.Id {
 		return false
 	}
+	if len(planned.Computers) > len(actual.Computers) || len(planned.Criteria) > len(actual.Criteria) {
+		return false
+	}
 	for i, v := range planned.Computers {
 		if v != actual.Computers[i] {
 			return false
@@ -25,8 +32,12 @@ func AreGroupsEquivalent(planned, actual *ComputerGroup) bool {
 	return true
 }
 
+// AreComputerRecordsEquivalent reports whether actual matches planned. Only the name, ID and serial number are
+// compared.
+//
+// Deprecated: use DiffComputers, which reports which fields differ.
 func AreComputerRecordsEquivalent(planned, actual *Computer) bool {
-	if actual == nil {
+	if planned == nil || actual == nil {
 		return false
 	}
 
@@ -0,0 +1,48 @@
+package jamfpro
+
+import "testing"
+
+func TestAreComputerRecordsEquivalentIgnoresUnplannedFields(t *testing.T) {
+	planned := &Computer{Id: "12", Name: "MacBook-00012", SerialNumber: "C02XK1ZZJG5H"}
+	actual := &Computer{
+		Id:           "12",
+		Name:         "MacBook-00012",
+		SerialNumber: "C02XK1ZZJG5H",
+		Udid:         "564D1234-AAAA-BBBB-CCCC-1234567890AB",
+		General:      ComputerGeneral{Id: "12", Name: "MacBook-00012", Platform: "Mac", SerialNumber: "C02XK1ZZJG5H"},
+	}
+
+	if !AreComputerRecordsEquivalent(planned, actual) {
+		t.Error("a planned computer without a UDID or general section does not match the server's copy")
+	}
+
+	renamed := *actual
+	renamed.Name = "MacBook-00013"
+	if AreComputerRecordsEquivalent(planned, &renamed) {
+		t.Error("computers with different names match")
+	}
+	if AreComputerRecordsEquivalent(planned, nil) || AreComputerRecordsEquivalent(nil, actual) {
+		t.Error("a nil computer matches")
+	}
+}
+
+func TestAreGroupsEquivalentIgnoresUnplannedFields(t *testing.T) {
+	criterion := ComputerGroupCriteria{Name: "Department", AndOr: CriterionAnd, SearchType: SearchTypeIs, Value: "IT"}
+	planned := &ComputerGroup{Id: "3", Name: "IT", Criteria: []ComputerGroupCriteria{criterion}}
+	actual := &ComputerGroup{Id: "3", Name: "IT", IsSmart: true, Criteria: []ComputerGroupCriteria{criterion}}
+
+	if !AreGroupsEquivalent(planned, actual) {
+		t.Error("a planned group that leaves IsSmart unset does not match the server's copy")
+	}
+
+	changed := *actual
+	changed.Criteria = []ComputerGroupCriteria{{Name: "Department", AndOr: CriterionAnd, SearchType: SearchTypeIs, Value: "HR"}}
+	if AreGroupsEquivalent(planned, &changed) {
+		t.Error("groups with different criteria match")
+	}
+
+	planned.Computers = []Computer{{Id: "12"}}
+	if AreGroupsEquivalent(planned, actual) {
+		t.Error("a group missing a planned member matches")
+	}
+}
@@ -0,0 +1,85 @@
+package jamfpro
+
+import "context"
+
+// V1Resource is the set of methods shared by the services of Jamf Pro API reference resources, such as Buildings,
+// Categories, Departments and ApiRoles, for code that synchronises any of them. T is the object, C the request that
+// creates one and U the request that updates one:
+//
+//	func prune[T, C, U any](ctx context.Context, r jamfpro.V1Resource[T, C, U], id func(*T) jamfpro.ID, keep map[jamfpro.ID]bool) error {
+//		objects, _, err := r.List(ctx)
+//		if err != nil {
+//			return err
+//		}
+//		for i := range objects {
+//			if objectId := id(&objects[i]); !keep[objectId] {
+//				if _, err := r.Delete(ctx, objectId.Int()); err != nil && err.Error() != "EOF" {
+//					return err
+//				}
+//			}
+//		}
+//		return nil
+//	}
+type V1Resource[T, C, U any] interface {
+	List(context.Context) ([]T, *Response, error)
+	GetByID(context.Context, int) (*T, *Response, error)
+	GetByName(context.Context, string) (*T, *Response, error)
+	Create(context.Context, *C) (*T, *Response, error)
+	Update(context.Context, int, *U) (*T, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+}
+
+var (
+	_ V1Resource[ApiRole, ApiRoleCreateRequest, ApiRoleUpdateRequest]          = &ApiRolesServiceOp{}
+	_ V1Resource[Building, BuildingCreateRequest, BuildingUpdateRequest]       = &BuildingsServiceOp{}
+	_ V1Resource[Category, CategoryCreateRequest, CategoryUpdateRequest]       = &CategoriesServiceOp{}
+	_ V1Resource[Department, DepartmentCreateRequest, DepartmentUpdateRequest] = &DepartmentsServiceOp{}
+	_ V1Resource[Script, Script, Script]                                       = scriptsResource{}
+)
+
+// ScriptsResource adapts scripts to V1Resource. Its List fetches every page of scripts.
+func ScriptsResource(scripts ScriptsService) V1Resource[Script, Script, Script] {
+	return scriptsResource{scripts}
+}
+
+type scriptsResource struct {
+	ScriptsService
+}
+
+func (s scriptsResource) List(ctx context.Context) ([]Script, *Response, error) {
+	var all []Script
+	opts := &ListOptions{PageSize: defaultPageSize, Sort: "id:asc"}
+	for {
+		page, resp, err := s.ScriptsService.List(ctx, opts)
+		if err != nil {
+			return all, resp, err
+		}
+		all = append(all, page...)
+		if len(page) < opts.PageSize {
+			return all, resp, nil
+		}
+		opts.Page++
+	}
+}
+
+// IndexByName lists the objects of r and returns them by the name key gives each, e.g. Building.GetName. If two
+// objects have the same name, the last one listed is kept.
+func IndexByName[T, C, U any](ctx context.Context, r V1Resource[T, C, U], key func(*T) string) (map[string]*T, *Response, error) {
+	if r == nil {
+		return nil, nil, NewArgError("resource", "cannot be nil")
+	} else if key == nil {
+		return nil, nil, NewArgError("key", "cannot be nil")
+	}
+
+	objects, resp, err := r.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	index := make(map[string]*T, len(objects))
+	for i := range objects {
+		index[key(&objects[i])] = &objects[i]
+	}
+	return index, resp, nil
+}
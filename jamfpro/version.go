@@ -0,0 +1,55 @@
+package jamfpro
+
+import (
+	"runtime/debug"
+	"sync"
+)
+
+const (
+	modulePath       = "github.com/jc0b/go-jamfpro-api"
+	userAgentProduct = "go-jamfpro-api"
+)
+
+var (
+	versionOnce sync.Once
+	version     string
+)
+
+// Version returns the version of this module as recorded in the build info of the running binary, or "devel" if it
+// is not known, e.g. when running from a checkout of this repository.
+func Version() string {
+	versionOnce.Do(func() {
+		version = "devel"
+
+		info, ok := debug.ReadBuildInfo()
+		if !ok {
+			return
+		}
+
+		module := &info.Main
+		if module.Path != modulePath {
+			module = nil
+			for _, dep := range info.Deps {
+				if dep.Path == modulePath {
+					module = dep
+					break
+				}
+			}
+		}
+		if module == nil {
+			return
+		}
+		if module.Replace != nil {
+			module = module.Replace
+		}
+		if module.Version != "" && module.Version != "(devel)" {
+			version = module.Version
+		}
+	})
+	return version
+}
+
+// defaultUserAgent is the User-Agent sent when none has been configured with WithUserAgent
+func defaultUserAgent() string {
+	return userAgentProduct + "/" + Version()
+}
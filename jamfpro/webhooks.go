@@ -0,0 +1,183 @@
+package jamfpro
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+)
+
+const webhooksBasePath = "JSSResource/webhooks"
+
+// Webhook events
+const (
+	WebhookEventComputerAdded                          = "ComputerAdded"
+	WebhookEventComputerCheckIn                        = "ComputerCheckIn"
+	WebhookEventComputerInventoryCompleted             = "ComputerInventoryCompleted"
+	WebhookEventComputerPolicyFinished                 = "ComputerPolicyFinished"
+	WebhookEventMobileDeviceEnrolled                   = "MobileDeviceEnrolled"
+	WebhookEventSmartGroupComputerMembershipChange     = "SmartGroupComputerMembershipChange"
+	WebhookEventSmartGroupMobileDeviceMembershipChange = "SmartGroupMobileDeviceMembershipChange"
+)
+
+// Ways Jamf Pro authenticates to the receiver of a webhook
+const (
+	WebhookAuthenticationNone  = "NONE"
+	WebhookAuthenticationBasic = "BASIC"
+)
+
+type WebhooksService interface {
+	List(context.Context) ([]WebhookListItem, *Response, error)
+	GetByID(context.Context, int) (*Webhook, *Response, error)
+	GetByName(context.Context, string) (*Webhook, *Response, error)
+	Create(context.Context, *Webhook) (*Webhook, *Response, error)
+	Update(context.Context, int, *Webhook) (*Webhook, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	DeleteByName(context.Context, string) (*Response, error)
+}
+
+// WebhooksServiceOp handles communication with the webhook related
+// methods of the Jamf Pro API.
+type WebhooksServiceOp struct {
+	client *Client
+}
+
+var _ WebhooksService = &WebhooksServiceOp{}
+
+// Webhook represents a Jamf Pro webhook, which posts a notification to URL whenever Event happens. SmartGroupId
+// limits smart group membership events to a single group.
+type Webhook struct {
+	XMLName            xml.Name `xml:"webhook"`
+	Id                 ID       `xml:"id,omitempty"`
+	Name               string   `xml:"name"`
+	Enabled            bool     `xml:"enabled"`
+	URL                string   `xml:"url"`
+	ContentType        string   `xml:"content_type"`
+	Event              string   `xml:"event"`
+	ConnectionTimeout  int      `xml:"connection_timeout,omitempty"`
+	ReadTimeout        int      `xml:"read_timeout,omitempty"`
+	AuthenticationType string   `xml:"authentication_type,omitempty"`
+	Username           string   `xml:"username,omitempty"`
+	Password           string   `xml:"password,omitempty"`
+	SmartGroupId       int      `xml:"smart_group_id,omitempty"`
+}
+
+// WebhookListItem is the summary of a webhook returned when listing all webhooks
+type WebhookListItem struct {
+	Id   ID     `xml:"id"`
+	Name string `xml:"name"`
+}
+
+// WebhookListResponse represents the raw API response to getting all webhooks
+type WebhookListResponse struct {
+	XMLName  xml.Name          `xml:"webhooks"`
+	Size     int               `xml:"size"`
+	Webhooks []WebhookListItem `xml:"webhook"`
+}
+
+type WebhookResponse struct {
+	Id ID `xml:"id"`
+}
+
+func (w *WebhooksServiceOp) List(ctx context.Context) ([]WebhookListItem, *Response, error) {
+	req, err := w.client.NewRequest(ctx, http.MethodGet, webhooksBasePath, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var webhookResponse WebhookListResponse
+	resp, err := w.client.Do(ctx, req, &webhookResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return webhookResponse.Webhooks, resp, err
+}
+
+func (w *WebhooksServiceOp) GetByID(ctx context.Context, id int) (*Webhook, *Response, error) {
+	path := webhooksBasePath + "/id/" + strconv.Itoa(id)
+	return w.get(ctx, path)
+}
+
+func (w *WebhooksServiceOp) GetByName(ctx context.Context, name string) (*Webhook, *Response, error) {
+	path := pathJoin(webhooksBasePath, "name", name)
+	return w.get(ctx, path)
+}
+
+func (w *WebhooksServiceOp) Create(ctx context.Context, webhook *Webhook) (*Webhook, *Response, error) {
+	path := webhooksBasePath + "/id/0"
+	if webhook == nil {
+		return nil, nil, NewArgError("webhook", "cannot be nil")
+	}
+
+	req, err := w.client.NewRequest(ctx, http.MethodPost, path, webhook, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhookCreation := new(WebhookResponse)
+	resp, err := w.client.Do(ctx, req, webhookCreation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getCreated(ctx, resp, webhookCreation.Id, w.GetByID)
+}
+
+func (w *WebhooksServiceOp) Update(ctx context.Context, id int, webhook *Webhook) (*Webhook, *Response, error) {
+	path := webhooksBasePath + "/id/" + strconv.Itoa(id)
+	if webhook == nil {
+		return nil, nil, NewArgError("webhook", "cannot be nil")
+	} else if id == 0 {
+		return nil, nil, NewArgError("webhook ID", "cannot be 0")
+	}
+
+	req, err := w.client.NewRequest(ctx, http.MethodPut, path, webhook, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhookUpdate := new(WebhookResponse)
+	resp, err := w.client.Do(ctx, req, webhookUpdate)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return w.GetByID(ctx, id)
+}
+
+func (w *WebhooksServiceOp) Delete(ctx context.Context, id int) (*Response, error) {
+	path := webhooksBasePath + "/id/" + strconv.Itoa(id)
+
+	req, err := w.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := w.client.Do(ctx, req, nil)
+	if err != nil && err.Error() != "EOF" {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// DeleteByName deletes the webhook called name. The error matches ErrNotFound if there is none.
+func (w *WebhooksServiceOp) DeleteByName(ctx context.Context, name string) (*Response, error) {
+	return deleteByName(ctx, "webhook", name, w.GetByName, func(webhook *Webhook) ID { return webhook.Id }, w.Delete)
+}
+
+func (w *WebhooksServiceOp) get(ctx context.Context, path string) (*Webhook, *Response, error) {
+	req, err := w.client.NewRequest(ctx, http.MethodGet, path, nil, "application/xml")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var webhook Webhook
+	resp, err := w.client.Do(ctx, req, &webhook)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &webhook, resp, err
+}
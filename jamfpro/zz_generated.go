@@ -0,0 +1,170 @@
+// Code generated by internal/gen from the Jamf Pro OpenAPI schema. DO NOT EDIT.
+
+package jamfpro
+
+import (
+	"context"
+	"net/http"
+)
+
+const volumePurchasingSubscriptionsGeneratedBasePath = "uapi/v1/volume-purchasing-subscriptions"
+
+// VolumePurchasingSubscriptionsService is generated from the /v1/volume-purchasing-subscriptions operations of the Jamf Pro API.
+type VolumePurchasingSubscriptionsService interface {
+	List(context.Context, *ListOptions) ([]VolumePurchasingSubscription, *Response, error)
+	GetByID(context.Context, string) (*VolumePurchasingSubscription, *Response, error)
+	Create(context.Context, *VolumePurchasingSubscriptionBase) (*HrefResponse, *Response, error)
+	Update(context.Context, string, *VolumePurchasingSubscriptionBase) (*VolumePurchasingSubscription, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+}
+
+// VolumePurchasingSubscriptionsServiceOp handles communication with the /v1/volume-purchasing-subscriptions methods of the Jamf Pro API.
+type VolumePurchasingSubscriptionsServiceOp struct {
+	client *Client
+}
+
+var _ VolumePurchasingSubscriptionsService = &VolumePurchasingSubscriptionsServiceOp{}
+
+// NewVolumePurchasingSubscriptionsService returns a VolumePurchasingSubscriptionsService that uses client.
+func NewVolumePurchasingSubscriptionsService(client *Client) VolumePurchasingSubscriptionsService {
+	return &VolumePurchasingSubscriptionsServiceOp{client: client}
+}
+
+func (v *VolumePurchasingSubscriptionsServiceOp) List(ctx context.Context, opts *ListOptions) ([]VolumePurchasingSubscription, *Response, error) {
+	path, err := addOptions(volumePurchasingSubscriptionsGeneratedBasePath, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var listResponse struct {
+		TotalCount int                            `json:"totalCount"`
+		Results    []VolumePurchasingSubscription `json:"results"`
+	}
+	resp, err := v.client.Do(ctx, req, &listResponse)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return listResponse.Results, resp, err
+}
+
+func (v *VolumePurchasingSubscriptionsServiceOp) GetByID(ctx context.Context, id string) (*VolumePurchasingSubscription, *Response, error) {
+	if id == "" {
+		return nil, nil, NewArgError("id", "cannot be empty")
+	}
+	path := pathJoin(volumePurchasingSubscriptionsGeneratedBasePath, id)
+
+	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(VolumePurchasingSubscription)
+	resp, err := v.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, err
+}
+
+func (v *VolumePurchasingSubscriptionsServiceOp) Create(ctx context.Context, request *VolumePurchasingSubscriptionBase) (*HrefResponse, *Response, error) {
+	if request == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	req, err := v.client.NewRequest(ctx, http.MethodPost, volumePurchasingSubscriptionsGeneratedBasePath, request, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(HrefResponse)
+	resp, err := v.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, err
+}
+
+func (v *VolumePurchasingSubscriptionsServiceOp) Update(ctx context.Context, id string, request *VolumePurchasingSubscriptionBase) (*VolumePurchasingSubscription, *Response, error) {
+	if request == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	} else if id == "" {
+		return nil, nil, NewArgError("id", "cannot be empty")
+	}
+	path := pathJoin(volumePurchasingSubscriptionsGeneratedBasePath, id)
+
+	req, err := v.client.NewRequest(ctx, http.MethodPut, path, request, "application/json")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(VolumePurchasingSubscription)
+	resp, err := v.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, err
+}
+
+func (v *VolumePurchasingSubscriptionsServiceOp) Delete(ctx context.Context, id string) (*Response, error) {
+	if id == "" {
+		return nil, NewArgError("id", "cannot be empty")
+	}
+	path := pathJoin(volumePurchasingSubscriptionsGeneratedBasePath, id)
+
+	req, err := v.client.NewRequest(ctx, http.MethodDelete, path, nil, "application/json")
+	if err != nil {
+		return nil, err
+	}
+
+	return v.client.Do(ctx, req, nil)
+}
+
+// VolumePurchasingSubscription is generated from the Jamf Pro OpenAPI schema.
+type VolumePurchasingSubscription struct {
+	Enabled            *bool               `json:"enabled,omitempty"`
+	ExternalRecipients []ExternalRecipient `json:"externalRecipients,omitempty"`
+	Id                 *ID                 `json:"id,omitempty"`
+	InternalRecipients []InternalRecipient `json:"internalRecipients,omitempty"`
+	LocationIds        []string            `json:"locationIds,omitempty"`
+	Name               string              `json:"name"`
+	SiteId             *string             `json:"siteId,omitempty"`
+	Triggers           []string            `json:"triggers,omitempty"`
+}
+
+// VolumePurchasingSubscriptionBase is generated from the Jamf Pro OpenAPI schema.
+type VolumePurchasingSubscriptionBase struct {
+	Enabled            *bool               `json:"enabled,omitempty"`
+	ExternalRecipients []ExternalRecipient `json:"externalRecipients,omitempty"`
+	InternalRecipients []InternalRecipient `json:"internalRecipients,omitempty"`
+	LocationIds        []string            `json:"locationIds,omitempty"`
+	Name               string              `json:"name"`
+	SiteId             *string             `json:"siteId,omitempty"`
+	Triggers           []string            `json:"triggers,omitempty"`
+}
+
+// HrefResponse is generated from the Jamf Pro OpenAPI schema.
+type HrefResponse struct {
+	Href *string `json:"href,omitempty"`
+	Id   *ID     `json:"id,omitempty"`
+}
+
+// ExternalRecipient is generated from the Jamf Pro OpenAPI schema.
+type ExternalRecipient struct {
+	Email *string `json:"email,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// InternalRecipient is generated from the Jamf Pro OpenAPI schema.
+type InternalRecipient struct {
+	AccountId *string `json:"accountId,omitempty"`
+	Frequency *string `json:"frequency,omitempty"`
+}